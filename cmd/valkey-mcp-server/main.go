@@ -4,20 +4,32 @@ import (
     "context"
     "flag"
     "fmt"
-    "log"
+    "log/slog"
     "net/http"
     "os"
+    "strings"
 
     "github.com/modelcontextprotocol/go-sdk/mcp"
 
     "github.com/ItsJooL/valkey-mcp-server/internal/client"
+    "github.com/ItsJooL/valkey-mcp-server/internal/completion"
+    "github.com/ItsJooL/valkey-mcp-server/internal/connections"
+    "github.com/ItsJooL/valkey-mcp-server/internal/logging"
+    "github.com/ItsJooL/valkey-mcp-server/internal/notifications"
+    "github.com/ItsJooL/valkey-mcp-server/internal/policy"
+    "github.com/ItsJooL/valkey-mcp-server/internal/prompts"
     "github.com/ItsJooL/valkey-mcp-server/internal/registry"
+    "github.com/ItsJooL/valkey-mcp-server/internal/resources"
     "github.com/ItsJooL/valkey-mcp-server/internal/tools"
+    "github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
     "github.com/ItsJooL/valkey-mcp-server/internal/types"
+    "github.com/ItsJooL/valkey-mcp-server/internal/undo"
 )
 
 func main() {
-    log.SetOutput(os.Stderr)
+    if len(os.Args) > 1 && os.Args[1] == analyzeRDBCommand {
+        os.Exit(analyzeRDB(os.Args[2:], os.Stdout, os.Stderr))
+    }
 
     // Add transport mode flag
     transportMode := flag.String("transport", "stdio", "Transport mode: stdio, http, sse")
@@ -26,8 +38,36 @@ func main() {
     urlFlag := flag.String("url", "", "Valkey connection URL")
     passwordFlag := flag.String("password", "", "Valkey password")
     dbFlag := flag.Int("db", 0, "Valkey database number (0-15)")
+    formatFlag := flag.String("format", "json", "Default text format of tool results: json, table")
+    connectionFlag := flag.String("connection", resources.DefaultConnection, "Connection name used in resource URIs")
+    connectionsFlag := flag.String("connections", "", "Comma-separated name=url pairs of other Valkey servers copy_keys can copy between")
+    logLevelFlag := flag.String("log-level", "info", "Log level: debug, info, warn, error")
+    logFormatFlag := flag.String("log-format", logging.FormatText, "Log format: text, json")
+    slowCommandFlag := flag.Duration("slow-command-threshold", client.DefaultSlowCommandThreshold, "Log Valkey commands slower than this")
+    confirmFlag := flag.Bool("confirm", true, "Ask the user to confirm destructive tool calls")
+    confirmMaxKeysFlag := flag.Int("confirm-max-keys", policy.DefaultMaxKeys, "Confirm calls that delete or modify more keys than this (0: confirm every call that changes a key)")
+    protectedKeysFlag := flag.String("protected-keys", "", "Comma-separated glob patterns of keys whose changes always need confirmation")
+    dryRunFlag := flag.Bool("dry-run", false, "Preview every write tool call instead of running it")
+    undoFlag := flag.Bool("undo", false, "Snapshot keys before write tools change them so the changes can be undone")
+    undoCapacityFlag := flag.Int("undo-capacity", undo.DefaultCapacity, "Number of changes kept for undo")
+    undoMaxBytesFlag := flag.Int64("undo-max-bytes", undo.DefaultMaxBytes, "Total size of the values kept for undo")
+    exportDirFlag := flag.String("export-dir", "", "Directory export_keys and import_keys may write and read files in (inline data only when empty)")
+    maxBlockTimeoutFlag := flag.Duration("max-block-timeout", base.DefaultMaxBlockTimeout, "Longest a blocking tool such as blpop_list, subscribe_capture or watch_keyspace may wait (0 disables them)")
+    rdbDirFlag := flag.String("rdb-dir", "", "Directory of RDB files the analyze_rdb and rdb_get_key tools may read (tools disabled when empty)")
     flag.Parse()
 
+    logLevel, err := logging.ParseLevel(*logLevelFlag)
+    if err != nil {
+        fatal("Invalid log level", err)
+    }
+    localHandler, err := logging.NewLocalHandler(os.Stderr, *logFormatFlag, logLevel)
+    if err != nil {
+        fatal("Invalid log format", err)
+    }
+    logHandler := logging.NewHandler(localHandler)
+    logger := slog.New(logHandler)
+    slog.SetDefault(logger)
+
     // ... existing Valkey connection setup ...
     valkeyURL := *urlFlag
     if valkeyURL == "" {
@@ -49,12 +89,12 @@ func main() {
 
     url, err := types.NewValkeyURL(valkeyURL)
     if err != nil {
-        log.Fatalf("Invalid Valkey URL: %v", err)
+        fatal("Invalid Valkey URL", err)
     }
 
     dbIndex, err := types.NewDBIndex(db)
     if err != nil {
-        log.Fatalf("Invalid database index: %v", err)
+        fatal("Invalid database index", err)
     }
 
     ctx := context.Background()
@@ -63,57 +103,138 @@ func main() {
         URL:      url,
         Password: password,
         DB:       dbIndex,
+
+        SlowCommandThreshold: *slowCommandFlag,
     })
     if err != nil {
-        log.Fatalf("Failed to create Valkey client: %v", err)
+        fatal("Failed to create Valkey client", err)
     }
     defer valkeyClient.Close()
 
+    connectionList, err := connections.ParseList(*connectionsFlag)
+    if err != nil {
+        fatal("Invalid connections", err)
+    }
+    connectionManager := connections.New(*connectionFlag, valkeyClient, func(ctx context.Context, rawURL string) (client.ValkeyClient, error) {
+        u, err := types.NewValkeyURL(rawURL)
+        if err != nil {
+            return nil, err
+        }
+        return client.New(ctx, client.Config{URL: u, SlowCommandThreshold: *slowCommandFlag})
+    })
+    for name, rawURL := range connectionList {
+        if _, err := types.NewValkeyURL(rawURL); err != nil {
+            fatal("Invalid connection URL", fmt.Errorf("%s: %w", name, err))
+        }
+        if err := connectionManager.Add(name, rawURL); err != nil {
+            fatal("Invalid connections", err)
+        }
+    }
+    defer connectionManager.Close()
+
     toolRegistry := registry.NewToolRegistry()
+    if err := toolRegistry.SetOutputFormat(*formatFlag); err != nil {
+        fatal("Invalid output format", err)
+    }
     tools.RegisterAll(toolRegistry, valkeyClient)
+    tools.RegisterExport(toolRegistry, valkeyClient, *exportDirFlag)
+    tools.RegisterCopy(toolRegistry, connectionManager)
+    // Keyspace watches and resource subscriptions share notification leases.
+    notificationLeases := notifications.New(valkeyClient)
+    if *maxBlockTimeoutFlag > 0 {
+        tools.RegisterBlocking(toolRegistry, valkeyClient, *maxBlockTimeoutFlag, notificationLeases)
+    }
+    if *confirmFlag {
+        toolRegistry.SetGuard(policy.New(policy.Config{
+            MaxKeys:           *confirmMaxKeysFlag,
+            ProtectedPatterns: splitList(*protectedKeysFlag),
+        }))
+    }
+    toolRegistry.SetDryRun(*dryRunFlag)
+    if *undoFlag {
+        tools.RegisterUndo(toolRegistry, valkeyClient, undo.New(valkeyClient, undo.Config{
+            Capacity: *undoCapacityFlag,
+            MaxBytes: *undoMaxBytesFlag,
+        }))
+    }
+    if *rdbDirFlag != "" {
+        tools.RegisterRDB(toolRegistry, *rdbDirFlag)
+    }
+
+    slog.Info("Valkey MCP Server started", "url", url.String(), "db", dbIndex.Int(), "tools", toolRegistry.Count(), "dry_run", toolRegistry.DryRun())
 
-    log.Printf("Valkey MCP Server started")
-    log.Printf("Connected to: %s (DB: %d)", url, dbIndex.Int())
-    log.Printf("Available tools: %d", toolRegistry.Count())
+    keyResources := resources.New(valkeyClient, *connectionFlag, dbIndex, notificationLeases)
+    defer keyResources.Close()
+    completer := completion.New(valkeyClient, *connectionFlag, dbIndex)
 
     server := mcp.NewServer(&mcp.Implementation{
         Name:    "valkey-mcp-server",
         Version: "1.0.0",
-    }, nil)
+    }, &mcp.ServerOptions{
+        SubscribeHandler:   keyResources.Subscribe,
+        UnsubscribeHandler: keyResources.Unsubscribe,
+        CompletionHandler:  completer.Complete,
+        // The SDK's own logs stay local; forwarding them to clients could
+        // recurse through session logging.
+        Logger: slog.New(localHandler),
+    })
 
     if err := toolRegistry.RegisterWithMCP(server); err != nil {
-        log.Fatalf("Failed to register tools with MCP: %v", err)
+        fatal("Failed to register tools with MCP", err)
     }
+    keyResources.RegisterWithMCP(server)
+    prompts.RegisterAll(server, keyResources.URI)
+
+    // Added last so that it wraps every other middleware.
+    server.AddReceivingMiddleware(logging.Middleware(logger))
+    logHandler.Attach(server)
 
     // Select transport based on mode
     switch *transportMode {
     case "http", "streamable":
-        log.Printf("Starting HTTP server on %s", *httpAddr)
+        slog.Info("Starting HTTP server", "addr", *httpAddr)
         handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
             return server
         }, nil)
 
         if err := http.ListenAndServe(*httpAddr, handler); err != nil {
-            log.Fatalf("HTTP server error: %v", err)
+            fatal("HTTP server error", err)
         }
 
     case "sse":
-        log.Printf("Starting SSE server on %s", *httpAddr)
+        slog.Info("Starting SSE server", "addr", *httpAddr)
         handler := mcp.NewSSEHandler(func(*http.Request) *mcp.Server {
             return server
         }, nil)
 
         if err := http.ListenAndServe(*httpAddr, handler); err != nil {
-            log.Fatalf("SSE server error: %v", err)
+            fatal("SSE server error", err)
         }
 
     case "stdio":
-        log.Println("Starting stdio transport...")
+        slog.Info("Starting stdio transport")
         if err := server.Run(ctx, &mcp.StdioTransport{}); err != nil {
-            log.Fatalf("MCP server error: %v", err)
+            fatal("MCP server error", err)
         }
 
     default:
-        log.Fatalf("Unknown transport mode: %s", *transportMode)
+        fatal("Unknown transport mode", fmt.Errorf("%q", *transportMode))
     }
 }
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+    var items []string
+    for _, item := range strings.Split(s, ",") {
+        if item = strings.TrimSpace(item); item != "" {
+            items = append(items, item)
+        }
+    }
+    return items
+}
+
+// fatal logs err and exits.
+func fatal(msg string, err error) {
+    slog.Error(msg, "error", err)
+    os.Exit(1)
+}
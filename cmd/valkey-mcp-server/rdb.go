@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/rdb"
+)
+
+// analyzeRDBCommand is the subcommand that analyzes an RDB file and exits.
+const analyzeRDBCommand = "analyze-rdb"
+
+// analyzeRDB runs the analyze-rdb subcommand, printing the report of the
+// file named in args as JSON to stdout. It returns the exit status.
+func analyzeRDB(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet(analyzeRDBCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: valkey-mcp-server %s [flags] FILE\n", analyzeRDBCommand)
+		fs.PrintDefaults()
+	}
+	delimiter := fs.String("delimiter", "", "Separator between key name segments (default \":\")")
+	depth := fs.Int("depth", 1, "Number of leading segments that form the group prefix")
+	limit := fs.Int("limit", 50, "Maximum number of groups reported (0: all)")
+	db := fs.Int("db", -1, "Only analyze keys of this database (-1: all)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer f.Close()
+
+	report, err := rdb.Analyze(context.Background(), f, rdb.AnalyzeOptions{Delimiter: *delimiter, Depth: *depth, Limit: *limit, DB: *db})
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to analyze %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
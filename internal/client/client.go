@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/types"
@@ -14,6 +19,11 @@ import (
 type Client struct {
 	client valkey.Client
 	url    types.ValkeyURL
+	db     types.DBIndex
+
+	// scripts records SHA1 digests returned by LoadScript.
+	scriptsMu sync.Mutex
+	scripts   map[string]bool
 }
 
 // Config holds the configuration for creating a new client.
@@ -21,6 +31,9 @@ type Config struct {
 	URL      types.ValkeyURL
 	Password string
 	DB       types.DBIndex
+	// SlowCommandThreshold is the duration above which commands are logged
+	// as slow. Zero means DefaultSlowCommandThreshold.
+	SlowCommandThreshold time.Duration
 }
 
 // New creates a new Valkey client with the given configuration.
@@ -43,10 +56,18 @@ func New(ctx context.Context, config Config) (*Client, error) {
 		InitAddress: []string{addr},
 	}
 
+	// Credentials and database in the URL apply unless set explicitly.
+	if password, ok := u.User.Password(); ok {
+		opts.Username = u.User.Username()
+		opts.Password = password
+	}
 	if config.Password != "" {
 		opts.Password = config.Password
 	}
 
+	if db, err := strconv.Atoi(strings.Trim(u.Path, "/")); err == nil && config.DB.Int() == 0 {
+		opts.SelectDB = db
+	}
 	if config.DB.Int() != 0 {
 		opts.SelectDB = config.DB.Int()
 	}
@@ -56,14 +77,22 @@ func New(ctx context.Context, config Config) (*Client, error) {
 		return nil, fmt.Errorf("failed to create valkey client: %w", err)
 	}
 
+	slowThreshold := config.SlowCommandThreshold
+	if slowThreshold <= 0 {
+		slowThreshold = DefaultSlowCommandThreshold
+	}
+	client = &observedClient{Client: client, observer: &observer{slowThreshold: slowThreshold}}
+
 	if err := client.Do(ctx, client.B().Ping().Build()).Error(); err != nil {
 		client.Close()
 		return nil, fmt.Errorf("failed to connect to valkey: %w", err)
 	}
 
 	return &Client{
-		client: client,
-		url:    config.URL,
+		client:  client,
+		url:     config.URL,
+		db:      types.DBIndex(opts.SelectDB),
+		scripts: make(map[string]bool),
 	}, nil
 }
 
@@ -212,6 +241,16 @@ func (c *Client) ExpireKey(ctx context.Context, key string, seconds int64) (bool
 	return count > 0, nil
 }
 
+// PExpireKey sets a key's time to live in milliseconds.
+func (c *Client) PExpireKey(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	resp := c.client.Do(ctx, c.client.B().Pexpire().Key(key).Milliseconds(milliseconds).Build())
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("PEXPIRE failed: %w", err)
+	}
+	count, _ := resp.AsInt64()
+	return count > 0, nil
+}
+
 func (c *Client) PersistKey(ctx context.Context, key string) (bool, error) {
 	resp := c.client.Do(ctx, c.client.B().Persist().Key(key).Build())
 	if err := resp.Error(); err != nil {
@@ -229,6 +268,17 @@ func (c *Client) RenameKey(ctx context.Context, oldKey, newKey string) (bool, er
 	return true, nil
 }
 
+// RenameKeyNX renames oldKey to newKey unless newKey exists, and reports
+// whether it did.
+func (c *Client) RenameKeyNX(ctx context.Context, oldKey, newKey string) (bool, error) {
+	resp := c.client.Do(ctx, c.client.B().Renamenx().Key(oldKey).Newkey(newKey).Build())
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("RENAMENX failed: %w", err)
+	}
+	n, err := resp.AsInt64()
+	return n == 1, err
+}
+
 func (c *Client) GetTTL(ctx context.Context, key string) (int64, error) {
 	resp := c.client.Do(ctx, c.client.B().Ttl().Key(key).Build())
 	if err := resp.Error(); err != nil {
@@ -238,6 +288,16 @@ func (c *Client) GetTTL(ctx context.Context, key string) (int64, error) {
 	return ttl, nil
 }
 
+// GetPTTL returns the remaining time to live of a key in milliseconds, -1 if
+// it has no expiry and -2 if it does not exist.
+func (c *Client) GetPTTL(ctx context.Context, key string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Pttl().Key(key).Build())
+	if err := resp.Error(); err != nil {
+		return -1, fmt.Errorf("PTTL failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
 func (c *Client) IncrementNumber(ctx context.Context, key string, amount int64) (int64, error) {
 	if amount == 1 {
 		resp := c.client.Do(ctx, c.client.B().Incr().Key(key).Build())
@@ -293,6 +353,149 @@ func (c *Client) GetRange(ctx context.Context, key string, start, end int64) ([]
 	return b, nil
 }
 
+// SetBit sets the bit at offset of key to value and returns its previous
+// value.
+func (c *Client) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Setbit().Key(key).Offset(offset).Value(value).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("SETBIT failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// GetBit returns the bit at offset of key.
+func (c *Client) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Getbit().Key(key).Offset(offset).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("GETBIT failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// bitRangeArgs returns the start, end and unit arguments of r. BITCOUNT
+// needs an end whenever a start is given, so a nil End becomes -1 when
+// withEnd is set.
+func bitRangeArgs(r *BitRange, withEnd bool) []string {
+	if r == nil {
+		return nil
+	}
+	args := []string{strconv.FormatInt(r.Start, 10)}
+	switch {
+	case r.End != nil:
+		args = append(args, strconv.FormatInt(*r.End, 10))
+	case withEnd || r.Bit:
+		args = append(args, "-1")
+	}
+	if r.Bit {
+		args = append(args, "BIT")
+	}
+	return args
+}
+
+// BitCount counts the set bits of key, within r when it is not nil.
+func (c *Client) BitCount(ctx context.Context, key string, r *BitRange) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("BITCOUNT").Keys(key).Args(bitRangeArgs(r, true)...).ReadOnly())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("BITCOUNT failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// BitPos returns the position of the first bit of key equal to bit, within r
+// when it is not nil, or -1 when there is none.
+func (c *Client) BitPos(ctx context.Context, key string, bit int64, r *BitRange) (int64, error) {
+	args := append([]string{strconv.FormatInt(bit, 10)}, bitRangeArgs(r, false)...)
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("BITPOS").Keys(key).Args(args...).ReadOnly())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("BITPOS failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// BitOp stores the result of a bitwise operation between keys in
+// destination and returns its length in bytes.
+func (c *Client) BitOp(ctx context.Context, operation, destination string, keys []string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("BITOP", operation).Keys(append([]string{destination}, keys...)...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("BITOP failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// BitField runs ops on key with BITFIELD, or BITFIELD_RO when readOnly.
+func (c *Client) BitField(ctx context.Context, key string, ops []BitFieldOp, readOnly bool) ([]*int64, error) {
+	name := "BITFIELD"
+	if readOnly {
+		name = "BITFIELD_RO"
+	}
+	var args []string
+	for _, op := range ops {
+		switch op.Op {
+		case "OVERFLOW":
+			args = append(args, op.Op, op.Overflow)
+		case "GET":
+			args = append(args, op.Op, op.Encoding, op.Offset)
+		default:
+			args = append(args, op.Op, op.Encoding, op.Offset, strconv.FormatInt(op.Value, 10))
+		}
+	}
+	cmd := c.client.B().Arbitrary(name).Keys(key).Args(args...)
+	var resp valkey.ValkeyResult
+	if readOnly {
+		resp = c.client.Do(ctx, cmd.ReadOnly())
+	} else {
+		resp = c.client.Do(ctx, cmd.Build())
+	}
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w", name, err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected %s reply: %w", name, err)
+	}
+	values := make([]*int64, len(arr))
+	for i, msg := range arr {
+		if msg.IsNil() {
+			continue
+		}
+		v, err := msg.AsInt64()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected %s reply: %w", name, err)
+		}
+		values[i] = &v
+	}
+	return values, nil
+}
+
+// PFAdd adds elements to the HyperLogLog at key, creating it if needed, and
+// reports whether its registers changed.
+func (c *Client) PFAdd(ctx context.Context, key string, elements []string) (bool, error) {
+	resp := c.client.Do(ctx, c.client.B().Pfadd().Key(key).Element(elements...).Build())
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("PFADD failed: %w", err)
+	}
+	changed, err := resp.AsInt64()
+	return changed == 1, err
+}
+
+// PFCount returns the estimated cardinality of the union of the
+// HyperLogLogs at keys. Missing keys count as empty.
+func (c *Client) PFCount(ctx context.Context, keys []string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Pfcount().Key(keys...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("PFCOUNT failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// PFMerge merges the HyperLogLogs at keys into destination.
+func (c *Client) PFMerge(ctx context.Context, destination string, keys []string) error {
+	if err := c.client.Do(ctx, c.client.B().Pfmerge().Destkey(destination).Sourcekey(keys...).Build()).Error(); err != nil {
+		return fmt.Errorf("PFMERGE failed: %w", err)
+	}
+	return nil
+}
+
 // Hash operations
 
 func (c *Client) GetMap(ctx context.Context, key string) (map[string][]byte, error) {
@@ -563,6 +766,143 @@ func (c *Client) TrimList(ctx context.Context, key string, start, stop int64) (b
 	return true, nil
 }
 
+// GetListPositions returns the indexes of element in the list at key with
+// LPOS, always asking for an array reply.
+func (c *Client) GetListPositions(ctx context.Context, key, element string, opts ListPositionOptions) ([]int64, error) {
+	args := []string{element}
+	if opts.Rank != 0 {
+		args = append(args, "RANK", strconv.FormatInt(opts.Rank, 10))
+	}
+	args = append(args, "COUNT", strconv.FormatInt(opts.Count, 10))
+	if opts.MaxLen > 0 {
+		args = append(args, "MAXLEN", strconv.FormatInt(opts.MaxLen, 10))
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("LPOS").Keys(key).Args(args...).ReadOnly())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return []int64{}, nil
+		}
+		return nil, fmt.Errorf("LPOS failed: %w", err)
+	}
+	positions, err := resp.AsIntSlice()
+	if err != nil {
+		return []int64{}, nil
+	}
+	return positions, nil
+}
+
+// InsertList inserts element before or after the first occurrence of pivot
+// with LINSERT and returns the new length.
+func (c *Client) InsertList(ctx context.Context, key string, before bool, pivot, element string) (int64, error) {
+	var cmd valkey.Completed
+	if before {
+		cmd = c.client.B().Linsert().Key(key).Before().Pivot(pivot).Element(element).Build()
+	} else {
+		cmd = c.client.B().Linsert().Key(key).After().Pivot(pivot).Element(element).Build()
+	}
+	resp := c.client.Do(ctx, cmd)
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("LINSERT failed: %w", err)
+	}
+	length, _ := resp.AsInt64()
+	return length, nil
+}
+
+// RemoveListElements removes occurrences of element with LREM: the first
+// count from the head when positive, the last -count from the tail when
+// negative and all of them when zero.
+func (c *Client) RemoveListElements(ctx context.Context, key string, count int64, element string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Lrem().Key(key).Count(count).Element(element).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("LREM failed: %w", err)
+	}
+	removed, _ := resp.AsInt64()
+	return removed, nil
+}
+
+// MoveList moves an element from the from end of source to the to end of
+// destination with LMOVE.
+func (c *Client) MoveList(ctx context.Context, source, destination, from, to string) ([]byte, bool, error) {
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("LMOVE").Keys(source, destination).Args(from, to).Build())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("LMOVE failed: %w", err)
+	}
+	value, err := resp.AsBytes()
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// MultiPopList pops up to count elements from the head, or the tail, of the
+// first non-empty list of keys with LMPOP.
+func (c *Client) MultiPopList(ctx context.Context, keys []string, tail bool, count int64) (*ListMultiPop, error) {
+	if count <= 0 {
+		count = 1
+	}
+	end := "LEFT"
+	if tail {
+		end = "RIGHT"
+	}
+	cmd := c.client.B().Arbitrary("LMPOP", strconv.Itoa(len(keys))).Keys(keys...).
+		Args(end, "COUNT", strconv.FormatInt(count, 10)).Build()
+	resp := c.client.Do(ctx, cmd)
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("LMPOP failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil || len(arr) < 2 {
+		return nil, fmt.Errorf("unexpected LMPOP reply")
+	}
+	pop := &ListMultiPop{}
+	if pop.Key, err = arr[0].ToString(); err != nil {
+		return nil, fmt.Errorf("unexpected LMPOP reply: %w", err)
+	}
+	elems, err := arr[1].ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected LMPOP reply: %w", err)
+	}
+	pop.Values = make([][]byte, 0, len(elems))
+	for _, elem := range elems {
+		b, err := elem.AsBytes()
+		if err != nil {
+			continue
+		}
+		pop.Values = append(pop.Values, b)
+	}
+	return pop, nil
+}
+
+// PushListExisting pushes values onto the head, or the tail, of the list at
+// key with LPUSHX or RPUSHX, doing nothing when the list does not exist.
+func (c *Client) PushListExisting(ctx context.Context, key string, values []string, tail bool) (int64, error) {
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	if tail {
+		resp := c.client.Do(ctx, c.client.B().Rpushx().Key(key).Element(values...).Build())
+		if err := resp.Error(); err != nil {
+			return 0, fmt.Errorf("RPUSHX failed: %w", err)
+		}
+		length, _ := resp.AsInt64()
+		return length, nil
+	}
+
+	resp := c.client.Do(ctx, c.client.B().Lpushx().Key(key).Element(values...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("LPUSHX failed: %w", err)
+	}
+	length, _ := resp.AsInt64()
+	return length, nil
+}
+
 // Ensure Client implements ValkeyClient at compile time
 var _ ValkeyClient = (*Client)(nil)
 
@@ -695,6 +1035,237 @@ func (c *Client) ObjectEncoding(ctx context.Context, key string) (string, error)
 	return resp.ToString()
 }
 
+// ScanKeys runs a single SCAN iteration and returns the keys found together with
+// the cursor for the next call. A returned cursor of 0 means the scan is complete.
+func (c *Client) ScanKeys(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	return scan(ctx, c.client, cursor, pattern, count)
+}
+
+// ScanNodes returns the addresses of the nodes a full keyspace scan must
+// visit: every primary of a cluster, or a single empty address for a
+// standalone server.
+func (c *Client) ScanNodes(ctx context.Context) ([]string, error) {
+	nodes := c.client.Nodes()
+	if len(nodes) <= 1 {
+		return []string{""}, nil
+	}
+	var primaries []string
+	for addr, node := range nodes {
+		role, err := node.Do(ctx, node.B().Role().Build()).ToArray()
+		if err != nil {
+			return nil, fmt.Errorf("ROLE failed on %s: %w", addr, err)
+		}
+		if len(role) > 0 {
+			if name, _ := role[0].ToString(); name == "master" {
+				primaries = append(primaries, addr)
+			}
+		}
+	}
+	sort.Strings(primaries)
+	return primaries, nil
+}
+
+// ScanNodeKeys runs SCAN on the node with the given address, as returned by
+// ScanNodes. An empty address scans through the default connection.
+func (c *Client) ScanNodeKeys(ctx context.Context, node string, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	if node == "" {
+		return c.ScanKeys(ctx, cursor, pattern, count)
+	}
+	conn, ok := c.client.Nodes()[node]
+	if !ok {
+		return nil, 0, fmt.Errorf("node %s is no longer part of the cluster", node)
+	}
+	return scan(ctx, conn, cursor, pattern, count)
+}
+
+// scan runs one SCAN call on conn.
+func scan(ctx context.Context, conn valkey.Client, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	builder := conn.B().Scan().Cursor(cursor)
+	var resp valkey.ValkeyResult
+	switch {
+	case pattern != "" && count > 0:
+		resp = conn.Do(ctx, builder.Match(pattern).Count(count).Build())
+	case pattern != "":
+		resp = conn.Do(ctx, builder.Match(pattern).Build())
+	case count > 0:
+		resp = conn.Do(ctx, builder.Count(count).Build())
+	default:
+		resp = conn.Do(ctx, builder.Build())
+	}
+	if err := resp.Error(); err != nil {
+		return nil, 0, fmt.Errorf("SCAN failed: %w", err)
+	}
+	entry, err := resp.AsScanEntry()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse SCAN response: %w", err)
+	}
+	return entry.Elements, entry.Cursor, nil
+}
+
+// UnlinkKeys removes keys with UNLINK, which frees memory in the background,
+// and returns how many existed. Keys are sent as separate commands in one
+// round trip, so they may live in different cluster slots.
+func (c *Client) UnlinkKeys(ctx context.Context, keys []string) (int64, error) {
+	cmds := make(valkey.Commands, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, c.client.B().Unlink().Key(key).Build())
+	}
+	var removed int64
+	for _, resp := range c.client.DoMulti(ctx, cmds...) {
+		n, err := resp.AsInt64()
+		if err != nil {
+			return removed, fmt.Errorf("UNLINK failed: %w", err)
+		}
+		removed += n
+	}
+	return removed, nil
+}
+
+// ExpireKeys sets a TTL in seconds on each key and returns how many existed.
+func (c *Client) ExpireKeys(ctx context.Context, keys []string, seconds int64) (int64, error) {
+	cmds := make(valkey.Commands, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, c.client.B().Expire().Key(key).Seconds(seconds).Build())
+	}
+	var updated int64
+	for _, resp := range c.client.DoMulti(ctx, cmds...) {
+		n, err := resp.AsInt64()
+		if err != nil {
+			return updated, fmt.Errorf("EXPIRE failed: %w", err)
+		}
+		updated += n
+	}
+	return updated, nil
+}
+
+// InspectKeys runs TYPE, OBJECT ENCODING, MEMORY USAGE and PTTL for every
+// key in one round trip.
+func (c *Client) InspectKeys(ctx context.Context, keys []string) ([]KeyInfo, error) {
+	const perKey = 4
+	cmds := make(valkey.Commands, 0, perKey*len(keys))
+	for _, key := range keys {
+		cmds = append(cmds,
+			c.client.B().Type().Key(key).Build(),
+			c.client.B().ObjectEncoding().Key(key).Build(),
+			c.client.B().MemoryUsage().Key(key).Build(),
+			c.client.B().Pttl().Key(key).Build(),
+		)
+	}
+	resps := c.client.DoMulti(ctx, cmds...)
+
+	infos := make([]KeyInfo, len(keys))
+	for i, key := range keys {
+		r := resps[i*perKey : (i+1)*perKey]
+		info := KeyInfo{Key: key}
+		var err error
+		if info.Type, err = r[0].ToString(); err != nil {
+			return nil, fmt.Errorf("TYPE failed: %w", err)
+		}
+		if info.Type != "none" {
+			// The key may expire between TYPE and the other commands, which
+			// then return nil.
+			if info.Encoding, err = r[1].ToString(); err != nil && !valkey.IsValkeyNil(err) {
+				return nil, fmt.Errorf("OBJECT ENCODING failed: %w", err)
+			}
+			if info.MemoryBytes, err = r[2].AsInt64(); err != nil && !valkey.IsValkeyNil(err) {
+				return nil, fmt.Errorf("MEMORY USAGE failed: %w", err)
+			}
+			if info.PTTL, err = r[3].AsInt64(); err != nil {
+				return nil, fmt.Errorf("PTTL failed: %w", err)
+			}
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
+
+// KeyLengths runs the size command matching each key's type in one round
+// trip.
+func (c *Client) KeyLengths(ctx context.Context, keys, types []string) ([]int64, error) {
+	if len(keys) != len(types) {
+		return nil, fmt.Errorf("got %d types for %d keys", len(types), len(keys))
+	}
+	lengths := make([]int64, len(keys))
+	cmds := make(valkey.Commands, 0, len(keys))
+	indexes := make([]int, 0, len(keys))
+	for i, key := range keys {
+		var cmd valkey.Completed
+		switch types[i] {
+		case "string":
+			cmd = c.client.B().Strlen().Key(key).Build()
+		case "hash":
+			cmd = c.client.B().Hlen().Key(key).Build()
+		case "list":
+			cmd = c.client.B().Llen().Key(key).Build()
+		case "set":
+			cmd = c.client.B().Scard().Key(key).Build()
+		case "zset":
+			cmd = c.client.B().Zcard().Key(key).Build()
+		case "stream":
+			cmd = c.client.B().Xlen().Key(key).Build()
+		default:
+			continue
+		}
+		cmds = append(cmds, cmd)
+		indexes = append(indexes, i)
+	}
+	for j, resp := range c.client.DoMulti(ctx, cmds...) {
+		n, err := resp.AsInt64()
+		if err != nil {
+			// WRONGTYPE means the key was replaced since its type was read.
+			if ve, ok := valkey.IsValkeyErr(err); ok && strings.HasPrefix(ve.Error(), "WRONGTYPE") {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get length of key %q: %w", keys[indexes[j]], err)
+		}
+		lengths[indexes[j]] = n
+	}
+	return lengths, nil
+}
+
+// ObjectFreqs runs OBJECT FREQ for every key in one round trip.
+func (c *Client) ObjectFreqs(ctx context.Context, keys []string) ([]int64, error) {
+	cmds := make(valkey.Commands, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, c.client.B().ObjectFreq().Key(key).Build())
+	}
+	return objectCounters(c.client.DoMulti(ctx, cmds...), "OBJECT FREQ")
+}
+
+// ObjectIdletimes runs OBJECT IDLETIME for every key in one round trip.
+func (c *Client) ObjectIdletimes(ctx context.Context, keys []string) ([]int64, error) {
+	cmds := make(valkey.Commands, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, c.client.B().ObjectIdletime().Key(key).Build())
+	}
+	return objectCounters(c.client.DoMulti(ctx, cmds...), "OBJECT IDLETIME")
+}
+
+// objectCounters parses OBJECT subcommand replies, mapping missing keys to -1.
+func objectCounters(resps []valkey.ValkeyResult, command string) ([]int64, error) {
+	values := make([]int64, len(resps))
+	for i, resp := range resps {
+		n, err := resp.AsInt64()
+		switch {
+		case valkey.IsValkeyNil(err):
+			n = -1
+		case err != nil:
+			return nil, fmt.Errorf("%s failed: %w", command, err)
+		}
+		values[i] = n
+	}
+	return values, nil
+}
+
+// GetKeyType returns the type of a key ("none" if it does not exist).
+func (c *Client) GetKeyType(ctx context.Context, key string) (string, error) {
+	resp := c.client.Do(ctx, c.client.B().Type().Key(key).Build())
+	if err := resp.Error(); err != nil {
+		return "", fmt.Errorf("TYPE failed: %w", err)
+	}
+	return resp.ToString()
+}
+
 // GetMapLength gets the number of fields in a hash.
 func (c *Client) GetMapLength(ctx context.Context, key string) (int64, error) {
 	resp := c.client.Do(ctx, c.client.B().Hlen().Key(key).Build())
@@ -836,45 +1407,335 @@ func (c *Client) SetDifference(ctx context.Context, firstKey string, otherKeys [
 	return result, nil
 }
 
-// AddStream adds an entry to a stream.
-func (c *Client) AddStream(ctx context.Context, key string, id string, fields map[string]string) (string, error) {
-	builder := c.client.B().Xadd().Key(key).Id(id).FieldValue()
-	for k, v := range fields {
-		builder = builder.FieldValue(k, v)
+// GetSortedSetRange gets members of a sorted set by rank, with their scores.
+func (c *Client) GetSortedSetRange(ctx context.Context, key string, start, stop int64) ([]SortedSetMember, error) {
+	resp := c.client.Do(ctx, c.client.B().Zrange().Key(key).Min(strconv.FormatInt(start, 10)).Max(strconv.FormatInt(stop, 10)).Withscores().Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("ZRANGE failed: %w", err)
+	}
+	// AsZScores handles both RESP2 (flat member/score array) and RESP3 (array of pairs).
+	scores, err := resp.AsZScores()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected ZRANGE reply: %w", err)
+	}
+	result := make([]SortedSetMember, len(scores))
+	for i, s := range scores {
+		result[i] = SortedSetMember{Member: []byte(s.Member), Score: s.Score}
+	}
+	return result, nil
+}
+
+// AddSortedSet adds members to a sorted set, updating the score of members
+// already present. It returns the number of members added.
+func (c *Client) AddSortedSet(ctx context.Context, key string, members []SortedSetMember) (int64, error) {
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	builder := c.client.B().Zadd().Key(key).ScoreMember()
+	for _, m := range members {
+		builder = builder.ScoreMember(m.Score, string(m.Member))
 	}
 	resp := c.client.Do(ctx, builder.Build())
 	if err := resp.Error(); err != nil {
-		return "", fmt.Errorf("XADD failed: %w", err)
+		return 0, fmt.Errorf("ZADD failed: %w", err)
 	}
-	return resp.ToString()
+
+	count, _ := resp.AsInt64()
+	return count, nil
 }
 
-// parseStreamEntry parses a single stream entry from the raw Valkey response element.
-// It is shared between GetStreamRange and ReadStream.
-func parseStreamEntry(entryElem valkey.ValkeyMessage) (StreamEntry, error) {
-	entryArr, err := entryElem.ToArray()
-	if err != nil || len(entryArr) < 2 {
-		return StreamEntry{}, fmt.Errorf("invalid stream entry format")
+// formatFloat formats f as the shortest decimal that parses back to it.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// GeoAdd adds locations to the geo set at key, moving members already
+// present. It returns the number of members added, plus those moved with
+// opts.CH.
+func (c *Client) GeoAdd(ctx context.Context, key string, locations []GeoLocation, opts GeoAddOptions) (int64, error) {
+	var args []string
+	if opts.NX {
+		args = append(args, "NX")
 	}
-	id, err := entryArr[0].ToString()
-	if err != nil {
-		return StreamEntry{}, fmt.Errorf("invalid stream entry ID")
+	if opts.XX {
+		args = append(args, "XX")
 	}
-	// In RESP3, stream entry field-values are returned as a MAP type (%),
-	// not a flat array. AsMap handles both RESP2 (flat array) and RESP3 (map) formats.
-	fieldMap, err := entryArr[1].AsMap()
-	if err != nil {
-		return StreamEntry{}, fmt.Errorf("invalid stream entry fields")
+	if opts.CH {
+		args = append(args, "CH")
 	}
-	fields := make(map[string][]byte, len(fieldMap))
-	for fieldName, fieldMsg := range fieldMap {
-		fieldVal, err := fieldMsg.AsBytes()
-		if err != nil {
-			continue
-		}
-		fields[fieldName] = fieldVal
+	for _, l := range locations {
+		args = append(args, formatFloat(l.Longitude), formatFloat(l.Latitude), l.Member)
 	}
-	return StreamEntry{ID: id, FieldValues: fields}, nil
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("GEOADD").Keys(key).Args(args...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("GEOADD failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// GeoPos returns the position of each member of the geo set at key.
+func (c *Client) GeoPos(ctx context.Context, key string, members []string) ([]*GeoPosition, error) {
+	resp := c.client.Do(ctx, c.client.B().Geopos().Key(key).Member(members...).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("GEOPOS failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected GEOPOS reply: %w", err)
+	}
+	positions := make([]*GeoPosition, len(arr))
+	for i, msg := range arr {
+		if msg.IsNil() {
+			continue
+		}
+		coords, err := msg.AsFloatSlice()
+		if err != nil || len(coords) != 2 {
+			return nil, fmt.Errorf("unexpected GEOPOS reply for %q", members[i])
+		}
+		positions[i] = &GeoPosition{Longitude: coords[0], Latitude: coords[1]}
+	}
+	return positions, nil
+}
+
+// GeoDist returns the distance between two members of the geo set at key in
+// unit.
+func (c *Client) GeoDist(ctx context.Context, key, member1, member2, unit string) (*float64, error) {
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("GEODIST").Keys(key).Args(member1, member2, unit).ReadOnly())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("GEODIST failed: %w", err)
+	}
+	distance, err := resp.AsFloat64()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected GEODIST reply: %w", err)
+	}
+	return &distance, nil
+}
+
+// GeoHash returns the geohash string of each member of the geo set at key.
+func (c *Client) GeoHash(ctx context.Context, key string, members []string) ([]*string, error) {
+	resp := c.client.Do(ctx, c.client.B().Geohash().Key(key).Member(members...).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("GEOHASH failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected GEOHASH reply: %w", err)
+	}
+	hashes := make([]*string, len(arr))
+	for i, msg := range arr {
+		if msg.IsNil() {
+			continue
+		}
+		hash, err := msg.ToString()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected GEOHASH reply: %w", err)
+		}
+		hashes[i] = &hash
+	}
+	return hashes, nil
+}
+
+// geoSearchArgs returns the GEOSEARCH arguments of q, without its With
+// options unless withs is set.
+func geoSearchArgs(q GeoSearchQuery, withs bool) []string {
+	var args []string
+	if q.FromMember != "" {
+		args = append(args, "FROMMEMBER", q.FromMember)
+	} else if q.FromLonLat != nil {
+		args = append(args, "FROMLONLAT", formatFloat(q.FromLonLat.Longitude), formatFloat(q.FromLonLat.Latitude))
+	}
+	if q.Radius > 0 {
+		args = append(args, "BYRADIUS", formatFloat(q.Radius), q.Unit)
+	} else {
+		args = append(args, "BYBOX", formatFloat(q.Width), formatFloat(q.Height), q.Unit)
+	}
+	if q.Order != "" {
+		args = append(args, q.Order)
+	}
+	if q.Count > 0 {
+		args = append(args, "COUNT", strconv.FormatInt(q.Count, 10))
+		if q.Any {
+			args = append(args, "ANY")
+		}
+	}
+	if withs && q.WithCoord {
+		args = append(args, "WITHCOORD")
+	}
+	if withs && q.WithDist {
+		args = append(args, "WITHDIST")
+	}
+	if withs && q.WithHash {
+		args = append(args, "WITHHASH")
+	}
+	return args
+}
+
+// GeoSearch returns the members of the geo set at key that q matches.
+func (c *Client) GeoSearch(ctx context.Context, key string, q GeoSearchQuery) ([]GeoMatch, error) {
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("GEOSEARCH").Keys(key).Args(geoSearchArgs(q, true)...).ReadOnly())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("GEOSEARCH failed: %w", err)
+	}
+	locations, err := resp.AsGeosearch()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected GEOSEARCH reply: %w", err)
+	}
+	matches := make([]GeoMatch, len(locations))
+	for i, l := range locations {
+		matches[i].Member = l.Name
+		if q.WithDist {
+			matches[i].Distance = &l.Dist
+		}
+		if q.WithHash {
+			matches[i].Hash = &l.GeoHash
+		}
+		if q.WithCoord {
+			matches[i].Position = &GeoPosition{Longitude: l.Longitude, Latitude: l.Latitude}
+		}
+	}
+	return matches, nil
+}
+
+// GeoSearchStore stores the members of the geo set at key that q matches in
+// destination and returns how many there are.
+func (c *Client) GeoSearchStore(ctx context.Context, destination, key string, q GeoSearchQuery, storeDist bool) (int64, error) {
+	args := geoSearchArgs(q, false)
+	if storeDist {
+		args = append(args, "STOREDIST")
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("GEOSEARCHSTORE").Keys(destination, key).Args(args...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("GEOSEARCHSTORE failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// AddStream adds an entry to a stream. With opts.NoMkStream and a missing
+// stream nothing is added and the returned ID is empty.
+func (c *Client) AddStream(ctx context.Context, key string, id string, fields map[string]string, opts StreamAddOptions) (string, error) {
+	var args []string
+	if opts.NoMkStream {
+		args = append(args, "NOMKSTREAM")
+	}
+	if opts.Trim != nil {
+		trimArgs, err := streamTrimArgs(*opts.Trim)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, trimArgs...)
+	}
+	args = append(args, id)
+	for k, v := range fields {
+		args = append(args, k, v)
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("XADD").Keys(key).Args(args...).Build())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("XADD failed: %w", err)
+	}
+	return resp.ToString()
+}
+
+// streamTrimArgs returns the XADD and XTRIM arguments of trim.
+func streamTrimArgs(trim StreamTrim) ([]string, error) {
+	strategy := strings.ToUpper(trim.Strategy)
+	if strategy != "MAXLEN" && strategy != "MINID" {
+		return nil, fmt.Errorf("trim strategy must be MAXLEN or MINID, got %q", trim.Strategy)
+	}
+	if trim.Threshold == "" {
+		return nil, fmt.Errorf("trim threshold cannot be empty")
+	}
+	if trim.Limit != 0 && !trim.Approximate {
+		return nil, fmt.Errorf("LIMIT requires approximate trimming")
+	}
+	args := []string{strategy}
+	if trim.Approximate {
+		args = append(args, "~")
+	}
+	args = append(args, trim.Threshold)
+	if trim.Limit > 0 {
+		args = append(args, "LIMIT", strconv.FormatInt(trim.Limit, 10))
+	}
+	return args, nil
+}
+
+// TrimStream trims the stream at key and returns the number of entries
+// evicted.
+func (c *Client) TrimStream(ctx context.Context, key string, trim StreamTrim) (int64, error) {
+	args, err := streamTrimArgs(trim)
+	if err != nil {
+		return 0, err
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("XTRIM").Keys(key).Args(args...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("XTRIM failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// DeleteStreamEntries deletes ids from the stream at key and returns the
+// number deleted.
+func (c *Client) DeleteStreamEntries(ctx context.Context, key string, ids []string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Xdel().Key(key).Id(ids...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("XDEL failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// SetStreamID sets the last generated ID of the stream at key with XSETID,
+// along with the count of entries ever added and the highest deleted ID.
+func (c *Client) SetStreamID(ctx context.Context, key, lastID string, entriesAdded int64, maxDeletedID string) error {
+	cmd := c.client.B().Xsetid().Key(key).LastId(lastID)
+	var built valkey.Completed
+	switch {
+	case entriesAdded > 0 && maxDeletedID != "":
+		built = cmd.Entriesadded(entriesAdded).Maxdeletedid(maxDeletedID).Build()
+	case entriesAdded > 0:
+		built = cmd.Entriesadded(entriesAdded).Build()
+	case maxDeletedID != "":
+		built = cmd.Maxdeletedid(maxDeletedID).Build()
+	default:
+		built = cmd.Build()
+	}
+	if err := c.client.Do(ctx, built).Error(); err != nil {
+		return fmt.Errorf("XSETID failed: %w", err)
+	}
+	return nil
+}
+
+// parseStreamEntry parses a single stream entry from the raw Valkey response element.
+// It is shared between GetStreamRange and ReadStream.
+func parseStreamEntry(entryElem valkey.ValkeyMessage) (StreamEntry, error) {
+	entryArr, err := entryElem.ToArray()
+	if err != nil || len(entryArr) < 2 {
+		return StreamEntry{}, fmt.Errorf("invalid stream entry format")
+	}
+	id, err := entryArr[0].ToString()
+	if err != nil {
+		return StreamEntry{}, fmt.Errorf("invalid stream entry ID")
+	}
+	// In RESP3, stream entry field-values are returned as a MAP type (%),
+	// not a flat array. AsMap handles both RESP2 (flat array) and RESP3 (map) formats.
+	fieldMap, err := entryArr[1].AsMap()
+	if err != nil {
+		return StreamEntry{}, fmt.Errorf("invalid stream entry fields")
+	}
+	fields := make(map[string][]byte, len(fieldMap))
+	for fieldName, fieldMsg := range fieldMap {
+		fieldVal, err := fieldMsg.AsBytes()
+		if err != nil {
+			continue
+		}
+		fields[fieldName] = fieldVal
+	}
+	return StreamEntry{ID: id, FieldValues: fields}, nil
 }
 
 // GetStreamRange gets entries from a stream within a range.
@@ -889,11 +1750,31 @@ func (c *Client) GetStreamRange(ctx context.Context, key string, start string, e
 	if err := resp.Error(); err != nil {
 		return nil, fmt.Errorf("XRANGE failed: %w", err)
 	}
+	return parseStreamRange(resp), nil
+}
 
-	// XRANGE raw format: [ [id, [f1, v1, f2, v2, ...]], ... ]
+// GetStreamRevRange gets entries from a stream within a range, from end down
+// to start.
+func (c *Client) GetStreamRevRange(ctx context.Context, key string, end string, start string, count int64) ([]StreamEntry, error) {
+	startBuilder := c.client.B().Xrevrange().Key(key).End(end).Start(start)
+	var resp valkey.ValkeyResult
+	if count > 0 {
+		resp = c.client.Do(ctx, startBuilder.Count(count).Build())
+	} else {
+		resp = c.client.Do(ctx, startBuilder.Build())
+	}
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XREVRANGE failed: %w", err)
+	}
+	return parseStreamRange(resp), nil
+}
+
+// parseStreamRange parses the reply of XRANGE or XREVRANGE:
+// [ [id, [f1, v1, f2, v2, ...]], ... ]
+func parseStreamRange(resp valkey.ValkeyResult) []StreamEntry {
 	arr, err := resp.ToArray()
 	if err != nil {
-		return []StreamEntry{}, nil
+		return []StreamEntry{}
 	}
 	result := make([]StreamEntry, 0, len(arr))
 	for _, entryElem := range arr {
@@ -903,7 +1784,7 @@ func (c *Client) GetStreamRange(ctx context.Context, key string, start string, e
 		}
 		result = append(result, entry)
 	}
-	return result, nil
+	return result
 }
 
 // GetStreamLength gets the length of a stream.
@@ -929,10 +1810,14 @@ func (c *Client) ReadStream(ctx context.Context, key string, id string, count in
 	if err := resp.Error(); err != nil {
 		return nil, fmt.Errorf("XREAD failed: %w", err)
 	}
+	return parseStreamRead(resp), nil
+}
 
+// parseStreamRead parses the reply of XREAD or XREADGROUP for one stream.
+func parseStreamRead(resp valkey.ValkeyResult) []StreamEntry {
 	msg, err := resp.ToMessage()
 	if err != nil {
-		return []StreamEntry{}, nil
+		return []StreamEntry{}
 	}
 
 	result := make([]StreamEntry, 0)
@@ -955,18 +1840,18 @@ func (c *Client) ReadStream(ctx context.Context, key string, id string, count in
 		// RESP3: outer is a MAP { stream_name: entries_array }
 		outerMap, err := msg.AsMap()
 		if err != nil {
-			return []StreamEntry{}, nil
+			return []StreamEntry{}
 		}
 		for _, entriesMsg := range outerMap {
 			parseEntries(entriesMsg)
 		}
-		return result, nil
+		return result
 	}
 
 	// RESP2: outer is [ [stream_key, entries_array], ... ]
 	outerArr, err := msg.ToArray()
 	if err != nil || len(outerArr) == 0 {
-		return []StreamEntry{}, nil
+		return []StreamEntry{}
 	}
 	for _, streamElem := range outerArr {
 		streamArr, err := streamElem.ToArray()
@@ -975,9 +1860,783 @@ func (c *Client) ReadStream(ctx context.Context, key string, id string, count in
 		}
 		parseEntries(streamArr[1])
 	}
+	return result
+}
+
+// parseStreamEntries parses an array of stream entries, as returned by XCLAIM
+// and XAUTOCLAIM. Entries deleted from the stream are skipped.
+func parseStreamEntries(msg valkey.ValkeyMessage) ([]StreamEntry, error) {
+	arr, err := msg.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]StreamEntry, 0, len(arr))
+	for _, elem := range arr {
+		if elem.IsNil() {
+			continue
+		}
+		entry, err := parseStreamEntry(elem)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// CreateStreamGroup creates consumer group on the stream at key, delivering
+// entries after id ("$" for new entries only). mkstream creates an empty
+// stream when key does not exist. entriesRead, when set, is the number of
+// entries the group is considered to have read, for lag reporting.
+func (c *Client) CreateStreamGroup(ctx context.Context, key, group, id string, mkstream bool, entriesRead *int64) error {
+	cmd := c.client.B().XgroupCreate().Key(key).Group(group).Id(id)
+	var completed valkey.Completed
+	switch {
+	case mkstream && entriesRead != nil:
+		completed = cmd.Mkstream().Entriesread(*entriesRead).Build()
+	case mkstream:
+		completed = cmd.Mkstream().Build()
+	case entriesRead != nil:
+		completed = cmd.Entriesread(*entriesRead).Build()
+	default:
+		completed = cmd.Build()
+	}
+	if err := c.client.Do(ctx, completed).Error(); err != nil {
+		return fmt.Errorf("XGROUP CREATE failed: %w", err)
+	}
+	return nil
+}
+
+// DestroyStreamGroup removes consumer group and reports whether it existed.
+func (c *Client) DestroyStreamGroup(ctx context.Context, key, group string) (bool, error) {
+	resp := c.client.Do(ctx, c.client.B().XgroupDestroy().Key(key).Group(group).Build())
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("XGROUP DESTROY failed: %w", err)
+	}
+	n, err := resp.AsInt64()
+	return n == 1, err
+}
+
+// SetStreamGroupID sets the last delivered ID of consumer group.
+func (c *Client) SetStreamGroupID(ctx context.Context, key, group, id string, entriesRead *int64) error {
+	cmd := c.client.B().XgroupSetid().Key(key).Group(group).Id(id)
+	var completed valkey.Completed
+	if entriesRead != nil {
+		completed = cmd.Entriesread(*entriesRead).Build()
+	} else {
+		completed = cmd.Build()
+	}
+	if err := c.client.Do(ctx, completed).Error(); err != nil {
+		return fmt.Errorf("XGROUP SETID failed: %w", err)
+	}
+	return nil
+}
+
+// CreateStreamConsumer adds consumer to group and reports whether it was
+// created.
+func (c *Client) CreateStreamConsumer(ctx context.Context, key, group, consumer string) (bool, error) {
+	resp := c.client.Do(ctx, c.client.B().XgroupCreateconsumer().Key(key).Group(group).Consumer(consumer).Build())
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("XGROUP CREATECONSUMER failed: %w", err)
+	}
+	n, err := resp.AsInt64()
+	return n == 1, err
+}
+
+// DeleteStreamConsumer removes consumer from group and returns the number of
+// pending entries it still owned.
+func (c *Client) DeleteStreamConsumer(ctx context.Context, key, group, consumer string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().XgroupDelconsumer().Key(key).Group(group).Consumername(consumer).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("XGROUP DELCONSUMER failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// ReadStreamGroup reads entries as consumer of group with XREADGROUP. An id
+// of ">" delivers new entries; any other id returns the consumer's pending
+// entries after it. noack skips adding the entries to the pending list.
+func (c *Client) ReadStreamGroup(ctx context.Context, key, group, consumer, id string, count int64, noack bool) ([]StreamEntry, error) {
+	args := []string{"GROUP", group, consumer}
+	if count > 0 {
+		args = append(args, "COUNT", strconv.FormatInt(count, 10))
+	}
+	if noack {
+		args = append(args, "NOACK")
+	}
+	args = append(args, "STREAMS")
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("XREADGROUP").Args(args...).Keys(key).Args(id).Build())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return []StreamEntry{}, nil
+		}
+		return nil, fmt.Errorf("XREADGROUP failed: %w", err)
+	}
+	return parseStreamRead(resp), nil
+}
+
+// AckStream acknowledges ids in group and returns how many were pending.
+func (c *Client) AckStream(ctx context.Context, key, group string, ids []string) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().Xack().Key(key).Group(group).Id(ids...).Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("XACK failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// PendingStreamSummary returns the summary form of XPENDING for group.
+func (c *Client) PendingStreamSummary(ctx context.Context, key, group string) (*StreamPendingSummary, error) {
+	resp := c.client.Do(ctx, c.client.B().Xpending().Key(key).Group(group).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XPENDING failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil || len(arr) < 4 {
+		return nil, fmt.Errorf("unexpected XPENDING reply")
+	}
+
+	summary := &StreamPendingSummary{Consumers: map[string]int64{}}
+	if summary.Count, err = arr[0].AsInt64(); err != nil {
+		return nil, fmt.Errorf("unexpected XPENDING reply: %w", err)
+	}
+	// The IDs and consumers are nil when nothing is pending.
+	summary.Lowest, _ = arr[1].ToString()
+	summary.Highest, _ = arr[2].ToString()
+	consumers, _ := arr[3].ToArray()
+	for _, consumer := range consumers {
+		pair, err := consumer.ToArray()
+		if err != nil || len(pair) < 2 {
+			continue
+		}
+		name, _ := pair[0].ToString()
+		count, _ := pair[1].AsInt64()
+		summary.Consumers[name] = count
+	}
+	return summary, nil
+}
+
+// PendingStream returns the pending entries of group selected by opts, with
+// the extended form of XPENDING.
+func (c *Client) PendingStream(ctx context.Context, key, group string, opts StreamPendingOptions) ([]StreamPendingEntry, error) {
+	start, end, count := opts.Start, opts.End, opts.Count
+	if start == "" {
+		start = "-"
+	}
+	if end == "" {
+		end = "+"
+	}
+	if count <= 0 {
+		count = 100
+	}
+	args := []string{group}
+	if opts.MinIdle > 0 {
+		args = append(args, "IDLE", strconv.FormatInt(opts.MinIdle, 10))
+	}
+	args = append(args, start, end, strconv.FormatInt(count, 10))
+	if opts.Consumer != "" {
+		args = append(args, opts.Consumer)
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("XPENDING").Keys(key).Args(args...).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XPENDING failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected XPENDING reply: %w", err)
+	}
+
+	entries := make([]StreamPendingEntry, 0, len(arr))
+	for _, elem := range arr {
+		fields, err := elem.ToArray()
+		if err != nil || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected XPENDING entry")
+		}
+		var entry StreamPendingEntry
+		entry.ID, _ = fields[0].ToString()
+		entry.Consumer, _ = fields[1].ToString()
+		entry.IdleMs, _ = fields[2].AsInt64()
+		entry.Deliveries, _ = fields[3].AsInt64()
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ClaimStream transfers ids idle for at least minIdle milliseconds to
+// consumer with XCLAIM and returns the claimed entries.
+func (c *Client) ClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, ids []string, opts StreamClaimOptions) ([]StreamEntry, error) {
+	args := append([]string{group, consumer, strconv.FormatInt(minIdle, 10)}, ids...)
+	if opts.Idle != nil {
+		args = append(args, "IDLE", strconv.FormatInt(*opts.Idle, 10))
+	}
+	if opts.Time != nil {
+		args = append(args, "TIME", strconv.FormatInt(*opts.Time, 10))
+	}
+	if opts.RetryCount != nil {
+		args = append(args, "RETRYCOUNT", strconv.FormatInt(*opts.RetryCount, 10))
+	}
+	if opts.Force {
+		args = append(args, "FORCE")
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("XCLAIM").Keys(key).Args(args...).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XCLAIM failed: %w", err)
+	}
+	msg, err := resp.ToMessage()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected XCLAIM reply: %w", err)
+	}
+	entries, err := parseStreamEntries(msg)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected XCLAIM reply: %w", err)
+	}
+	return entries, nil
+}
+
+// AutoClaimStream transfers up to count pending entries from start on, idle
+// for at least minIdle milliseconds, to consumer with XAUTOCLAIM.
+func (c *Client) AutoClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, start string, count int64) (*StreamAutoClaim, error) {
+	cmd := c.client.B().Xautoclaim().Key(key).Group(group).Consumer(consumer).MinIdleTime(strconv.FormatInt(minIdle, 10)).Start(start)
+	var resp valkey.ValkeyResult
+	if count > 0 {
+		resp = c.client.Do(ctx, cmd.Count(count).Build())
+	} else {
+		resp = c.client.Do(ctx, cmd.Build())
+	}
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XAUTOCLAIM failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil || len(arr) < 2 {
+		return nil, fmt.Errorf("unexpected XAUTOCLAIM reply")
+	}
+
+	result := &StreamAutoClaim{Deleted: []string{}}
+	if result.NextStart, err = arr[0].ToString(); err != nil {
+		return nil, fmt.Errorf("unexpected XAUTOCLAIM reply: %w", err)
+	}
+	if result.Entries, err = parseStreamEntries(arr[1]); err != nil {
+		return nil, fmt.Errorf("unexpected XAUTOCLAIM reply: %w", err)
+	}
+	// Deleted IDs are reported from Valkey 7.0 on.
+	if len(arr) > 2 {
+		deleted, _ := arr[2].AsStrSlice()
+		result.Deleted = append(result.Deleted, deleted...)
+	}
 	return result, nil
 }
 
+// GetStreamInfo returns XINFO STREAM of key. With full it uses the FULL form,
+// listing up to count entries and pending entries per group.
+// XINFO replies are flat field-value arrays in RESP2 and maps in RESP3; both
+// are read through AsMap.
+func (c *Client) GetStreamInfo(ctx context.Context, key string, full bool, count int64) (*StreamInfo, error) {
+	var resp valkey.ValkeyResult
+	switch {
+	case full && count > 0:
+		resp = c.client.Do(ctx, c.client.B().XinfoStream().Key(key).Full().Count(count).Build())
+	case full:
+		resp = c.client.Do(ctx, c.client.B().XinfoStream().Key(key).Full().Build())
+	default:
+		resp = c.client.Do(ctx, c.client.B().XinfoStream().Key(key).Build())
+	}
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XINFO STREAM failed: %w", err)
+	}
+	fields, err := resp.AsMap()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected XINFO STREAM reply: %w", err)
+	}
+
+	info := &StreamInfo{
+		Length:               infoInt(fields, "length"),
+		RadixTreeKeys:        infoInt(fields, "radix-tree-keys"),
+		RadixTreeNodes:       infoInt(fields, "radix-tree-nodes"),
+		LastGeneratedID:      infoString(fields, "last-generated-id"),
+		MaxDeletedEntryID:    infoString(fields, "max-deleted-entry-id"),
+		EntriesAdded:         infoInt(fields, "entries-added"),
+		RecordedFirstEntryID: infoString(fields, "recorded-first-entry-id"),
+		Groups:               infoInt(fields, "groups"),
+	}
+	for name, dst := range map[string]**StreamEntry{"first-entry": &info.FirstEntry, "last-entry": &info.LastEntry} {
+		if msg, ok := fields[name]; ok && !msg.IsNil() {
+			entry, err := parseStreamEntry(msg)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected XINFO STREAM reply: %w", err)
+			}
+			*dst = &entry
+		}
+	}
+	if !full {
+		return info, nil
+	}
+
+	if msg, ok := fields["entries"]; ok {
+		if info.Entries, err = parseStreamEntries(msg); err != nil {
+			return nil, fmt.Errorf("unexpected XINFO STREAM reply: %w", err)
+		}
+	}
+	groups, _ := infoArray(fields, "groups")
+	info.Groups = int64(len(groups))
+	info.GroupDetails = make([]StreamGroupDetail, 0, len(groups))
+	for _, groupMsg := range groups {
+		group, err := groupMsg.AsMap()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected XINFO STREAM group: %w", err)
+		}
+		detail := StreamGroupDetail{
+			Name:            infoString(group, "name"),
+			LastDeliveredID: infoString(group, "last-delivered-id"),
+			EntriesRead:     infoOptionalInt(group, "entries-read"),
+			Lag:             infoOptionalInt(group, "lag"),
+			Pending:         infoInt(group, "pel-count"),
+		}
+		// Group pending entries are [id, consumer, delivery-time, count].
+		pending, _ := infoArray(group, "pending")
+		for _, p := range pending {
+			arr, err := p.ToArray()
+			if err != nil || len(arr) < 4 {
+				return nil, fmt.Errorf("unexpected XINFO STREAM pending entry")
+			}
+			var delivery StreamPendingDelivery
+			delivery.ID, _ = arr[0].ToString()
+			delivery.Consumer, _ = arr[1].ToString()
+			delivery.DeliveryTime, _ = arr[2].AsInt64()
+			delivery.Deliveries, _ = arr[3].AsInt64()
+			detail.PendingEntries = append(detail.PendingEntries, delivery)
+		}
+		consumers, _ := infoArray(group, "consumers")
+		for _, consumerMsg := range consumers {
+			consumer, err := consumerMsg.AsMap()
+			if err != nil {
+				return nil, fmt.Errorf("unexpected XINFO STREAM consumer: %w", err)
+			}
+			cd := StreamConsumerDetail{
+				Name:       infoString(consumer, "name"),
+				SeenTime:   infoInt(consumer, "seen-time"),
+				ActiveTime: -1,
+				Pending:    infoInt(consumer, "pel-count"),
+			}
+			if active := infoOptionalInt(consumer, "active-time"); active != nil {
+				cd.ActiveTime = *active
+			}
+			// Consumer pending entries are [id, delivery-time, count].
+			pending, _ := infoArray(consumer, "pending")
+			for _, p := range pending {
+				arr, err := p.ToArray()
+				if err != nil || len(arr) < 3 {
+					return nil, fmt.Errorf("unexpected XINFO STREAM pending entry")
+				}
+				delivery := StreamPendingDelivery{Consumer: cd.Name}
+				delivery.ID, _ = arr[0].ToString()
+				delivery.DeliveryTime, _ = arr[1].AsInt64()
+				delivery.Deliveries, _ = arr[2].AsInt64()
+				cd.PendingEntries = append(cd.PendingEntries, delivery)
+			}
+			detail.Consumers = append(detail.Consumers, cd)
+		}
+		info.GroupDetails = append(info.GroupDetails, detail)
+	}
+	return info, nil
+}
+
+// GetStreamGroups returns the consumer groups of the stream at key.
+func (c *Client) GetStreamGroups(ctx context.Context, key string) ([]StreamGroupInfo, error) {
+	resp := c.client.Do(ctx, c.client.B().XinfoGroups().Key(key).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XINFO GROUPS failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected XINFO GROUPS reply: %w", err)
+	}
+	groups := make([]StreamGroupInfo, 0, len(arr))
+	for _, elem := range arr {
+		fields, err := elem.AsMap()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected XINFO GROUPS reply: %w", err)
+		}
+		groups = append(groups, StreamGroupInfo{
+			Name:            infoString(fields, "name"),
+			Consumers:       infoInt(fields, "consumers"),
+			Pending:         infoInt(fields, "pending"),
+			LastDeliveredID: infoString(fields, "last-delivered-id"),
+			EntriesRead:     infoOptionalInt(fields, "entries-read"),
+			Lag:             infoOptionalInt(fields, "lag"),
+		})
+	}
+	return groups, nil
+}
+
+// GetStreamConsumers returns the consumers of group.
+func (c *Client) GetStreamConsumers(ctx context.Context, key, group string) ([]StreamConsumerInfo, error) {
+	resp := c.client.Do(ctx, c.client.B().XinfoConsumers().Key(key).Group(group).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("XINFO CONSUMERS failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected XINFO CONSUMERS reply: %w", err)
+	}
+	consumers := make([]StreamConsumerInfo, 0, len(arr))
+	for _, elem := range arr {
+		fields, err := elem.AsMap()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected XINFO CONSUMERS reply: %w", err)
+		}
+		consumer := StreamConsumerInfo{
+			Name:       infoString(fields, "name"),
+			Pending:    infoInt(fields, "pending"),
+			IdleMs:     infoInt(fields, "idle"),
+			InactiveMs: -1,
+		}
+		if inactive := infoOptionalInt(fields, "inactive"); inactive != nil {
+			consumer.InactiveMs = *inactive
+		}
+		consumers = append(consumers, consumer)
+	}
+	return consumers, nil
+}
+
+// infoInt returns the integer field name of an XINFO reply, or 0.
+func infoInt(fields map[string]valkey.ValkeyMessage, name string) int64 {
+	msg, ok := fields[name]
+	if !ok {
+		return 0
+	}
+	n, _ := msg.AsInt64()
+	return n
+}
+
+// infoOptionalInt returns the integer field name of an XINFO reply, or nil
+// when it is missing or nil.
+func infoOptionalInt(fields map[string]valkey.ValkeyMessage, name string) *int64 {
+	msg, ok := fields[name]
+	if !ok || msg.IsNil() {
+		return nil
+	}
+	n, err := msg.AsInt64()
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// infoString returns the string field name of an XINFO reply, or "".
+func infoString(fields map[string]valkey.ValkeyMessage, name string) string {
+	msg, ok := fields[name]
+	if !ok {
+		return ""
+	}
+	s, _ := msg.ToString()
+	return s
+}
+
+// infoArray returns the array field name of an XINFO reply.
+func infoArray(fields map[string]valkey.ValkeyMessage, name string) ([]valkey.ValkeyMessage, error) {
+	msg, ok := fields[name]
+	if !ok || msg.IsNil() {
+		return nil, nil
+	}
+	return msg.ToArray()
+}
+
+// WatchKeyspace subscribes to keyspace notifications for keys in the client's
+// database matching keyPattern and calls onEvent for each one. It blocks until
+// ctx is cancelled or the subscription fails. The server only publishes events
+// when notify-keyspace-events enables them (for example "KA").
+func (c *Client) WatchKeyspace(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error {
+	if keyPattern == "" {
+		keyPattern = "*"
+	}
+	prefix := fmt.Sprintf("__keyspace@%d__:", c.db.Int())
+	cmd := c.client.B().Psubscribe().Pattern(prefix + keyPattern).Build()
+	err := c.client.Receive(ctx, cmd, func(msg valkey.PubSubMessage) {
+		onEvent(KeyspaceEvent{
+			DB:    c.db.Int(),
+			Key:   strings.TrimPrefix(msg.Channel, prefix),
+			Event: msg.Message,
+		})
+	})
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("PSUBSCRIBE failed: %w", err)
+	}
+	return nil
+}
+
+// WatchKeyevents is WatchKeyspace for servers that publish key-event
+// notifications ("E" in notify-keyspace-events) rather than keyspace ones.
+// Key-event channels are named after the event, so keys are matched against
+// keyPattern with path.Match on the client.
+func (c *Client) WatchKeyevents(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error {
+	prefix := fmt.Sprintf("__keyevent@%d__:", c.db.Int())
+	cmd := c.client.B().Psubscribe().Pattern(prefix + "*").Build()
+	err := c.client.Receive(ctx, cmd, func(msg valkey.PubSubMessage) {
+		if keyPattern != "" && keyPattern != "*" {
+			if ok, _ := path.Match(keyPattern, msg.Message); !ok {
+				return
+			}
+		}
+		onEvent(KeyspaceEvent{
+			DB:    c.db.Int(),
+			Key:   msg.Message,
+			Event: strings.TrimPrefix(msg.Channel, prefix),
+		})
+	})
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("PSUBSCRIBE failed: %w", err)
+	}
+	return nil
+}
+
+// Publish sends message to channel with PUBLISH, or SPUBLISH when sharded,
+// and returns how many clients received it.
+func (c *Client) Publish(ctx context.Context, channel, message string, sharded bool) (int64, error) {
+	name := "PUBLISH"
+	cmd := c.client.B().Publish().Channel(channel).Message(message).Build()
+	if sharded {
+		name = "SPUBLISH"
+		cmd = c.client.B().Spublish().Channel(channel).Message(message).Build()
+	}
+	resp := c.client.Do(ctx, cmd)
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("%s failed: %w", name, err)
+	}
+	return resp.AsInt64()
+}
+
+// PubSubChannels returns the channels with subscribers matching pattern, or
+// all of them when pattern is empty. In a cluster only the subscribers of
+// the node serving the command are seen.
+func (c *Client) PubSubChannels(ctx context.Context, pattern string, sharded bool) ([]string, error) {
+	name := "PUBSUB CHANNELS"
+	var cmd valkey.Completed
+	switch {
+	case sharded && pattern != "":
+		cmd = c.client.B().PubsubShardchannels().Pattern(pattern).Build()
+	case sharded:
+		cmd = c.client.B().PubsubShardchannels().Build()
+	case pattern != "":
+		cmd = c.client.B().PubsubChannels().Pattern(pattern).Build()
+	default:
+		cmd = c.client.B().PubsubChannels().Build()
+	}
+	if sharded {
+		name = "PUBSUB SHARDCHANNELS"
+	}
+	channels, err := c.client.Do(ctx, cmd).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", name, err)
+	}
+	sort.Strings(channels)
+	return channels, nil
+}
+
+// PubSubNumSub returns the number of subscribers of each channel.
+func (c *Client) PubSubNumSub(ctx context.Context, channels []string, sharded bool) (map[string]int64, error) {
+	name := "PUBSUB NUMSUB"
+	cmd := c.client.B().PubsubNumsub().Channel(channels...).Build()
+	if sharded {
+		name = "PUBSUB SHARDNUMSUB"
+		cmd = c.client.B().PubsubShardnumsub().Channel(channels...).Build()
+	}
+	counts, err := c.client.Do(ctx, cmd).AsIntMap()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", name, err)
+	}
+	return counts, nil
+}
+
+// PubSubNumPat returns the number of patterns subscribed to with PSUBSCRIBE.
+func (c *Client) PubSubNumPat(ctx context.Context) (int64, error) {
+	resp := c.client.Do(ctx, c.client.B().PubsubNumpat().Build())
+	if err := resp.Error(); err != nil {
+		return 0, fmt.Errorf("PUBSUB NUMPAT failed: %w", err)
+	}
+	return resp.AsInt64()
+}
+
+// Subscribe subscribes to channels, patterns and shardChannels with
+// SUBSCRIBE, PSUBSCRIBE and SSUBSCRIBE and delivers their messages until ctx
+// is done. Each kind runs in its own goroutine, so onMessage may be called
+// concurrently.
+func (c *Client) Subscribe(ctx context.Context, channels, patterns, shardChannels []string, onMessage func(PubSubMessage)) error {
+	type subscription struct {
+		name string
+		cmd  valkey.Completed
+	}
+	var subs []subscription
+	if len(channels) > 0 {
+		subs = append(subs, subscription{"SUBSCRIBE", c.client.B().Subscribe().Channel(channels...).Build()})
+	}
+	if len(patterns) > 0 {
+		subs = append(subs, subscription{"PSUBSCRIBE", c.client.B().Psubscribe().Pattern(patterns...).Build()})
+	}
+	if len(shardChannels) > 0 {
+		subs = append(subs, subscription{"SSUBSCRIBE", c.client.B().Ssubscribe().Channel(shardChannels...).Build()})
+	}
+	if len(subs) == 0 {
+		return fmt.Errorf("no channels or patterns to subscribe to")
+	}
+
+	// A failed subscription ends the others too.
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, len(subs))
+	for _, sub := range subs {
+		go func() {
+			err := c.client.Receive(subCtx, sub.cmd, func(msg valkey.PubSubMessage) {
+				onMessage(PubSubMessage{Channel: msg.Channel, Pattern: msg.Pattern, Message: []byte(msg.Message)})
+			})
+			if err != nil && subCtx.Err() == nil {
+				err = fmt.Errorf("%s failed: %w", sub.name, err)
+				cancel()
+			} else {
+				err = nil
+			}
+			errs <- err
+		}()
+	}
+	var first error
+	for range subs {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// doBlocking runs a blocking command on a dedicated connection so that it
+// does not hold up the commands pipelined on the shared ones. The connection
+// is closed when ctx ends first, since its reply would otherwise be read by
+// the next command sent on it.
+func (c *Client) doBlocking(ctx context.Context, cmd valkey.Completed) valkey.ValkeyResult {
+	var resp valkey.ValkeyResult
+	_ = c.client.Dedicated(func(dc valkey.DedicatedClient) error {
+		resp = dc.Do(ctx, cmd)
+		if ctx.Err() != nil {
+			dc.Close()
+		}
+		return nil
+	})
+	return resp
+}
+
+// blockingTimeout rejects timeouts that would block forever.
+func blockingTimeout(cmd string, timeout time.Duration) error {
+	if timeout <= 0 {
+		return fmt.Errorf("%s failed: timeout must be positive", cmd)
+	}
+	return nil
+}
+
+// BlockingReadStream waits for entries after id with XREAD BLOCK. It returns
+// no entries when timeout passes first.
+func (c *Client) BlockingReadStream(ctx context.Context, key, id string, count int64, timeout time.Duration) ([]StreamEntry, error) {
+	if err := blockingTimeout("XREAD", timeout); err != nil {
+		return nil, err
+	}
+	// BLOCK 0 waits forever, so round sub-millisecond timeouts up.
+	ms := timeout.Milliseconds()
+	if ms == 0 {
+		ms = 1
+	}
+	var cmd valkey.Completed
+	if count > 0 {
+		cmd = c.client.B().Xread().Count(count).Block(ms).Streams().Key(key).Id(id).Build()
+	} else {
+		cmd = c.client.B().Xread().Block(ms).Streams().Key(key).Id(id).Build()
+	}
+	resp := c.doBlocking(ctx, cmd)
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return []StreamEntry{}, nil
+		}
+		return nil, fmt.Errorf("XREAD failed: %w", err)
+	}
+	return parseStreamRead(resp), nil
+}
+
+// BlockingPopList pops the head of the first non-empty list of keys with
+// BLPOP. It returns nil when timeout passes first.
+func (c *Client) BlockingPopList(ctx context.Context, keys []string, timeout time.Duration) (*ListPop, error) {
+	if err := blockingTimeout("BLPOP", timeout); err != nil {
+		return nil, err
+	}
+	resp := c.doBlocking(ctx, c.client.B().Blpop().Key(keys...).Timeout(timeout.Seconds()).Build())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("BLPOP failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil || len(arr) < 2 {
+		return nil, fmt.Errorf("unexpected BLPOP reply")
+	}
+	pop := &ListPop{}
+	if pop.Key, err = arr[0].ToString(); err != nil {
+		return nil, fmt.Errorf("unexpected BLPOP reply: %w", err)
+	}
+	if pop.Value, err = arr[1].AsBytes(); err != nil {
+		return nil, fmt.Errorf("unexpected BLPOP reply: %w", err)
+	}
+	return pop, nil
+}
+
+// BlockingMoveList moves an element from the from end of source to the to
+// end of destination with BLMOVE. It reports false when timeout passes
+// first.
+func (c *Client) BlockingMoveList(ctx context.Context, source, destination, from, to string, timeout time.Duration) ([]byte, bool, error) {
+	if err := blockingTimeout("BLMOVE", timeout); err != nil {
+		return nil, false, err
+	}
+	seconds := strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64)
+	cmd := c.client.B().Arbitrary("BLMOVE").Keys(source, destination).Args(from, to, seconds).Blocking()
+	resp := c.doBlocking(ctx, cmd)
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("BLMOVE failed: %w", err)
+	}
+	value, err := resp.AsBytes()
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// BlockingPopMinSortedSet pops the lowest scored member of the first
+// non-empty sorted set of keys with BZPOPMIN. It returns nil when timeout
+// passes first.
+func (c *Client) BlockingPopMinSortedSet(ctx context.Context, keys []string, timeout time.Duration) (*SortedSetPop, error) {
+	if err := blockingTimeout("BZPOPMIN", timeout); err != nil {
+		return nil, err
+	}
+	resp := c.doBlocking(ctx, c.client.B().Bzpopmin().Key(keys...).Timeout(timeout.Seconds()).Build())
+	if err := resp.Error(); err != nil {
+		if valkey.IsValkeyNil(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("BZPOPMIN failed: %w", err)
+	}
+	arr, err := resp.ToArray()
+	if err != nil || len(arr) < 3 {
+		return nil, fmt.Errorf("unexpected BZPOPMIN reply")
+	}
+	pop := &SortedSetPop{}
+	if pop.Key, err = arr[0].ToString(); err != nil {
+		return nil, fmt.Errorf("unexpected BZPOPMIN reply: %w", err)
+	}
+	if pop.Member, err = arr[1].AsBytes(); err != nil {
+		return nil, fmt.Errorf("unexpected BZPOPMIN reply: %w", err)
+	}
+	if pop.Score, err = arr[2].AsFloat64(); err != nil {
+		return nil, fmt.Errorf("unexpected BZPOPMIN reply: %w", err)
+	}
+	return pop, nil
+}
+
 // DumpKey serializes a key's value.
 func (c *Client) DumpKey(ctx context.Context, key string) ([]byte, error) {
 	resp := c.client.Do(ctx, c.client.B().Dump().Key(key).Build())
@@ -987,15 +2646,71 @@ func (c *Client) DumpKey(ctx context.Context, key string) ([]byte, error) {
 	return resp.AsBytes()
 }
 
-// RestoreKey restores a serialized value to a key.
-func (c *Client) RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error) {
-	resp := c.client.Do(ctx, c.client.B().Restore().Key(key).Ttl(ttl).SerializedValue(string(serialized)).Build())
+// RestoreKey restores a serialized value to a key. ttl is in milliseconds; 0
+// means no expiry.
+func (c *Client) RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte, opts RestoreOptions) (bool, error) {
+	if opts.IdleTime != nil && opts.Freq != nil {
+		return false, fmt.Errorf("RESTORE accepts either IDLETIME or FREQ, not both")
+	}
+	args := []string{strconv.FormatInt(ttl, 10), string(serialized)}
+	if opts.Replace {
+		args = append(args, "REPLACE")
+	}
+	if opts.AbsTTL {
+		args = append(args, "ABSTTL")
+	}
+	if opts.IdleTime != nil {
+		args = append(args, "IDLETIME", strconv.FormatInt(*opts.IdleTime, 10))
+	}
+	if opts.Freq != nil {
+		args = append(args, "FREQ", strconv.FormatInt(*opts.Freq, 10))
+	}
+	resp := c.client.Do(ctx, c.client.B().Arbitrary("RESTORE").Keys(key).Args(args...).Build())
 	if err := resp.Error(); err != nil {
 		return false, fmt.Errorf("RESTORE failed: %w", err)
 	}
 	return true, nil
 }
 
+// CopyKey copies source to destination with COPY, into database db when db
+// is not negative. It returns false when destination exists and replace is
+// not set, or when source does not exist.
+func (c *Client) CopyKey(ctx context.Context, source, destination string, db int, replace bool) (bool, error) {
+	if !c.SameSlot(source, destination) {
+		return false, fmt.Errorf("COPY failed: CROSSSLOT Keys in request don't hash to the same slot")
+	}
+
+	cmd := c.client.B().Copy().Source(source).Destination(destination)
+	var completed valkey.Completed
+	switch {
+	case db >= 0 && replace:
+		completed = cmd.Db(int64(db)).Replace().Build()
+	case db >= 0:
+		completed = cmd.Db(int64(db)).Build()
+	case replace:
+		completed = cmd.Replace().Build()
+	default:
+		completed = cmd.Build()
+	}
+	resp := c.client.Do(ctx, completed)
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("COPY failed: %w", err)
+	}
+	n, err := resp.AsInt64()
+	return n == 1, err
+}
+
+// MoveKey moves key to database db with MOVE. It returns false when the key
+// exists in db or does not exist in the current database.
+func (c *Client) MoveKey(ctx context.Context, key string, db int) (bool, error) {
+	resp := c.client.Do(ctx, c.client.B().Move().Key(key).Db(int64(db)).Build())
+	if err := resp.Error(); err != nil {
+		return false, fmt.Errorf("MOVE failed: %w", err)
+	}
+	n, err := resp.AsInt64()
+	return n == 1, err
+}
+
 // ObjectIdletime gets the idle time of a key.
 func (c *Client) ObjectIdletime(ctx context.Context, key string) (int64, error) {
 	resp := c.client.Do(ctx, c.client.B().ObjectIdletime().Key(key).Build())
@@ -1053,6 +2768,23 @@ func (c *Client) GetDatabaseSize(ctx context.Context) (int64, error) {
 	return resp.AsInt64()
 }
 
+// NodeDatabaseSize runs DBSIZE on the node with the given address, as
+// returned by ScanNodes. An empty address uses the default connection.
+func (c *Client) NodeDatabaseSize(ctx context.Context, node string) (int64, error) {
+	if node == "" {
+		return c.GetDatabaseSize(ctx)
+	}
+	conn, ok := c.client.Nodes()[node]
+	if !ok {
+		return 0, fmt.Errorf("node %s is no longer part of the cluster", node)
+	}
+	size, err := conn.Do(ctx, conn.B().Dbsize().Build()).AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("DBSIZE failed on %s: %w", node, err)
+	}
+	return size, nil
+}
+
 // GetSlowlog gets slow query log entries.
 func (c *Client) GetSlowlog(ctx context.Context, count int64) ([]map[string]interface{}, error) {
 	var resp valkey.ValkeyResult
@@ -1143,7 +2875,50 @@ func (c *Client) LoadScript(ctx context.Context, script string) (string, error)
 	if err := resp.Error(); err != nil {
 		return "", fmt.Errorf("SCRIPT LOAD failed: %w", err)
 	}
-	return resp.ToString()
+	sha, err := resp.ToString()
+	if err != nil {
+		return "", err
+	}
+
+	c.scriptsMu.Lock()
+	c.scripts[sha] = true
+	c.scriptsMu.Unlock()
+	return sha, nil
+}
+
+// ListLoadedScripts returns the SHA1 digests of scripts loaded through this
+// client that are still present in the server's script cache.
+func (c *Client) ListLoadedScripts(ctx context.Context) ([]string, error) {
+	c.scriptsMu.Lock()
+	shas := make([]string, 0, len(c.scripts))
+	for sha := range c.scripts {
+		shas = append(shas, sha)
+	}
+	c.scriptsMu.Unlock()
+	if len(shas) == 0 {
+		return shas, nil
+	}
+	sort.Strings(shas)
+
+	// SCRIPT FLUSH or a restart empties the cache; drop digests that are gone.
+	resp := c.client.Do(ctx, c.client.B().ScriptExists().Sha1(shas...).Build())
+	if err := resp.Error(); err != nil {
+		return nil, fmt.Errorf("SCRIPT EXISTS failed: %w", err)
+	}
+	exists, err := resp.ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SCRIPT EXISTS response: %w", err)
+	}
+
+	result := make([]string, 0, len(shas))
+	for i, sha := range shas {
+		if i < len(exists) {
+			if ok, _ := exists[i].AsBool(); ok {
+				result = append(result, sha)
+			}
+		}
+	}
+	return result, nil
 }
 
 // EvalSHA evaluates a loaded script by its SHA1 hash.
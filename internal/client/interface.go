@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 )
 
 // ValkeyClient defines the interface for Valkey operations.
@@ -18,14 +19,36 @@ type ValkeyClient interface {
 	DeleteKey(ctx context.Context, key string) (bool, error)
 	ExistsKeys(ctx context.Context, keys []string) (map[string]bool, error)
 	ExpireKey(ctx context.Context, key string, seconds int64) (bool, error)
+	PExpireKey(ctx context.Context, key string, milliseconds int64) (bool, error)
 	PersistKey(ctx context.Context, key string) (bool, error)
 	RenameKey(ctx context.Context, oldKey, newKey string) (bool, error)
+	RenameKeyNX(ctx context.Context, oldKey, newKey string) (bool, error)
 	GetTTL(ctx context.Context, key string) (int64, error)
+	GetPTTL(ctx context.Context, key string) (int64, error)
 	IncrementNumber(ctx context.Context, key string, amount int64) (int64, error)
 	DecrementNumber(ctx context.Context, key string, amount int64) (int64, error)
 	AppendString(ctx context.Context, key, value string) (int64, error)
 	GetRange(ctx context.Context, key string, start, end int64) ([]byte, error)
 
+	// Bitmap operations. BitOp returns the length of destination; its
+	// operation is AND, OR, XOR, NOT or, where the server supports them, DIFF,
+	// DIFF1, ANDOR and ONE. BitField returns one value per GET, SET and INCRBY,
+	// nil when OVERFLOW FAIL skipped it; readOnly sends BITFIELD_RO.
+	SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error)
+	GetBit(ctx context.Context, key string, offset int64) (int64, error)
+	BitCount(ctx context.Context, key string, r *BitRange) (int64, error)
+	BitPos(ctx context.Context, key string, bit int64, r *BitRange) (int64, error)
+	BitOp(ctx context.Context, operation, destination string, keys []string) (int64, error)
+	BitField(ctx context.Context, key string, ops []BitFieldOp, readOnly bool) ([]*int64, error)
+
+	// HyperLogLog operations. PFAdd reports whether the estimate changed;
+	// PFCount estimates the cardinality of the union of keys; PFMerge
+	// stores the union of keys and destination's own contents in
+	// destination.
+	PFAdd(ctx context.Context, key string, elements []string) (bool, error)
+	PFCount(ctx context.Context, keys []string) (int64, error)
+	PFMerge(ctx context.Context, destination string, keys []string) error
+
 	// Hash (map) operations
 	GetMap(ctx context.Context, key string) (map[string][]byte, error)
 	SetMap(ctx context.Context, key string, fields map[string]string) (int64, error)
@@ -44,6 +67,18 @@ type ValkeyClient interface {
 	GetListIndex(ctx context.Context, key string, index int64) ([]byte, bool, error)
 	SetListIndex(ctx context.Context, key string, index int64, value string) (bool, error)
 	TrimList(ctx context.Context, key string, start, stop int64) (bool, error)
+	// GetListPositions returns the indexes of element in key with LPOS; see
+	// ListPositionOptions. InsertList returns -1 when pivot is missing and 0
+	// when key is. MoveList moves from and to the "LEFT" or "RIGHT" ends and
+	// reports false when source is empty. MultiPopList returns nil when
+	// every list is empty. PushListExisting pushes only onto an existing list
+	// and returns 0 otherwise.
+	GetListPositions(ctx context.Context, key, element string, opts ListPositionOptions) ([]int64, error)
+	InsertList(ctx context.Context, key string, before bool, pivot, element string) (int64, error)
+	RemoveListElements(ctx context.Context, key string, count int64, element string) (int64, error)
+	MoveList(ctx context.Context, source, destination, from, to string) ([]byte, bool, error)
+	MultiPopList(ctx context.Context, keys []string, tail bool, count int64) (*ListMultiPop, error)
+	PushListExisting(ctx context.Context, key string, values []string, tail bool) (int64, error)
 
 	// Set operations
 	AddSet(ctx context.Context, key string, members []string) (int64, error)
@@ -60,6 +95,25 @@ type ValkeyClient interface {
 	MemoryUsage(ctx context.Context, key string) (int64, error)
 	TouchKeys(ctx context.Context, keys []string) (int64, error)
 	ObjectEncoding(ctx context.Context, key string) (string, error)
+	ScanKeys(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error)
+	// ScanNodes returns the nodes a full keyspace scan must visit; ScanNodeKeys
+	// scans one of them. A standalone server is a single node "".
+	ScanNodes(ctx context.Context) ([]string, error)
+	ScanNodeKeys(ctx context.Context, node string, cursor uint64, pattern string, count int64) ([]string, uint64, error)
+	UnlinkKeys(ctx context.Context, keys []string) (int64, error)
+	ExpireKeys(ctx context.Context, keys []string, seconds int64) (int64, error)
+	// InspectKeys returns the type, encoding, memory usage and TTL of each
+	// key, in order. Keys that no longer exist have Type "none".
+	InspectKeys(ctx context.Context, keys []string) ([]KeyInfo, error)
+	// KeyLengths returns the STRLEN, HLEN, LLEN, SCARD, ZCARD or XLEN of each
+	// key according to its type in types, in order; other types have length 0.
+	KeyLengths(ctx context.Context, keys, types []string) ([]int64, error)
+	// ObjectFreqs returns the OBJECT FREQ of each key, which requires an LFU
+	// maxmemory-policy, and ObjectIdletimes its OBJECT IDLETIME in seconds,
+	// which requires any other policy. Keys that no longer exist report -1.
+	ObjectFreqs(ctx context.Context, keys []string) ([]int64, error)
+	ObjectIdletimes(ctx context.Context, keys []string) ([]int64, error)
+	GetKeyType(ctx context.Context, key string) (string, error)
 
 	// Additional Hash operations
 	GetMapLength(ctx context.Context, key string) (int64, error)
@@ -72,15 +126,87 @@ type ValkeyClient interface {
 	SetUnion(ctx context.Context, keys []string) ([][]byte, error)
 	SetDifference(ctx context.Context, firstKey string, otherKeys []string) ([][]byte, error)
 
+	// Sorted set operations
+	GetSortedSetRange(ctx context.Context, key string, start, stop int64) ([]SortedSetMember, error)
+	AddSortedSet(ctx context.Context, key string, members []SortedSetMember) (int64, error)
+
+	// Geo operations. GeoPos, GeoDist and GeoHash return nil for missing
+	// members. GeoSearchStore ignores the With options of q and, with
+	// storeDist, scores the stored members by distance instead of position.
+	GeoAdd(ctx context.Context, key string, locations []GeoLocation, opts GeoAddOptions) (int64, error)
+	GeoPos(ctx context.Context, key string, members []string) ([]*GeoPosition, error)
+	GeoDist(ctx context.Context, key, member1, member2, unit string) (*float64, error)
+	GeoHash(ctx context.Context, key string, members []string) ([]*string, error)
+	GeoSearch(ctx context.Context, key string, q GeoSearchQuery) ([]GeoMatch, error)
+	GeoSearchStore(ctx context.Context, destination, key string, q GeoSearchQuery, storeDist bool) (int64, error)
+
 	// Stream operations
-	AddStream(ctx context.Context, key string, id string, fields map[string]string) (string, error)
+	AddStream(ctx context.Context, key string, id string, fields map[string]string, opts StreamAddOptions) (string, error)
+	// GetStreamRange and GetStreamRevRange accept exclusive bounds "(id".
 	GetStreamRange(ctx context.Context, key string, start string, end string, count int64) ([]StreamEntry, error)
+	GetStreamRevRange(ctx context.Context, key string, end string, start string, count int64) ([]StreamEntry, error)
 	GetStreamLength(ctx context.Context, key string) (int64, error)
 	ReadStream(ctx context.Context, key string, id string, count int64) ([]StreamEntry, error)
+	TrimStream(ctx context.Context, key string, trim StreamTrim) (int64, error)
+	DeleteStreamEntries(ctx context.Context, key string, ids []string) (int64, error)
+	// SetStreamID sends entriesAdded and maxDeletedID only when they are
+	// set, as servers before 7.0 do not accept them.
+	SetStreamID(ctx context.Context, key, lastID string, entriesAdded int64, maxDeletedID string) error
+
+	// Stream consumer group operations
+	CreateStreamGroup(ctx context.Context, key, group, id string, mkstream bool, entriesRead *int64) error
+	DestroyStreamGroup(ctx context.Context, key, group string) (bool, error)
+	SetStreamGroupID(ctx context.Context, key, group, id string, entriesRead *int64) error
+	CreateStreamConsumer(ctx context.Context, key, group, consumer string) (bool, error)
+	DeleteStreamConsumer(ctx context.Context, key, group, consumer string) (int64, error)
+	ReadStreamGroup(ctx context.Context, key, group, consumer, id string, count int64, noack bool) ([]StreamEntry, error)
+	AckStream(ctx context.Context, key, group string, ids []string) (int64, error)
+	PendingStreamSummary(ctx context.Context, key, group string) (*StreamPendingSummary, error)
+	PendingStream(ctx context.Context, key, group string, opts StreamPendingOptions) ([]StreamPendingEntry, error)
+	ClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, ids []string, opts StreamClaimOptions) ([]StreamEntry, error)
+	AutoClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, start string, count int64) (*StreamAutoClaim, error)
+
+	// Stream introspection. GetStreamInfo with full lists up to count
+	// entries and the pending entries of each group (0 means the server's
+	// default of 10).
+	GetStreamInfo(ctx context.Context, key string, full bool, count int64) (*StreamInfo, error)
+	GetStreamGroups(ctx context.Context, key string) ([]StreamGroupInfo, error)
+	GetStreamConsumers(ctx context.Context, key, group string) ([]StreamConsumerInfo, error)
+
+	// Blocking operations. They wait up to timeout, which must be positive,
+	// on a dedicated connection and return no result when it passes first.
+	// BlockingMoveList moves from and to the "LEFT" or "RIGHT" ends.
+	BlockingReadStream(ctx context.Context, key, id string, count int64, timeout time.Duration) ([]StreamEntry, error)
+	BlockingPopList(ctx context.Context, keys []string, timeout time.Duration) (*ListPop, error)
+	BlockingMoveList(ctx context.Context, source, destination, from, to string, timeout time.Duration) ([]byte, bool, error)
+	BlockingPopMinSortedSet(ctx context.Context, keys []string, timeout time.Duration) (*SortedSetPop, error)
+
+	// Pub/Sub operations. sharded selects SPUBLISH, PUBSUB SHARDCHANNELS and
+	// PUBSUB SHARDNUMSUB. Subscribe delivers messages until ctx is done and
+	// may call onMessage concurrently.
+	Publish(ctx context.Context, channel, message string, sharded bool) (int64, error)
+	PubSubChannels(ctx context.Context, pattern string, sharded bool) ([]string, error)
+	PubSubNumSub(ctx context.Context, channels []string, sharded bool) (map[string]int64, error)
+	PubSubNumPat(ctx context.Context) (int64, error)
+	Subscribe(ctx context.Context, channels, patterns, shardChannels []string, onMessage func(PubSubMessage)) error
+
+	// Keyspace notifications. WatchKeyspace listens on the __keyspace@<db>__
+	// channels (the K flag of notify-keyspace-events) and WatchKeyevents on
+	// the __keyevent@<db>__ ones (the E flag).
+	WatchKeyspace(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error
+	WatchKeyevents(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error
 
 	// Serialization operations
 	DumpKey(ctx context.Context, key string) ([]byte, error)
-	RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error)
+	RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte, opts RestoreOptions) (bool, error)
+
+	// Copy operations. CopyKey copies into database db, or the current
+	// database when db is negative. SameSlot reports whether a multi-key
+	// command such as COPY may name both keys, which in a cluster requires
+	// them to hash to the same slot.
+	CopyKey(ctx context.Context, source, destination string, db int, replace bool) (bool, error)
+	SameSlot(a, b string) bool
+	MoveKey(ctx context.Context, key string, db int) (bool, error)
 
 	// Key object info
 	ObjectIdletime(ctx context.Context, key string) (int64, error)
@@ -91,6 +217,8 @@ type ValkeyClient interface {
 
 	// Database operations
 	GetDatabaseSize(ctx context.Context) (int64, error)
+	// NodeDatabaseSize returns DBSIZE of a node returned by ScanNodes.
+	NodeDatabaseSize(ctx context.Context, node string) (int64, error)
 	GetSlowlog(ctx context.Context, count int64) ([]map[string]interface{}, error)
 
 	// Cluster operations
@@ -103,4 +231,8 @@ type ValkeyClient interface {
 	EvalScript(ctx context.Context, script string, keys []string, args []string) (interface{}, error)
 	LoadScript(ctx context.Context, script string) (string, error)
 	EvalSHA(ctx context.Context, sha string, keys []string, args []string) (interface{}, error)
+	// ListLoadedScripts returns the SHA1 digests of scripts loaded through this
+	// client. Valkey cannot enumerate its script cache, so scripts loaded by
+	// other clients are not included.
+	ListLoadedScripts(ctx context.Context) ([]string, error)
 }
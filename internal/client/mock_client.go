@@ -1,9 +1,20 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 // MockValkeyClient implements ValkeyClient interface for testing via function stubs.
@@ -18,14 +29,30 @@ type MockValkeyClient struct {
 	DeleteKeyFunc       func(ctx context.Context, key string) (bool, error)
 	ExistsKeysFunc      func(ctx context.Context, keys []string) (map[string]bool, error)
 	ExpireKeyFunc       func(ctx context.Context, key string, seconds int64) (bool, error)
+	PExpireKeyFunc      func(ctx context.Context, key string, milliseconds int64) (bool, error)
 	PersistKeyFunc      func(ctx context.Context, key string) (bool, error)
 	RenameKeyFunc       func(ctx context.Context, oldKey, newKey string) (bool, error)
+	RenameKeyNXFunc     func(ctx context.Context, oldKey, newKey string) (bool, error)
 	GetTTLFunc          func(ctx context.Context, key string) (int64, error)
+	GetPTTLFunc         func(ctx context.Context, key string) (int64, error)
 	IncrementNumberFunc func(ctx context.Context, key string, amount int64) (int64, error)
 	DecrementNumberFunc func(ctx context.Context, key string, amount int64) (int64, error)
 	AppendStringFunc    func(ctx context.Context, key, value string) (int64, error)
 	GetRangeFunc        func(ctx context.Context, key string, start, end int64) ([]byte, error)
 
+	// Bitmap operations
+	SetBitFunc   func(ctx context.Context, key string, offset int64, value int64) (int64, error)
+	GetBitFunc   func(ctx context.Context, key string, offset int64) (int64, error)
+	BitCountFunc func(ctx context.Context, key string, r *BitRange) (int64, error)
+	BitPosFunc   func(ctx context.Context, key string, bit int64, r *BitRange) (int64, error)
+	BitOpFunc    func(ctx context.Context, operation, destination string, keys []string) (int64, error)
+	BitFieldFunc func(ctx context.Context, key string, ops []BitFieldOp, readOnly bool) ([]*int64, error)
+
+	// HyperLogLog operations
+	PFAddFunc   func(ctx context.Context, key string, elements []string) (bool, error)
+	PFCountFunc func(ctx context.Context, keys []string) (int64, error)
+	PFMergeFunc func(ctx context.Context, destination string, keys []string) error
+
 	// Hash operations
 	GetMapFunc              func(ctx context.Context, key string) (map[string][]byte, error)
 	SetMapFunc              func(ctx context.Context, key string, fields map[string]string) (int64, error)
@@ -37,13 +64,19 @@ type MockValkeyClient struct {
 	ListMapFieldValuesFunc  func(ctx context.Context, key string) ([][]byte, error)
 
 	// List operations
-	PushListFunc      func(ctx context.Context, key string, values []string, tail bool) (int64, error)
-	PopListFunc       func(ctx context.Context, key string, count int64, tail bool) ([][]byte, error)
-	GetListRangeFunc  func(ctx context.Context, key string, start, stop int64) ([][]byte, error)
-	GetListLengthFunc func(ctx context.Context, key string) (int64, error)
-	GetListIndexFunc  func(ctx context.Context, key string, index int64) ([]byte, bool, error)
-	SetListIndexFunc  func(ctx context.Context, key string, index int64, value string) (bool, error)
-	TrimListFunc      func(ctx context.Context, key string, start, stop int64) (bool, error)
+	PushListFunc           func(ctx context.Context, key string, values []string, tail bool) (int64, error)
+	PopListFunc            func(ctx context.Context, key string, count int64, tail bool) ([][]byte, error)
+	GetListRangeFunc       func(ctx context.Context, key string, start, stop int64) ([][]byte, error)
+	GetListLengthFunc      func(ctx context.Context, key string) (int64, error)
+	GetListIndexFunc       func(ctx context.Context, key string, index int64) ([]byte, bool, error)
+	SetListIndexFunc       func(ctx context.Context, key string, index int64, value string) (bool, error)
+	TrimListFunc           func(ctx context.Context, key string, start, stop int64) (bool, error)
+	GetListPositionsFunc   func(ctx context.Context, key, element string, opts ListPositionOptions) ([]int64, error)
+	InsertListFunc         func(ctx context.Context, key string, before bool, pivot, element string) (int64, error)
+	RemoveListElementsFunc func(ctx context.Context, key string, count int64, element string) (int64, error)
+	MoveListFunc           func(ctx context.Context, source, destination, from, to string) ([]byte, bool, error)
+	MultiPopListFunc       func(ctx context.Context, keys []string, tail bool, count int64) (*ListMultiPop, error)
+	PushListExistingFunc   func(ctx context.Context, key string, values []string, tail bool) (int64, error)
 
 	// Set operations
 	AddSetFunc            func(ctx context.Context, key string, members []string) (int64, error)
@@ -58,14 +91,86 @@ type MockValkeyClient struct {
 	SetDifferenceFunc     func(ctx context.Context, firstKey string, otherKeys []string) ([][]byte, error)
 
 	// Stream operations
-	AddStreamFunc       func(ctx context.Context, key string, id string, fields map[string]string) (string, error)
-	GetStreamRangeFunc  func(ctx context.Context, key string, start string, end string, count int64) ([]StreamEntry, error)
-	GetStreamLengthFunc func(ctx context.Context, key string) (int64, error)
-	ReadStreamFunc      func(ctx context.Context, key string, id string, count int64) ([]StreamEntry, error)
+	AddStreamFunc           func(ctx context.Context, key string, id string, fields map[string]string, opts StreamAddOptions) (string, error)
+	GetStreamRangeFunc      func(ctx context.Context, key string, start string, end string, count int64) ([]StreamEntry, error)
+	GetStreamRevRangeFunc   func(ctx context.Context, key string, end string, start string, count int64) ([]StreamEntry, error)
+	GetStreamLengthFunc     func(ctx context.Context, key string) (int64, error)
+	ReadStreamFunc          func(ctx context.Context, key string, id string, count int64) ([]StreamEntry, error)
+	TrimStreamFunc          func(ctx context.Context, key string, trim StreamTrim) (int64, error)
+	DeleteStreamEntriesFunc func(ctx context.Context, key string, ids []string) (int64, error)
+	SetStreamIDFunc         func(ctx context.Context, key, lastID string, entriesAdded int64, maxDeletedID string) error
+
+	// Stream consumer group operations
+	CreateStreamGroupFunc    func(ctx context.Context, key, group, id string, mkstream bool, entriesRead *int64) error
+	DestroyStreamGroupFunc   func(ctx context.Context, key, group string) (bool, error)
+	SetStreamGroupIDFunc     func(ctx context.Context, key, group, id string, entriesRead *int64) error
+	CreateStreamConsumerFunc func(ctx context.Context, key, group, consumer string) (bool, error)
+	DeleteStreamConsumerFunc func(ctx context.Context, key, group, consumer string) (int64, error)
+	ReadStreamGroupFunc      func(ctx context.Context, key, group, consumer, id string, count int64, noack bool) ([]StreamEntry, error)
+	AckStreamFunc            func(ctx context.Context, key, group string, ids []string) (int64, error)
+	PendingStreamSummaryFunc func(ctx context.Context, key, group string) (*StreamPendingSummary, error)
+	PendingStreamFunc        func(ctx context.Context, key, group string, opts StreamPendingOptions) ([]StreamPendingEntry, error)
+	ClaimStreamFunc          func(ctx context.Context, key, group, consumer string, minIdle int64, ids []string, opts StreamClaimOptions) ([]StreamEntry, error)
+	AutoClaimStreamFunc      func(ctx context.Context, key, group, consumer string, minIdle int64, start string, count int64) (*StreamAutoClaim, error)
+
+	// Stream introspection
+	GetStreamInfoFunc      func(ctx context.Context, key string, full bool, count int64) (*StreamInfo, error)
+	GetStreamGroupsFunc    func(ctx context.Context, key string) ([]StreamGroupInfo, error)
+	GetStreamConsumersFunc func(ctx context.Context, key, group string) ([]StreamConsumerInfo, error)
+
+	// Pub/Sub operations
+	PublishFunc        func(ctx context.Context, channel, message string, sharded bool) (int64, error)
+	PubSubChannelsFunc func(ctx context.Context, pattern string, sharded bool) ([]string, error)
+	PubSubNumSubFunc   func(ctx context.Context, channels []string, sharded bool) (map[string]int64, error)
+	PubSubNumPatFunc   func(ctx context.Context) (int64, error)
+	SubscribeFunc      func(ctx context.Context, channels, patterns, shardChannels []string, onMessage func(PubSubMessage)) error
+
+	// Blocking operations
+	BlockingReadStreamFunc      func(ctx context.Context, key, id string, count int64, timeout time.Duration) ([]StreamEntry, error)
+	BlockingPopListFunc         func(ctx context.Context, keys []string, timeout time.Duration) (*ListPop, error)
+	BlockingMoveListFunc        func(ctx context.Context, source, destination, from, to string, timeout time.Duration) ([]byte, bool, error)
+	BlockingPopMinSortedSetFunc func(ctx context.Context, keys []string, timeout time.Duration) (*SortedSetPop, error)
 
 	// Serialization operations
 	DumpKeyFunc    func(ctx context.Context, key string) ([]byte, error)
-	RestoreKeyFunc func(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error)
+	RestoreKeyFunc func(ctx context.Context, key string, ttl int64, serialized []byte, opts RestoreOptions) (bool, error)
+
+	// Copy operations
+	CopyKeyFunc  func(ctx context.Context, source, destination string, db int, replace bool) (bool, error)
+	SameSlotFunc func(a, b string) bool
+	MoveKeyFunc  func(ctx context.Context, key string, db int) (bool, error)
+
+	// Key discovery and notification operations
+	ScanKeysFunc          func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error)
+	ScanNodesFunc         func(ctx context.Context) ([]string, error)
+	ScanNodeKeysFunc      func(ctx context.Context, node string, cursor uint64, pattern string, count int64) ([]string, uint64, error)
+	UnlinkKeysFunc        func(ctx context.Context, keys []string) (int64, error)
+	ExpireKeysFunc        func(ctx context.Context, keys []string, seconds int64) (int64, error)
+	InspectKeysFunc       func(ctx context.Context, keys []string) ([]KeyInfo, error)
+	KeyLengthsFunc        func(ctx context.Context, keys, types []string) ([]int64, error)
+	ObjectFreqsFunc       func(ctx context.Context, keys []string) ([]int64, error)
+	ObjectIdletimesFunc   func(ctx context.Context, keys []string) ([]int64, error)
+	GetKeyTypeFunc        func(ctx context.Context, key string) (string, error)
+	MemoryUsageFunc       func(ctx context.Context, key string) (int64, error)
+	GetSortedSetRangeFunc func(ctx context.Context, key string, start, stop int64) ([]SortedSetMember, error)
+	AddSortedSetFunc      func(ctx context.Context, key string, members []SortedSetMember) (int64, error)
+	WatchKeyspaceFunc     func(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error
+	WatchKeyeventsFunc    func(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error
+
+	// Geo operations
+	GeoAddFunc         func(ctx context.Context, key string, locations []GeoLocation, opts GeoAddOptions) (int64, error)
+	GeoPosFunc         func(ctx context.Context, key string, members []string) ([]*GeoPosition, error)
+	GeoDistFunc        func(ctx context.Context, key, member1, member2, unit string) (*float64, error)
+	GeoHashFunc        func(ctx context.Context, key string, members []string) ([]*string, error)
+	GeoSearchFunc      func(ctx context.Context, key string, q GeoSearchQuery) ([]GeoMatch, error)
+	GeoSearchStoreFunc func(ctx context.Context, destination, key string, q GeoSearchQuery, storeDist bool) (int64, error)
+
+	// Scripting operations
+	ListLoadedScriptsFunc func(ctx context.Context) ([]string, error)
+
+	// Server operations
+	GetDatabaseSizeFunc  func(ctx context.Context) (int64, error)
+	NodeDatabaseSizeFunc func(ctx context.Context, node string) (int64, error)
 }
 
 // Server operations
@@ -121,6 +226,13 @@ func (m *MockValkeyClient) ExpireKey(ctx context.Context, key string, seconds in
 	return false, nil
 }
 
+func (m *MockValkeyClient) PExpireKey(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	if m.PExpireKeyFunc != nil {
+		return m.PExpireKeyFunc(ctx, key, milliseconds)
+	}
+	return false, nil
+}
+
 func (m *MockValkeyClient) PersistKey(ctx context.Context, key string) (bool, error) {
 	if m.PersistKeyFunc != nil {
 		return m.PersistKeyFunc(ctx, key)
@@ -135,6 +247,13 @@ func (m *MockValkeyClient) RenameKey(ctx context.Context, oldKey, newKey string)
 	return false, nil
 }
 
+func (m *MockValkeyClient) RenameKeyNX(ctx context.Context, oldKey, newKey string) (bool, error) {
+	if m.RenameKeyNXFunc != nil {
+		return m.RenameKeyNXFunc(ctx, oldKey, newKey)
+	}
+	return true, nil
+}
+
 func (m *MockValkeyClient) GetTTL(ctx context.Context, key string) (int64, error) {
 	if m.GetTTLFunc != nil {
 		return m.GetTTLFunc(ctx, key)
@@ -142,6 +261,13 @@ func (m *MockValkeyClient) GetTTL(ctx context.Context, key string) (int64, error
 	return -1, nil
 }
 
+func (m *MockValkeyClient) GetPTTL(ctx context.Context, key string) (int64, error) {
+	if m.GetPTTLFunc != nil {
+		return m.GetPTTLFunc(ctx, key)
+	}
+	return -1, nil
+}
+
 func (m *MockValkeyClient) IncrementNumber(ctx context.Context, key string, amount int64) (int64, error) {
 	if m.IncrementNumberFunc != nil {
 		return m.IncrementNumberFunc(ctx, key, amount)
@@ -170,6 +296,73 @@ func (m *MockValkeyClient) GetRange(ctx context.Context, key string, start, end
 	return nil, nil
 }
 
+// Bitmap operations
+
+func (m *MockValkeyClient) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
+	if m.SetBitFunc != nil {
+		return m.SetBitFunc(ctx, key, offset, value)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	if m.GetBitFunc != nil {
+		return m.GetBitFunc(ctx, key, offset)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) BitCount(ctx context.Context, key string, r *BitRange) (int64, error) {
+	if m.BitCountFunc != nil {
+		return m.BitCountFunc(ctx, key, r)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) BitPos(ctx context.Context, key string, bit int64, r *BitRange) (int64, error) {
+	if m.BitPosFunc != nil {
+		return m.BitPosFunc(ctx, key, bit, r)
+	}
+	return -1, nil
+}
+
+func (m *MockValkeyClient) BitOp(ctx context.Context, operation, destination string, keys []string) (int64, error) {
+	if m.BitOpFunc != nil {
+		return m.BitOpFunc(ctx, operation, destination, keys)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) BitField(ctx context.Context, key string, ops []BitFieldOp, readOnly bool) ([]*int64, error) {
+	if m.BitFieldFunc != nil {
+		return m.BitFieldFunc(ctx, key, ops, readOnly)
+	}
+	return []*int64{}, nil
+}
+
+// HyperLogLog operations
+
+func (m *MockValkeyClient) PFAdd(ctx context.Context, key string, elements []string) (bool, error) {
+	if m.PFAddFunc != nil {
+		return m.PFAddFunc(ctx, key, elements)
+	}
+	return false, nil
+}
+
+func (m *MockValkeyClient) PFCount(ctx context.Context, keys []string) (int64, error) {
+	if m.PFCountFunc != nil {
+		return m.PFCountFunc(ctx, keys)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) PFMerge(ctx context.Context, destination string, keys []string) error {
+	if m.PFMergeFunc != nil {
+		return m.PFMergeFunc(ctx, destination, keys)
+	}
+	return nil
+}
+
 // Hash operations
 
 func (m *MockValkeyClient) GetMap(ctx context.Context, key string) (map[string][]byte, error) {
@@ -279,6 +472,48 @@ func (m *MockValkeyClient) TrimList(ctx context.Context, key string, start, stop
 	return false, nil
 }
 
+func (m *MockValkeyClient) GetListPositions(ctx context.Context, key, element string, opts ListPositionOptions) ([]int64, error) {
+	if m.GetListPositionsFunc != nil {
+		return m.GetListPositionsFunc(ctx, key, element, opts)
+	}
+	return []int64{}, nil
+}
+
+func (m *MockValkeyClient) InsertList(ctx context.Context, key string, before bool, pivot, element string) (int64, error) {
+	if m.InsertListFunc != nil {
+		return m.InsertListFunc(ctx, key, before, pivot, element)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) RemoveListElements(ctx context.Context, key string, count int64, element string) (int64, error) {
+	if m.RemoveListElementsFunc != nil {
+		return m.RemoveListElementsFunc(ctx, key, count, element)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) MoveList(ctx context.Context, source, destination, from, to string) ([]byte, bool, error) {
+	if m.MoveListFunc != nil {
+		return m.MoveListFunc(ctx, source, destination, from, to)
+	}
+	return nil, false, nil
+}
+
+func (m *MockValkeyClient) MultiPopList(ctx context.Context, keys []string, tail bool, count int64) (*ListMultiPop, error) {
+	if m.MultiPopListFunc != nil {
+		return m.MultiPopListFunc(ctx, keys, tail, count)
+	}
+	return nil, nil
+}
+
+func (m *MockValkeyClient) PushListExisting(ctx context.Context, key string, values []string, tail bool) (int64, error) {
+	if m.PushListExistingFunc != nil {
+		return m.PushListExistingFunc(ctx, key, values, tail)
+	}
+	return 0, nil
+}
+
 // Set operations
 
 func (m *MockValkeyClient) AddSet(ctx context.Context, key string, members []string) (int64, error) {
@@ -353,9 +588,9 @@ func (m *MockValkeyClient) SetDifference(ctx context.Context, firstKey string, o
 
 // Stream operations
 
-func (m *MockValkeyClient) AddStream(ctx context.Context, key string, id string, fields map[string]string) (string, error) {
+func (m *MockValkeyClient) AddStream(ctx context.Context, key string, id string, fields map[string]string, opts StreamAddOptions) (string, error) {
 	if m.AddStreamFunc != nil {
-		return m.AddStreamFunc(ctx, key, id, fields)
+		return m.AddStreamFunc(ctx, key, id, fields, opts)
 	}
 	return id, nil
 }
@@ -367,6 +602,13 @@ func (m *MockValkeyClient) GetStreamRange(ctx context.Context, key string, start
 	return []StreamEntry{}, nil
 }
 
+func (m *MockValkeyClient) GetStreamRevRange(ctx context.Context, key string, end string, start string, count int64) ([]StreamEntry, error) {
+	if m.GetStreamRevRangeFunc != nil {
+		return m.GetStreamRevRangeFunc(ctx, key, end, start, count)
+	}
+	return []StreamEntry{}, nil
+}
+
 func (m *MockValkeyClient) GetStreamLength(ctx context.Context, key string) (int64, error) {
 	if m.GetStreamLengthFunc != nil {
 		return m.GetStreamLengthFunc(ctx, key)
@@ -381,6 +623,197 @@ func (m *MockValkeyClient) ReadStream(ctx context.Context, key string, id string
 	return []StreamEntry{}, nil
 }
 
+func (m *MockValkeyClient) TrimStream(ctx context.Context, key string, trim StreamTrim) (int64, error) {
+	if m.TrimStreamFunc != nil {
+		return m.TrimStreamFunc(ctx, key, trim)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) DeleteStreamEntries(ctx context.Context, key string, ids []string) (int64, error) {
+	if m.DeleteStreamEntriesFunc != nil {
+		return m.DeleteStreamEntriesFunc(ctx, key, ids)
+	}
+	return int64(len(ids)), nil
+}
+
+func (m *MockValkeyClient) SetStreamID(ctx context.Context, key, lastID string, entriesAdded int64, maxDeletedID string) error {
+	if m.SetStreamIDFunc != nil {
+		return m.SetStreamIDFunc(ctx, key, lastID, entriesAdded, maxDeletedID)
+	}
+	return nil
+}
+
+// Stream consumer group operations
+
+func (m *MockValkeyClient) CreateStreamGroup(ctx context.Context, key, group, id string, mkstream bool, entriesRead *int64) error {
+	if m.CreateStreamGroupFunc != nil {
+		return m.CreateStreamGroupFunc(ctx, key, group, id, mkstream, entriesRead)
+	}
+	return nil
+}
+
+func (m *MockValkeyClient) DestroyStreamGroup(ctx context.Context, key, group string) (bool, error) {
+	if m.DestroyStreamGroupFunc != nil {
+		return m.DestroyStreamGroupFunc(ctx, key, group)
+	}
+	return true, nil
+}
+
+func (m *MockValkeyClient) SetStreamGroupID(ctx context.Context, key, group, id string, entriesRead *int64) error {
+	if m.SetStreamGroupIDFunc != nil {
+		return m.SetStreamGroupIDFunc(ctx, key, group, id, entriesRead)
+	}
+	return nil
+}
+
+func (m *MockValkeyClient) CreateStreamConsumer(ctx context.Context, key, group, consumer string) (bool, error) {
+	if m.CreateStreamConsumerFunc != nil {
+		return m.CreateStreamConsumerFunc(ctx, key, group, consumer)
+	}
+	return true, nil
+}
+
+func (m *MockValkeyClient) DeleteStreamConsumer(ctx context.Context, key, group, consumer string) (int64, error) {
+	if m.DeleteStreamConsumerFunc != nil {
+		return m.DeleteStreamConsumerFunc(ctx, key, group, consumer)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) ReadStreamGroup(ctx context.Context, key, group, consumer, id string, count int64, noack bool) ([]StreamEntry, error) {
+	if m.ReadStreamGroupFunc != nil {
+		return m.ReadStreamGroupFunc(ctx, key, group, consumer, id, count, noack)
+	}
+	return []StreamEntry{}, nil
+}
+
+func (m *MockValkeyClient) AckStream(ctx context.Context, key, group string, ids []string) (int64, error) {
+	if m.AckStreamFunc != nil {
+		return m.AckStreamFunc(ctx, key, group, ids)
+	}
+	return int64(len(ids)), nil
+}
+
+func (m *MockValkeyClient) PendingStreamSummary(ctx context.Context, key, group string) (*StreamPendingSummary, error) {
+	if m.PendingStreamSummaryFunc != nil {
+		return m.PendingStreamSummaryFunc(ctx, key, group)
+	}
+	return &StreamPendingSummary{Consumers: map[string]int64{}}, nil
+}
+
+func (m *MockValkeyClient) PendingStream(ctx context.Context, key, group string, opts StreamPendingOptions) ([]StreamPendingEntry, error) {
+	if m.PendingStreamFunc != nil {
+		return m.PendingStreamFunc(ctx, key, group, opts)
+	}
+	return []StreamPendingEntry{}, nil
+}
+
+func (m *MockValkeyClient) ClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, ids []string, opts StreamClaimOptions) ([]StreamEntry, error) {
+	if m.ClaimStreamFunc != nil {
+		return m.ClaimStreamFunc(ctx, key, group, consumer, minIdle, ids, opts)
+	}
+	return []StreamEntry{}, nil
+}
+
+func (m *MockValkeyClient) AutoClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, start string, count int64) (*StreamAutoClaim, error) {
+	if m.AutoClaimStreamFunc != nil {
+		return m.AutoClaimStreamFunc(ctx, key, group, consumer, minIdle, start, count)
+	}
+	return &StreamAutoClaim{NextStart: "0-0", Entries: []StreamEntry{}, Deleted: []string{}}, nil
+}
+
+// Stream introspection
+
+func (m *MockValkeyClient) GetStreamInfo(ctx context.Context, key string, full bool, count int64) (*StreamInfo, error) {
+	if m.GetStreamInfoFunc != nil {
+		return m.GetStreamInfoFunc(ctx, key, full, count)
+	}
+	return &StreamInfo{LastGeneratedID: "0-0"}, nil
+}
+
+func (m *MockValkeyClient) GetStreamGroups(ctx context.Context, key string) ([]StreamGroupInfo, error) {
+	if m.GetStreamGroupsFunc != nil {
+		return m.GetStreamGroupsFunc(ctx, key)
+	}
+	return []StreamGroupInfo{}, nil
+}
+
+func (m *MockValkeyClient) GetStreamConsumers(ctx context.Context, key, group string) ([]StreamConsumerInfo, error) {
+	if m.GetStreamConsumersFunc != nil {
+		return m.GetStreamConsumersFunc(ctx, key, group)
+	}
+	return []StreamConsumerInfo{}, nil
+}
+
+// Pub/Sub operations
+
+func (m *MockValkeyClient) Publish(ctx context.Context, channel, message string, sharded bool) (int64, error) {
+	if m.PublishFunc != nil {
+		return m.PublishFunc(ctx, channel, message, sharded)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) PubSubChannels(ctx context.Context, pattern string, sharded bool) ([]string, error) {
+	if m.PubSubChannelsFunc != nil {
+		return m.PubSubChannelsFunc(ctx, pattern, sharded)
+	}
+	return []string{}, nil
+}
+
+func (m *MockValkeyClient) PubSubNumSub(ctx context.Context, channels []string, sharded bool) (map[string]int64, error) {
+	if m.PubSubNumSubFunc != nil {
+		return m.PubSubNumSubFunc(ctx, channels, sharded)
+	}
+	return map[string]int64{}, nil
+}
+
+func (m *MockValkeyClient) PubSubNumPat(ctx context.Context) (int64, error) {
+	if m.PubSubNumPatFunc != nil {
+		return m.PubSubNumPatFunc(ctx)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) Subscribe(ctx context.Context, channels, patterns, shardChannels []string, onMessage func(PubSubMessage)) error {
+	if m.SubscribeFunc != nil {
+		return m.SubscribeFunc(ctx, channels, patterns, shardChannels, onMessage)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// Blocking operations
+
+func (m *MockValkeyClient) BlockingReadStream(ctx context.Context, key, id string, count int64, timeout time.Duration) ([]StreamEntry, error) {
+	if m.BlockingReadStreamFunc != nil {
+		return m.BlockingReadStreamFunc(ctx, key, id, count, timeout)
+	}
+	return []StreamEntry{}, nil
+}
+
+func (m *MockValkeyClient) BlockingPopList(ctx context.Context, keys []string, timeout time.Duration) (*ListPop, error) {
+	if m.BlockingPopListFunc != nil {
+		return m.BlockingPopListFunc(ctx, keys, timeout)
+	}
+	return nil, nil
+}
+
+func (m *MockValkeyClient) BlockingMoveList(ctx context.Context, source, destination, from, to string, timeout time.Duration) ([]byte, bool, error) {
+	if m.BlockingMoveListFunc != nil {
+		return m.BlockingMoveListFunc(ctx, source, destination, from, to, timeout)
+	}
+	return nil, false, nil
+}
+
+func (m *MockValkeyClient) BlockingPopMinSortedSet(ctx context.Context, keys []string, timeout time.Duration) (*SortedSetPop, error) {
+	if m.BlockingPopMinSortedSetFunc != nil {
+		return m.BlockingPopMinSortedSetFunc(ctx, keys, timeout)
+	}
+	return nil, nil
+}
+
 // Serialization operations
 
 func (m *MockValkeyClient) DumpKey(ctx context.Context, key string) ([]byte, error) {
@@ -390,9 +823,32 @@ func (m *MockValkeyClient) DumpKey(ctx context.Context, key string) ([]byte, err
 	return []byte{}, nil
 }
 
-func (m *MockValkeyClient) RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error) {
+func (m *MockValkeyClient) RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte, opts RestoreOptions) (bool, error) {
 	if m.RestoreKeyFunc != nil {
-		return m.RestoreKeyFunc(ctx, key, ttl, serialized)
+		return m.RestoreKeyFunc(ctx, key, ttl, serialized, opts)
+	}
+	return true, nil
+}
+
+// Copy operations
+
+func (m *MockValkeyClient) CopyKey(ctx context.Context, source, destination string, db int, replace bool) (bool, error) {
+	if m.CopyKeyFunc != nil {
+		return m.CopyKeyFunc(ctx, source, destination, db, replace)
+	}
+	return true, nil
+}
+
+func (m *MockValkeyClient) SameSlot(a, b string) bool {
+	if m.SameSlotFunc != nil {
+		return m.SameSlotFunc(a, b)
+	}
+	return true
+}
+
+func (m *MockValkeyClient) MoveKey(ctx context.Context, key string, db int) (bool, error) {
+	if m.MoveKeyFunc != nil {
+		return m.MoveKeyFunc(ctx, key, db)
 	}
 	return true, nil
 }
@@ -408,9 +864,19 @@ func (m *MockValkeyClient) ConfigSet(ctx context.Context, parameter, value strin
 }
 
 func (m *MockValkeyClient) GetDatabaseSize(ctx context.Context) (int64, error) {
+	if m.GetDatabaseSizeFunc != nil {
+		return m.GetDatabaseSizeFunc(ctx)
+	}
 	return 0, nil
 }
 
+func (m *MockValkeyClient) NodeDatabaseSize(ctx context.Context, node string) (int64, error) {
+	if m.NodeDatabaseSizeFunc != nil {
+		return m.NodeDatabaseSizeFunc(ctx, node)
+	}
+	return m.GetDatabaseSize(ctx)
+}
+
 func (m *MockValkeyClient) GetSlowlog(ctx context.Context, count int64) ([]map[string]interface{}, error) {
 	return []map[string]interface{}{}, nil
 }
@@ -452,6 +918,9 @@ func (m *MockValkeyClient) ExistsKey(ctx context.Context, key string) (bool, err
 }
 
 func (m *MockValkeyClient) MemoryUsage(ctx context.Context, key string) (int64, error) {
+	if m.MemoryUsageFunc != nil {
+		return m.MemoryUsageFunc(ctx, key)
+	}
 	return 100, nil
 }
 
@@ -483,73 +952,360 @@ func (m *MockValkeyClient) IncrementMapField(ctx context.Context, key, field str
 	return 0, nil
 }
 
-// Ensure MockValkeyClient implements ValkeyClient at compile time
-var _ ValkeyClient = (*MockValkeyClient)(nil)
-
-// ---------------------------------------------------------------------------
-// MockClient — full in-memory implementation for unit tests
-// ---------------------------------------------------------------------------
-
-// MockClient is an in-memory mock implementation of ValkeyClient for testing.
-type MockClient struct {
-	mu sync.RWMutex
-
-	// Storage — all value data held as raw bytes
-	strings map[string][]byte
-	hashes  map[string]map[string][]byte
-	lists   map[string][][]byte
-	sets    map[string]map[string]bool
-	ttls    map[string]int64
+func (m *MockValkeyClient) ScanKeys(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	if m.ScanKeysFunc != nil {
+		return m.ScanKeysFunc(ctx, cursor, pattern, count)
+	}
+	return []string{}, 0, nil
+}
 
-	// Behavior controls
-	PingError          error
-	GetServerInfoError error
+func (m *MockValkeyClient) ScanNodes(ctx context.Context) ([]string, error) {
+	if m.ScanNodesFunc != nil {
+		return m.ScanNodesFunc(ctx)
+	}
+	return []string{""}, nil
 }
 
-// NewMockClient creates a new mock client for testing.
-func NewMockClient() *MockClient {
-	return &MockClient{
-		strings: make(map[string][]byte),
-		hashes:  make(map[string]map[string][]byte),
-		lists:   make(map[string][][]byte),
-		sets:    make(map[string]map[string]bool),
-		ttls:    make(map[string]int64),
+func (m *MockValkeyClient) ScanNodeKeys(ctx context.Context, node string, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	if m.ScanNodeKeysFunc != nil {
+		return m.ScanNodeKeysFunc(ctx, node, cursor, pattern, count)
 	}
+	return m.ScanKeys(ctx, cursor, pattern, count)
 }
 
-// SetRawBytes stores raw byte data for a string key — for testing binary retrieval paths.
-func (m *MockClient) SetRawBytes(key string, value []byte) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.strings[key] = value
+func (m *MockValkeyClient) UnlinkKeys(ctx context.Context, keys []string) (int64, error) {
+	if m.UnlinkKeysFunc != nil {
+		return m.UnlinkKeysFunc(ctx, keys)
+	}
+	return int64(len(keys)), nil
 }
 
-// SetRawHashBytes stores raw byte hash field values for testing binary retrieval paths.
-func (m *MockClient) SetRawHashBytes(key string, fields map[string][]byte) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	if m.hashes[key] == nil {
-		m.hashes[key] = make(map[string][]byte)
+func (m *MockValkeyClient) ExpireKeys(ctx context.Context, keys []string, seconds int64) (int64, error) {
+	if m.ExpireKeysFunc != nil {
+		return m.ExpireKeysFunc(ctx, keys, seconds)
 	}
-	for k, v := range fields {
-		m.hashes[key][k] = v
+	return int64(len(keys)), nil
+}
+
+func (m *MockValkeyClient) InspectKeys(ctx context.Context, keys []string) ([]KeyInfo, error) {
+	if m.InspectKeysFunc != nil {
+		return m.InspectKeysFunc(ctx, keys)
 	}
+	return inspectKeys(ctx, m, keys)
 }
 
-// SetRawListBytes stores raw byte list elements for testing binary retrieval paths.
-func (m *MockClient) SetRawListBytes(key string, values [][]byte) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.lists[key] = values
+func (m *MockValkeyClient) KeyLengths(ctx context.Context, keys, types []string) ([]int64, error) {
+	if m.KeyLengthsFunc != nil {
+		return m.KeyLengthsFunc(ctx, keys, types)
+	}
+	return make([]int64, len(keys)), nil
 }
 
-// Server operations
+func (m *MockValkeyClient) ObjectFreqs(ctx context.Context, keys []string) ([]int64, error) {
+	if m.ObjectFreqsFunc != nil {
+		return m.ObjectFreqsFunc(ctx, keys)
+	}
+	return make([]int64, len(keys)), nil
+}
 
-func (m *MockClient) Ping(ctx context.Context) error {
-	if m.PingError != nil {
-		return m.PingError
+func (m *MockValkeyClient) ObjectIdletimes(ctx context.Context, keys []string) ([]int64, error) {
+	if m.ObjectIdletimesFunc != nil {
+		return m.ObjectIdletimesFunc(ctx, keys)
 	}
-	return nil
+	return make([]int64, len(keys)), nil
+}
+
+func (m *MockValkeyClient) GetKeyType(ctx context.Context, key string) (string, error) {
+	if m.GetKeyTypeFunc != nil {
+		return m.GetKeyTypeFunc(ctx, key)
+	}
+	return "none", nil
+}
+
+func (m *MockValkeyClient) GetSortedSetRange(ctx context.Context, key string, start, stop int64) ([]SortedSetMember, error) {
+	if m.GetSortedSetRangeFunc != nil {
+		return m.GetSortedSetRangeFunc(ctx, key, start, stop)
+	}
+	return []SortedSetMember{}, nil
+}
+
+func (m *MockValkeyClient) AddSortedSet(ctx context.Context, key string, members []SortedSetMember) (int64, error) {
+	if m.AddSortedSetFunc != nil {
+		return m.AddSortedSetFunc(ctx, key, members)
+	}
+	return int64(len(members)), nil
+}
+
+// Geo operations
+
+func (m *MockValkeyClient) GeoAdd(ctx context.Context, key string, locations []GeoLocation, opts GeoAddOptions) (int64, error) {
+	if m.GeoAddFunc != nil {
+		return m.GeoAddFunc(ctx, key, locations, opts)
+	}
+	return int64(len(locations)), nil
+}
+
+func (m *MockValkeyClient) GeoPos(ctx context.Context, key string, members []string) ([]*GeoPosition, error) {
+	if m.GeoPosFunc != nil {
+		return m.GeoPosFunc(ctx, key, members)
+	}
+	return make([]*GeoPosition, len(members)), nil
+}
+
+func (m *MockValkeyClient) GeoDist(ctx context.Context, key, member1, member2, unit string) (*float64, error) {
+	if m.GeoDistFunc != nil {
+		return m.GeoDistFunc(ctx, key, member1, member2, unit)
+	}
+	return nil, nil
+}
+
+func (m *MockValkeyClient) GeoHash(ctx context.Context, key string, members []string) ([]*string, error) {
+	if m.GeoHashFunc != nil {
+		return m.GeoHashFunc(ctx, key, members)
+	}
+	return make([]*string, len(members)), nil
+}
+
+func (m *MockValkeyClient) GeoSearch(ctx context.Context, key string, q GeoSearchQuery) ([]GeoMatch, error) {
+	if m.GeoSearchFunc != nil {
+		return m.GeoSearchFunc(ctx, key, q)
+	}
+	return []GeoMatch{}, nil
+}
+
+func (m *MockValkeyClient) GeoSearchStore(ctx context.Context, destination, key string, q GeoSearchQuery, storeDist bool) (int64, error) {
+	if m.GeoSearchStoreFunc != nil {
+		return m.GeoSearchStoreFunc(ctx, destination, key, q, storeDist)
+	}
+	return 0, nil
+}
+
+func (m *MockValkeyClient) WatchKeyspace(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error {
+	if m.WatchKeyspaceFunc != nil {
+		return m.WatchKeyspaceFunc(ctx, keyPattern, onEvent)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (m *MockValkeyClient) WatchKeyevents(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error {
+	if m.WatchKeyeventsFunc != nil {
+		return m.WatchKeyeventsFunc(ctx, keyPattern, onEvent)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (m *MockValkeyClient) ListLoadedScripts(ctx context.Context) ([]string, error) {
+	if m.ListLoadedScriptsFunc != nil {
+		return m.ListLoadedScriptsFunc(ctx)
+	}
+	return []string{}, nil
+}
+
+// Ensure MockValkeyClient implements ValkeyClient at compile time
+var _ ValkeyClient = (*MockValkeyClient)(nil)
+
+// ---------------------------------------------------------------------------
+// MockClient — full in-memory implementation for unit tests
+// ---------------------------------------------------------------------------
+
+// MockClient is an in-memory mock implementation of ValkeyClient for testing.
+type MockClient struct {
+	mu sync.RWMutex
+
+	// Storage — all value data held as raw bytes
+	strings map[string][]byte
+	hashes  map[string]map[string][]byte
+	lists   map[string][][]byte
+	sets    map[string]map[string]bool
+	zsets   map[string][]SortedSetMember
+	streams map[string][]StreamEntry
+	groups  map[string]map[string]*mockStreamGroup
+	// tops records the last generated ID of streams that had entries
+	// deleted, which the remaining entries no longer tell.
+	tops    map[string]*mockStreamTop
+	ttls    map[string]int64
+	configs map[string]string
+	scripts map[string]bool
+	freqs   map[string]int64
+
+	// Active WatchKeyspace subscriptions, fed by EmitKeyspaceEvent, and
+	// Subscribe calls, fed by Publish
+	watchers    map[int]keyspaceWatcher
+	subscribers map[int]mockSubscriber
+	nextID      int
+
+	// Behavior controls
+	PingError          error
+	GetServerInfoError error
+	// Clock returns the time used for stream consumer idle times; nil means
+	// time.Now.
+	Clock func() time.Time
+}
+
+// NewMockClient creates a new mock client for testing.
+func NewMockClient() *MockClient {
+	return &MockClient{
+		strings: make(map[string][]byte),
+		hashes:  make(map[string]map[string][]byte),
+		lists:   make(map[string][][]byte),
+		sets:     make(map[string]map[string]bool),
+		zsets:    make(map[string][]SortedSetMember),
+		streams:  make(map[string][]StreamEntry),
+		groups:   make(map[string]map[string]*mockStreamGroup),
+		tops:     make(map[string]*mockStreamTop),
+		ttls:     make(map[string]int64),
+		configs:  make(map[string]string),
+		scripts:  make(map[string]bool),
+		freqs:    make(map[string]int64),
+		watchers: make(map[int]keyspaceWatcher),
+
+		subscribers: make(map[int]mockSubscriber),
+	}
+}
+
+// keyspaceWatcher is a WatchKeyspace subscription registered on a MockClient.
+type keyspaceWatcher struct {
+	pattern string
+	onEvent func(KeyspaceEvent)
+}
+
+// mockSubscriber is a Subscribe call registered on a MockClient.
+type mockSubscriber struct {
+	channels      []string
+	patterns      []string
+	shardChannels []string
+	onMessage     func(PubSubMessage)
+}
+
+// SetRawBytes stores raw byte data for a string key — for testing binary retrieval paths.
+func (m *MockClient) SetRawBytes(key string, value []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.strings[key] = value
+}
+
+// SetRawHashBytes stores raw byte hash field values for testing binary retrieval paths.
+func (m *MockClient) SetRawHashBytes(key string, fields map[string][]byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.hashes[key] == nil {
+		m.hashes[key] = make(map[string][]byte)
+	}
+	for k, v := range fields {
+		m.hashes[key][k] = v
+	}
+}
+
+// SetRawListBytes stores raw byte list elements for testing binary retrieval paths.
+func (m *MockClient) SetRawListBytes(key string, values [][]byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lists[key] = values
+}
+
+// SetRawSortedSet stores sorted set members for testing sorted set retrieval paths.
+// Members are kept in the given order, which should already be sorted by score.
+func (m *MockClient) SetRawSortedSet(key string, members []SortedSetMember) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.zsets[key] = members
+}
+
+// SetObjectFreq sets the access frequency ObjectFreqs reports for key.
+func (m *MockClient) SetObjectFreq(key string, freq int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.freqs[key] = freq
+}
+
+// EmitKeyspaceEvent delivers a keyspace notification to every active
+// WatchKeyspace call whose pattern matches key.
+func (m *MockClient) EmitKeyspaceEvent(key, event string) {
+	m.mu.RLock()
+	targets := make([]func(KeyspaceEvent), 0, len(m.watchers))
+	for _, w := range m.watchers {
+		if ok, _ := path.Match(w.pattern, key); ok {
+			targets = append(targets, w.onEvent)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, onEvent := range targets {
+		onEvent(KeyspaceEvent{Key: key, Event: event})
+	}
+}
+
+// deleteKey removes key and its TTL from the mock storage. The caller must
+// hold m.mu.
+func (m *MockClient) deleteKey(key string) {
+	delete(m.strings, key)
+	delete(m.hashes, key)
+	delete(m.lists, key)
+	delete(m.sets, key)
+	delete(m.zsets, key)
+	delete(m.streams, key)
+	delete(m.groups, key)
+	delete(m.tops, key)
+	delete(m.ttls, key)
+}
+
+// keyType returns the type of key in the mock storage. The caller must hold m.mu.
+func (m *MockClient) keyType(key string) string {
+	if _, ok := m.strings[key]; ok {
+		return "string"
+	}
+	if _, ok := m.hashes[key]; ok {
+		return "hash"
+	}
+	if _, ok := m.lists[key]; ok {
+		return "list"
+	}
+	if _, ok := m.sets[key]; ok {
+		return "set"
+	}
+	if _, ok := m.zsets[key]; ok {
+		return "zset"
+	}
+	if _, ok := m.streams[key]; ok {
+		return "stream"
+	}
+	return "none"
+}
+
+// allKeys returns every stored key in sorted order. The caller must hold m.mu.
+func (m *MockClient) allKeys() []string {
+	keys := make([]string, 0, len(m.strings)+len(m.hashes)+len(m.lists)+len(m.sets)+len(m.zsets)+len(m.streams))
+	for k := range m.strings {
+		keys = append(keys, k)
+	}
+	for k := range m.hashes {
+		keys = append(keys, k)
+	}
+	for k := range m.lists {
+		keys = append(keys, k)
+	}
+	for k := range m.sets {
+		keys = append(keys, k)
+	}
+	for k := range m.zsets {
+		keys = append(keys, k)
+	}
+	for k := range m.streams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Server operations
+
+func (m *MockClient) Ping(ctx context.Context) error {
+	if m.PingError != nil {
+		return m.PingError
+	}
+	return nil
 }
 
 func (m *MockClient) GetServerInfo(ctx context.Context) (map[string]string, error) {
@@ -610,10 +1366,12 @@ func (m *MockClient) DeleteKey(ctx context.Context, key string) (bool, error) {
 	_, existsHash := m.hashes[key]
 	_, existsList := m.lists[key]
 	_, existsSet := m.sets[key]
+	_, existsZSet := m.zsets[key]
+	_, existsStream := m.streams[key]
+	delete(m.ttls, key)
 
 	if existsStr {
 		delete(m.strings, key)
-		delete(m.ttls, key)
 		return true, nil
 	}
 	if existsHash {
@@ -628,6 +1386,16 @@ func (m *MockClient) DeleteKey(ctx context.Context, key string) (bool, error) {
 		delete(m.sets, key)
 		return true, nil
 	}
+	if existsZSet {
+		delete(m.zsets, key)
+		return true, nil
+	}
+	if existsStream {
+		delete(m.streams, key)
+		delete(m.groups, key)
+		delete(m.tops, key)
+		return true, nil
+	}
 	return false, nil
 }
 
@@ -637,11 +1405,7 @@ func (m *MockClient) ExistsKeys(ctx context.Context, keys []string) (map[string]
 
 	result := make(map[string]bool)
 	for _, key := range keys {
-		_, existsStr := m.strings[key]
-		_, existsHash := m.hashes[key]
-		_, existsList := m.lists[key]
-		_, existsSet := m.sets[key]
-		result[key] = existsStr || existsHash || existsList || existsSet
+		result[key] = m.keyType(key) != "none"
 	}
 	return result, nil
 }
@@ -657,6 +1421,18 @@ func (m *MockClient) ExpireKey(ctx context.Context, key string, seconds int64) (
 	return false, nil
 }
 
+// PExpireKey mock implementation. TTLs are kept in whole seconds, rounded up.
+func (m *MockClient) PExpireKey(ctx context.Context, key string, milliseconds int64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.keyType(key) == "none" {
+		return false, nil
+	}
+	m.ttls[key] = (milliseconds + 999) / 1000
+	return true, nil
+}
+
 func (m *MockClient) PersistKey(ctx context.Context, key string) (bool, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -688,6 +1464,30 @@ func (m *MockClient) RenameKey(ctx context.Context, oldKey, newKey string) (bool
 	return true, nil
 }
 
+func (m *MockClient) RenameKeyNX(ctx context.Context, oldKey, newKey string) (bool, error) {
+	m.mu.RLock()
+	taken := m.keyType(newKey) != "none"
+	m.mu.RUnlock()
+	if taken {
+		return false, nil
+	}
+	return m.RenameKey(ctx, oldKey, newKey)
+}
+
+// GetPTTL mock implementation; TTLs are stored with second precision.
+func (m *MockClient) GetPTTL(ctx context.Context, key string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if ttl, exists := m.ttls[key]; exists {
+		return ttl * 1000, nil
+	}
+	if m.keyType(key) != "none" {
+		return -1, nil
+	}
+	return -2, nil
+}
+
 func (m *MockClient) GetTTL(ctx context.Context, key string) (int64, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -695,7 +1495,7 @@ func (m *MockClient) GetTTL(ctx context.Context, key string) (int64, error) {
 	if ttl, exists := m.ttls[key]; exists {
 		return ttl, nil
 	}
-	if _, exists := m.strings[key]; exists {
+	if m.keyType(key) != "none" {
 		return -1, nil
 	}
 	return -2, nil
@@ -759,84 +1559,524 @@ func (m *MockClient) GetRange(ctx context.Context, key string, start, end int64)
 	return result, nil
 }
 
-// Hash operations
+// Bitmap operations
 
-func (m *MockClient) GetMap(ctx context.Context, key string) (map[string][]byte, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+// stringBytes returns the string at key for a bitmap or HyperLogLog command,
+// failing when key holds another type. The caller must hold m.mu.
+func (m *MockClient) stringBytes(cmd, key string) ([]byte, error) {
+	if t := m.keyType(key); t != "none" && t != "string" {
+		return nil, fmt.Errorf("%s failed: WRONGTYPE Operation against a key holding the wrong kind of value", cmd)
+	}
+	return m.strings[key], nil
+}
 
-	if hash, exists := m.hashes[key]; exists {
-		result := make(map[string][]byte, len(hash))
-		for k, v := range hash {
-			result[k] = v
-		}
-		return result, nil
+// mockBit returns the bit at pos of data, bits being numbered from the most
+// significant bit of the first byte.
+func mockBit(data []byte, pos int64) uint64 {
+	if pos/8 >= int64(len(data)) {
+		return 0
 	}
-	return make(map[string][]byte), nil
+	return uint64(data[pos/8]>>(7-pos%8)) & 1
 }
 
-func (m *MockClient) SetMap(ctx context.Context, key string, fields map[string]string) (int64, error) {
+// setMockBit sets the bit at pos of data, growing it as needed.
+func setMockBit(data []byte, pos int64, bit uint64) []byte {
+	if need := pos/8 + 1; need > int64(len(data)) {
+		data = append(data, make([]byte, need-int64(len(data)))...)
+	}
+	mask := byte(0x80) >> (pos % 8)
+	if bit == 1 {
+		data[pos/8] |= mask
+	} else {
+		data[pos/8] &^= mask
+	}
+	return data
+}
+
+// mockBitRange returns the first and last bit positions r selects in a
+// string of length bytes; first > last when it selects none.
+func mockBitRange(length int64, r *BitRange) (int64, int64) {
+	if r == nil {
+		return 0, length*8 - 1
+	}
+	size := length
+	if r.Bit {
+		size = length * 8
+	}
+	start, end := r.Start, size-1
+	if r.End != nil {
+		end = *r.End
+	}
+	if start < 0 {
+		start += size
+	}
+	if end < 0 {
+		end += size
+	}
+	start = max(start, 0)
+	end = min(end, size-1)
+	if r.Bit {
+		return start, end
+	}
+	return start * 8, end*8 + 7
+}
+
+// SetBit mock implementation
+func (m *MockClient) SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.hashes[key] == nil {
-		m.hashes[key] = make(map[string][]byte)
+	data, err := m.stringBytes("SETBIT", key)
+	if err != nil {
+		return 0, err
 	}
-
-	var newFields int64
-	for field, value := range fields {
-		if _, exists := m.hashes[key][field]; !exists {
-			newFields++
-		}
-		m.hashes[key][field] = []byte(value)
+	if offset < 0 || (value != 0 && value != 1) {
+		return 0, fmt.Errorf("SETBIT failed: ERR bit offset is not an integer or out of range")
 	}
+	previous := mockBit(data, offset)
+	m.strings[key] = setMockBit(slices.Clone(data), offset, uint64(value))
+	return int64(previous), nil
+}
 
-	return newFields, nil
+// GetBit mock implementation
+func (m *MockClient) GetBit(ctx context.Context, key string, offset int64) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, err := m.stringBytes("GETBIT", key)
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("GETBIT failed: ERR bit offset is not an integer or out of range")
+	}
+	return int64(mockBit(data, offset)), nil
 }
 
-func (m *MockClient) GetMapField(ctx context.Context, key, field string) ([]byte, bool, error) {
+// BitCount mock implementation
+func (m *MockClient) BitCount(ctx context.Context, key string, r *BitRange) (int64, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	if hash, exists := m.hashes[key]; exists {
-		val, fieldExists := hash[field]
-		return val, fieldExists, nil
+	data, err := m.stringBytes("BITCOUNT", key)
+	if err != nil {
+		return 0, err
 	}
-	return nil, false, nil
+	first, last := mockBitRange(int64(len(data)), r)
+	var count int64
+	for pos := first; pos <= last; pos++ {
+		count += int64(mockBit(data, pos))
+	}
+	return count, nil
 }
 
-func (m *MockClient) GetMapFields(ctx context.Context, key string, fields []string) (map[string][]byte, error) {
+// BitPos mock implementation. Like BITPOS, looking for a clear bit without
+// an end reports the bit after the string when every bit is set.
+func (m *MockClient) BitPos(ctx context.Context, key string, bit int64, r *BitRange) (int64, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	result := make(map[string][]byte)
-	if hash, exists := m.hashes[key]; exists {
-		for _, field := range fields {
-			if val, ok := hash[field]; ok {
-				result[field] = val
-			}
+	data, err := m.stringBytes("BITPOS", key)
+	if err != nil {
+		return 0, err
+	}
+	if bit != 0 && bit != 1 {
+		return 0, fmt.Errorf("BITPOS failed: ERR The bit argument must be 1 or 0.")
+	}
+	if len(data) == 0 {
+		if bit == 0 {
+			return 0, nil
 		}
+		return -1, nil
 	}
-	return result, nil
+	first, last := mockBitRange(int64(len(data)), r)
+	for pos := first; pos <= last; pos++ {
+		if mockBit(data, pos) == uint64(bit) {
+			return pos, nil
+		}
+	}
+	if bit == 0 && first <= last && (r == nil || r.End == nil) {
+		return last + 1, nil
+	}
+	return -1, nil
 }
 
-func (m *MockClient) DeleteMapFields(ctx context.Context, key string, fields []string) (int64, error) {
+// BitOp mock implementation
+func (m *MockClient) BitOp(ctx context.Context, operation, destination string, keys []string) (int64, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	var deleted int64
-	if hash, exists := m.hashes[key]; exists {
-		for _, field := range fields {
-			if _, ok := hash[field]; ok {
-				delete(hash, field)
-				deleted++
-			}
+	switch operation {
+	case "AND", "OR", "XOR", "ONE":
+	case "NOT":
+		if len(keys) != 1 {
+			return 0, fmt.Errorf("BITOP failed: ERR BITOP NOT must be called with a single source key.")
+		}
+	case "DIFF", "DIFF1", "ANDOR":
+		if len(keys) < 2 {
+			return 0, fmt.Errorf("BITOP failed: ERR BITOP %s must be called with at least two source keys.", operation)
+		}
+	default:
+		return 0, fmt.Errorf("BITOP failed: ERR syntax error")
+	}
+	sources := make([][]byte, len(keys))
+	length := 0
+	for i, key := range keys {
+		data, err := m.stringBytes("BITOP", key)
+		if err != nil {
+			return 0, err
 		}
+		sources[i] = data
+		length = max(length, len(data))
+	}
+	if length == 0 {
+		m.deleteKey(destination)
+		return 0, nil
 	}
-	return deleted, nil
-}
 
-func (m *MockClient) ListMapKeys(ctx context.Context, key string) ([]string, error) {
+	byteAt := func(src []byte, i int) byte {
+		if i < len(src) {
+			return src[i]
+		}
+		return 0
+	}
+	result := make([]byte, length)
+	for i := range result {
+		first := byteAt(sources[0], i)
+		var and, or, once, twice byte = 0xff, 0, 0, 0
+		var rest byte
+		for j, src := range sources {
+			b := byteAt(src, i)
+			and &= b
+			or |= b
+			twice |= once & b
+			once |= b
+			if j > 0 {
+				rest |= b
+			}
+		}
+		switch operation {
+		case "AND":
+			result[i] = and
+		case "OR":
+			result[i] = or
+		case "XOR":
+			for _, src := range sources {
+				result[i] ^= byteAt(src, i)
+			}
+		case "NOT":
+			result[i] = ^first
+		case "DIFF":
+			result[i] = first &^ rest
+		case "DIFF1":
+			result[i] = ^first & rest
+		case "ANDOR":
+			result[i] = first & rest
+		case "ONE":
+			result[i] = once &^ twice
+		}
+	}
+	m.deleteKey(destination)
+	m.strings[destination] = result
+	return int64(length), nil
+}
+
+// mockBitFieldType is a parsed BITFIELD encoding.
+type mockBitFieldType struct {
+	signed bool
+	width  int64
+}
+
+// bounds returns the smallest and largest values of the type.
+func (t mockBitFieldType) bounds() (*big.Int, *big.Int) {
+	if t.signed {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(t.width-1))
+		return new(big.Int).Neg(limit), limit.Sub(limit, big.NewInt(1))
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.width))
+	return big.NewInt(0), limit.Sub(limit, big.NewInt(1))
+}
+
+// BitField mock implementation
+func (m *MockClient) BitField(ctx context.Context, key string, ops []BitFieldOp, readOnly bool) ([]*int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name := "BITFIELD"
+	if readOnly {
+		name = "BITFIELD_RO"
+	}
+	data, err := m.stringBytes(name, key)
+	if err != nil {
+		return nil, err
+	}
+	data = slices.Clone(data)
+	written := false
+	overflow := "WRAP"
+	results := []*int64{}
+	for _, op := range ops {
+		if readOnly && op.Op != "GET" {
+			return nil, fmt.Errorf("%s failed: ERR BITFIELD_RO only supports the GET subcommand", name)
+		}
+		if op.Op == "OVERFLOW" {
+			switch op.Overflow {
+			case "WRAP", "SAT", "FAIL":
+				overflow = op.Overflow
+			default:
+				return nil, fmt.Errorf("%s failed: ERR Invalid OVERFLOW type specified", name)
+			}
+			continue
+		}
+
+		var typ mockBitFieldType
+		width, err := strconv.ParseInt(op.Encoding[min(1, len(op.Encoding)):], 10, 64)
+		typ.signed, typ.width = strings.HasPrefix(op.Encoding, "i"), width
+		if err != nil || (!typ.signed && !strings.HasPrefix(op.Encoding, "u")) || width < 1 || width > 64 || (!typ.signed && width > 63) {
+			return nil, fmt.Errorf("%s failed: ERR Invalid bitfield type. Use something like i16 u8. Note that u64 is not supported but i64 is.", name)
+		}
+		offset, err := strconv.ParseInt(strings.TrimPrefix(op.Offset, "#"), 10, 64)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("%s failed: ERR bit offset is not an integer or out of range", name)
+		}
+		if strings.HasPrefix(op.Offset, "#") {
+			offset *= width
+		}
+
+		raw := new(big.Int)
+		for i := int64(0); i < width; i++ {
+			raw.Lsh(raw, 1)
+			raw.Or(raw, big.NewInt(int64(mockBit(data, offset+i))))
+		}
+		modulus := new(big.Int).Lsh(big.NewInt(1), uint(width))
+		current := new(big.Int).Set(raw)
+		lowest, highest := typ.bounds()
+		if typ.signed && current.Cmp(highest) > 0 {
+			current.Sub(current, modulus)
+		}
+
+		var value *big.Int
+		switch op.Op {
+		case "GET":
+			v := current.Int64()
+			results = append(results, &v)
+			continue
+		case "SET":
+			value = big.NewInt(op.Value)
+		case "INCRBY":
+			value = new(big.Int).Add(current, big.NewInt(op.Value))
+		default:
+			return nil, fmt.Errorf("%s failed: ERR syntax error", name)
+		}
+		if value.Cmp(lowest) < 0 || value.Cmp(highest) > 0 {
+			switch overflow {
+			case "FAIL":
+				results = append(results, nil)
+				continue
+			case "SAT":
+				if value.Cmp(lowest) < 0 {
+					value = lowest
+				} else {
+					value = highest
+				}
+			default:
+				value.Sub(value, lowest).Mod(value, modulus).Add(value, lowest)
+			}
+		}
+
+		bits := new(big.Int).Set(value)
+		if bits.Sign() < 0 {
+			bits.Add(bits, modulus)
+		}
+		for i := int64(0); i < width; i++ {
+			data = setMockBit(data, offset+i, uint64(bits.Bit(int(width-1-i))))
+		}
+		written = true
+		v := value.Int64()
+		if op.Op == "SET" {
+			v = current.Int64()
+		}
+		results = append(results, &v)
+	}
+	if written {
+		m.strings[key] = data
+	}
+	return results, nil
+}
+
+// HyperLogLog operations
+
+// mockHLLPrefix starts the strings the mock stores HyperLogLogs in, as the
+// "HYLL" magic starts real ones. The rest is a JSON array of the members.
+const mockHLLPrefix = "HYLL"
+
+// hll returns the members of the HyperLogLog at key, nil when it does not
+// exist. The caller must hold m.mu.
+func (m *MockClient) hll(cmd, key string) (map[string]bool, error) {
+	data, err := m.stringBytes(cmd, key)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	var members []string
+	if !bytes.HasPrefix(data, []byte(mockHLLPrefix)) || json.Unmarshal(data[len(mockHLLPrefix):], &members) != nil {
+		return nil, fmt.Errorf("%s failed: WRONGTYPE Key is not a valid HyperLogLog string value.", cmd)
+	}
+	set := make(map[string]bool, len(members))
+	for _, member := range members {
+		set[member] = true
+	}
+	return set, nil
+}
+
+// setHLL stores members as the HyperLogLog at key. The caller must hold
+// m.mu.
+func (m *MockClient) setHLL(key string, members map[string]bool) {
+	data, _ := json.Marshal(slices.Sorted(maps.Keys(members)))
+	m.strings[key] = append([]byte(mockHLLPrefix), data...)
+}
+
+// PFAdd mock implementation. The mock keeps every member, so PFCount is
+// exact rather than an estimate.
+func (m *MockClient) PFAdd(ctx context.Context, key string, elements []string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	members, err := m.hll("PFADD", key)
+	if err != nil {
+		return false, err
+	}
+	changed := members == nil
+	if members == nil {
+		members = map[string]bool{}
+	}
+	for _, element := range elements {
+		if !members[element] {
+			members[element] = true
+			changed = true
+		}
+	}
+	if changed {
+		m.setHLL(key, members)
+	}
+	return changed, nil
+}
+
+// PFCount mock implementation
+func (m *MockClient) PFCount(ctx context.Context, keys []string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	union := map[string]bool{}
+	for _, key := range keys {
+		members, err := m.hll("PFCOUNT", key)
+		if err != nil {
+			return 0, err
+		}
+		maps.Copy(union, members)
+	}
+	return int64(len(union)), nil
+}
+
+// PFMerge mock implementation
+func (m *MockClient) PFMerge(ctx context.Context, destination string, keys []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	union, err := m.hll("PFMERGE", destination)
+	if err != nil {
+		return err
+	}
+	if union == nil {
+		union = map[string]bool{}
+	}
+	for _, key := range keys {
+		members, err := m.hll("PFMERGE", key)
+		if err != nil {
+			return err
+		}
+		maps.Copy(union, members)
+	}
+	m.setHLL(destination, union)
+	return nil
+}
+
+// Hash operations
+
+func (m *MockClient) GetMap(ctx context.Context, key string) (map[string][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if hash, exists := m.hashes[key]; exists {
+		result := make(map[string][]byte, len(hash))
+		for k, v := range hash {
+			result[k] = v
+		}
+		return result, nil
+	}
+	return make(map[string][]byte), nil
+}
+
+func (m *MockClient) SetMap(ctx context.Context, key string, fields map[string]string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.hashes[key] == nil {
+		m.hashes[key] = make(map[string][]byte)
+	}
+
+	var newFields int64
+	for field, value := range fields {
+		if _, exists := m.hashes[key][field]; !exists {
+			newFields++
+		}
+		m.hashes[key][field] = []byte(value)
+	}
+
+	return newFields, nil
+}
+
+func (m *MockClient) GetMapField(ctx context.Context, key, field string) ([]byte, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if hash, exists := m.hashes[key]; exists {
+		val, fieldExists := hash[field]
+		return val, fieldExists, nil
+	}
+	return nil, false, nil
+}
+
+func (m *MockClient) GetMapFields(ctx context.Context, key string, fields []string) (map[string][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make(map[string][]byte)
+	if hash, exists := m.hashes[key]; exists {
+		for _, field := range fields {
+			if val, ok := hash[field]; ok {
+				result[field] = val
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *MockClient) DeleteMapFields(ctx context.Context, key string, fields []string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var deleted int64
+	if hash, exists := m.hashes[key]; exists {
+		for _, field := range fields {
+			if _, ok := hash[field]; ok {
+				delete(hash, field)
+				deleted++
+			}
+		}
+	}
+	return deleted, nil
+}
+
+func (m *MockClient) ListMapKeys(ctx context.Context, key string) ([]string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -1056,6 +2296,215 @@ func (m *MockClient) TrimList(ctx context.Context, key string, start, stop int64
 	return true, nil
 }
 
+// listKey checks that key holds a list or does not exist. The caller must
+// hold m.mu.
+func (m *MockClient) listKey(cmd, key string) error {
+	if t := m.keyType(key); t != "none" && t != "list" {
+		return fmt.Errorf("%s failed: WRONGTYPE Operation against a key holding the wrong kind of value", cmd)
+	}
+	return nil
+}
+
+// GetListPositions mock implementation
+func (m *MockClient) GetListPositions(ctx context.Context, key, element string, opts ListPositionOptions) ([]int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.listKey("LPOS", key); err != nil {
+		return nil, err
+	}
+	if opts.Count < 0 || opts.MaxLen < 0 {
+		return nil, fmt.Errorf("LPOS failed: ERR value is out of range, must be positive")
+	}
+	list := m.lists[key]
+	rank := opts.Rank
+	if rank == 0 {
+		rank = 1
+	}
+	skip := rank - 1
+	step, index := int64(1), int64(0)
+	if rank < 0 {
+		skip = -rank - 1
+		step, index = -1, int64(len(list))-1
+	}
+
+	positions := []int64{}
+	for compared := int64(0); index >= 0 && index < int64(len(list)); index += step {
+		if opts.MaxLen > 0 && compared == opts.MaxLen {
+			break
+		}
+		compared++
+		if string(list[index]) != element {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		positions = append(positions, index)
+		if opts.Count > 0 && int64(len(positions)) == opts.Count {
+			break
+		}
+	}
+	return positions, nil
+}
+
+// InsertList mock implementation
+func (m *MockClient) InsertList(ctx context.Context, key string, before bool, pivot, element string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.listKey("LINSERT", key); err != nil {
+		return 0, err
+	}
+	list, exists := m.lists[key]
+	if !exists {
+		return 0, nil
+	}
+	for i, v := range list {
+		if string(v) != pivot {
+			continue
+		}
+		at := i
+		if !before {
+			at++
+		}
+		updated := make([][]byte, 0, len(list)+1)
+		updated = append(updated, list[:at]...)
+		updated = append(updated, []byte(element))
+		m.lists[key] = append(updated, list[at:]...)
+		return int64(len(m.lists[key])), nil
+	}
+	return -1, nil
+}
+
+// RemoveListElements mock implementation
+func (m *MockClient) RemoveListElements(ctx context.Context, key string, count int64, element string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.listKey("LREM", key); err != nil {
+		return 0, err
+	}
+	list := m.lists[key]
+	remove := make([]bool, len(list))
+	var removed int64
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+	for n := 0; n < len(list); n++ {
+		i := n
+		if count < 0 {
+			i = len(list) - 1 - n
+		}
+		if string(list[i]) == element {
+			remove[i] = true
+			removed++
+			if removed == limit {
+				break
+			}
+		}
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+
+	kept := make([][]byte, 0, len(list)-int(removed))
+	for i, v := range list {
+		if !remove[i] {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) == 0 {
+		delete(m.lists, key)
+	} else {
+		m.lists[key] = kept
+	}
+	return removed, nil
+}
+
+// MoveList mock implementation
+func (m *MockClient) MoveList(ctx context.Context, source, destination, from, to string) ([]byte, bool, error) {
+	for _, end := range []string{from, to} {
+		if end != "LEFT" && end != "RIGHT" {
+			return nil, false, fmt.Errorf("LMOVE failed: ERR syntax error")
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range []string{source, destination} {
+		if err := m.listKey("LMOVE", key); err != nil {
+			return nil, false, err
+		}
+	}
+	if len(m.lists[source]) == 0 {
+		return nil, false, nil
+	}
+	value := m.popListEnd(source, from == "LEFT")
+	if to == "LEFT" {
+		m.lists[destination] = append([][]byte{value}, m.lists[destination]...)
+	} else {
+		m.lists[destination] = append(m.lists[destination], value)
+	}
+	return value, true, nil
+}
+
+// MultiPopList mock implementation
+func (m *MockClient) MultiPopList(ctx context.Context, keys []string, tail bool, count int64) (*ListMultiPop, error) {
+	if count <= 0 {
+		count = 1
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		if err := m.listKey("LMPOP", key); err != nil {
+			return nil, err
+		}
+		if len(m.lists[key]) == 0 {
+			continue
+		}
+		pop := &ListMultiPop{Key: key}
+		for int64(len(pop.Values)) < count && len(m.lists[key]) > 0 {
+			pop.Values = append(pop.Values, m.popListEnd(key, !tail))
+		}
+		return pop, nil
+	}
+	return nil, nil
+}
+
+// PushListExisting mock implementation
+func (m *MockClient) PushListExisting(ctx context.Context, key string, values []string, tail bool) (int64, error) {
+	cmd := "LPUSHX"
+	if tail {
+		cmd = "RPUSHX"
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.listKey(cmd, key); err != nil {
+		return 0, err
+	}
+	list, exists := m.lists[key]
+	if !exists {
+		return 0, nil
+	}
+	for _, v := range values {
+		if tail {
+			list = append(list, []byte(v))
+		} else {
+			list = append([][]byte{[]byte(v)}, list...)
+		}
+	}
+	m.lists[key] = list
+	return int64(len(list)), nil
+}
+
 // Set operations
 
 func (m *MockClient) AddSet(ctx context.Context, key string, members []string) (int64, error) {
@@ -1199,11 +2648,7 @@ func (m *MockClient) ExistsKey(ctx context.Context, key string) (bool, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	_, existsStr := m.strings[key]
-	_, existsHash := m.hashes[key]
-	_, existsList := m.lists[key]
-	_, existsSet := m.sets[key]
-	return existsStr || existsHash || existsList || existsSet, nil
+	return m.keyType(key) != "none", nil
 }
 
 // MemoryUsage mock implementation
@@ -1383,111 +2828,1978 @@ func (m *MockClient) SetDifference(ctx context.Context, firstKey string, otherKe
 	return members, nil
 }
 
-// AddStream mock implementation
-func (m *MockClient) AddStream(ctx context.Context, key string, id string, fields map[string]string) (string, error) {
-	return id, nil
+// AddStream mock implementation. An id of "*" is generated as one
+// millisecond after the last generated ID; explicit IDs must exceed it.
+// Approximate trims evict exactly like exact ones.
+func (m *MockClient) AddStream(ctx context.Context, key string, id string, fields map[string]string, opts StreamAddOptions) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch m.keyType(key) {
+	case "stream":
+	case "none":
+		if opts.NoMkStream {
+			return "", nil
+		}
+	default:
+		return "", fmt.Errorf("XADD failed: WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+	if opts.Trim != nil {
+		if err := validateMockTrim("XADD", *opts.Trim); err != nil {
+			return "", err
+		}
+	}
+	top := m.streamTop(key)
+	next := mockStreamID{top.lastID.ms + 1, 0}
+	if id != "*" {
+		var ok bool
+		if next, ok = parseMockStreamID(id, 0); !ok {
+			return "", fmt.Errorf("XADD failed: ERR Invalid stream ID specified as stream command argument")
+		}
+		if !top.lastID.less(next) {
+			return "", fmt.Errorf("XADD failed: ERR The ID specified in XADD is equal or smaller than the target stream top item")
+		}
+	}
+
+	values := make(map[string][]byte, len(fields))
+	for k, v := range fields {
+		values[k] = []byte(v)
+	}
+	m.streams[key] = append(m.streams[key], StreamEntry{ID: next.String(), FieldValues: values})
+	top.lastID = next
+	top.added++
+	m.tops[key] = top
+	if opts.Trim != nil {
+		m.trimStream(key, *opts.Trim)
+	}
+	return next.String(), nil
+}
+
+// mockStreamTop is the last generated ID of a stream, the number of entries
+// ever added and the highest deleted ID.
+type mockStreamTop struct {
+	lastID     mockStreamID
+	added      int64
+	maxDeleted mockStreamID
+}
+
+// streamTop returns the top of the stream at key, derived from its entries
+// when none was recorded. The caller must hold m.mu.
+func (m *MockClient) streamTop(key string) *mockStreamTop {
+	if top, ok := m.tops[key]; ok {
+		copied := *top
+		return &copied
+	}
+	entries := m.streams[key]
+	top := &mockStreamTop{added: int64(len(entries))}
+	if len(entries) > 0 {
+		top.lastID, _ = parseMockStreamID(entries[len(entries)-1].ID, 0)
+	}
+	return top
+}
+
+// removeStreamEntries removes the entries of the stream at key for which
+// remove returns true and returns how many it removed. The caller must hold
+// m.mu.
+func (m *MockClient) removeStreamEntries(key string, remove func(i int, id mockStreamID) bool) int64 {
+	top := m.streamTop(key)
+	entries := m.streams[key]
+	kept := entries[:0:0]
+	for i, entry := range entries {
+		id, _ := parseMockStreamID(entry.ID, 0)
+		if !remove(i, id) {
+			kept = append(kept, entry)
+			continue
+		}
+		if top.maxDeleted.less(id) {
+			top.maxDeleted = id
+		}
+	}
+	m.streams[key] = kept
+	m.tops[key] = top
+	return int64(len(entries) - len(kept))
 }
 
-// GetStreamRange mock implementation
-func (m *MockClient) GetStreamRange(ctx context.Context, key string, start string, end string, count int64) ([]StreamEntry, error) {
-	return []StreamEntry{}, nil
+// validateMockTrim checks trim like the server does.
+func validateMockTrim(cmd string, trim StreamTrim) error {
+	switch strings.ToUpper(trim.Strategy) {
+	case "MAXLEN":
+		if n, err := strconv.ParseInt(trim.Threshold, 10, 64); err != nil || n < 0 {
+			return fmt.Errorf("%s failed: ERR The MAXLEN argument must be >= 0.", cmd)
+		}
+	case "MINID":
+		if _, ok := parseMockStreamID(trim.Threshold, 0); !ok {
+			return fmt.Errorf("%s failed: ERR Invalid stream ID specified as stream command argument", cmd)
+		}
+	default:
+		return fmt.Errorf("trim strategy must be MAXLEN or MINID, got %q", trim.Strategy)
+	}
+	if trim.Limit != 0 && !trim.Approximate {
+		return fmt.Errorf("LIMIT requires approximate trimming")
+	}
+	return nil
 }
 
-// GetStreamLength mock implementation
-func (m *MockClient) GetStreamLength(ctx context.Context, key string) (int64, error) {
-	return 0, nil
+// trimStream applies a validated trim to the stream at key. The caller must
+// hold m.mu.
+func (m *MockClient) trimStream(key string, trim StreamTrim) int64 {
+	if strings.ToUpper(trim.Strategy) == "MAXLEN" {
+		maxLen, _ := strconv.Atoi(trim.Threshold)
+		excess := len(m.streams[key]) - maxLen
+		return m.removeStreamEntries(key, func(i int, id mockStreamID) bool { return i < excess })
+	}
+	minID, _ := parseMockStreamID(trim.Threshold, 0)
+	return m.removeStreamEntries(key, func(i int, id mockStreamID) bool { return id.less(minID) })
 }
 
-// ReadStream mock implementation
-func (m *MockClient) ReadStream(ctx context.Context, key string, id string, count int64) ([]StreamEntry, error) {
-	return []StreamEntry{}, nil
+// TrimStream mock implementation
+func (m *MockClient) TrimStream(ctx context.Context, key string, trim StreamTrim) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := validateMockTrim("XTRIM", trim); err != nil {
+		return 0, err
+	}
+	switch m.keyType(key) {
+	case "stream":
+		return m.trimStream(key, trim), nil
+	case "none":
+		return 0, nil
+	}
+	return 0, fmt.Errorf("XTRIM failed: WRONGTYPE Operation against a key holding the wrong kind of value")
 }
 
-// DumpKey mock implementation
-func (m *MockClient) DumpKey(ctx context.Context, key string) ([]byte, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+// DeleteStreamEntries mock implementation
+func (m *MockClient) DeleteStreamEntries(ctx context.Context, key string, ids []string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	if value, exists := m.strings[key]; exists {
-		result := make([]byte, len(value))
-		copy(result, value)
-		return result, nil
+	deleted := map[mockStreamID]bool{}
+	for _, id := range ids {
+		parsed, ok := parseMockStreamID(id, 0)
+		if !ok {
+			return 0, fmt.Errorf("XDEL failed: ERR Invalid stream ID specified as stream command argument")
+		}
+		deleted[parsed] = true
 	}
-	return nil, nil
+	switch m.keyType(key) {
+	case "stream":
+		return m.removeStreamEntries(key, func(i int, id mockStreamID) bool { return deleted[id] }), nil
+	case "none":
+		return 0, nil
+	}
+	return 0, fmt.Errorf("XDEL failed: WRONGTYPE Operation against a key holding the wrong kind of value")
 }
 
-// RestoreKey mock implementation
-func (m *MockClient) RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error) {
+// SetStreamID mock implementation
+func (m *MockClient) SetStreamID(ctx context.Context, key, lastID string, entriesAdded int64, maxDeletedID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.strings[key] = serialized
-	return true, nil
+	if err := m.streamKey("XSETID", key); err != nil {
+		return err
+	}
+	id, ok := parseMockStreamID(lastID, 0)
+	if !ok {
+		return fmt.Errorf("XSETID failed: ERR Invalid stream ID specified as stream command argument")
+	}
+	top := m.streamTop(key)
+	entries := m.streams[key]
+	if len(entries) > 0 {
+		if last, _ := parseMockStreamID(entries[len(entries)-1].ID, 0); id.less(last) {
+			return fmt.Errorf("XSETID failed: ERR The ID specified in XSETID is smaller than the target stream top item")
+		}
+	}
+	if entriesAdded > 0 {
+		if entriesAdded < int64(len(entries)) {
+			return fmt.Errorf("XSETID failed: ERR The entries_added specified in XSETID is smaller than the target stream length")
+		}
+		top.added = entriesAdded
+	}
+	if maxDeletedID != "" {
+		if top.maxDeleted, ok = parseMockStreamID(maxDeletedID, 0); !ok {
+			return fmt.Errorf("XSETID failed: ERR Invalid stream ID specified as stream command argument")
+		}
+		if id.less(top.maxDeleted) {
+			return fmt.Errorf("XSETID failed: ERR The ID specified in XSETID is smaller than the provided max_deleted_entry_id")
+		}
+	}
+	top.lastID = id
+	m.tops[key] = top
+	return nil
 }
 
-// ObjectIdletime mock implementation
-func (m *MockClient) ObjectIdletime(ctx context.Context, key string) (int64, error) {
-	return 0, nil
+// GetStreamRange mock implementation. start and end are inclusive IDs,
+// exclusive IDs "(id", or "-" and "+".
+func (m *MockClient) GetStreamRange(ctx context.Context, key string, start string, end string, count int64) ([]StreamEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	from, to, err := parseMockStreamRange("XRANGE", start, end)
+	if err != nil {
+		return nil, err
+	}
+	result := []StreamEntry{}
+	for _, entry := range m.streams[key] {
+		id, _ := parseMockStreamID(entry.ID, 0)
+		if id.less(from) || to.less(id) {
+			continue
+		}
+		if count > 0 && int64(len(result)) >= count {
+			break
+		}
+		result = append(result, entry)
+	}
+	return result, nil
 }
 
-// ConfigGet mock implementation
-func (m *MockClient) ConfigGet(ctx context.Context, parameter string) (map[string]string, error) {
-	result := make(map[string]string)
-	result[parameter] = "mock_value"
+// GetStreamRevRange mock implementation
+func (m *MockClient) GetStreamRevRange(ctx context.Context, key string, end string, start string, count int64) ([]StreamEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	from, to, err := parseMockStreamRange("XREVRANGE", start, end)
+	if err != nil {
+		return nil, err
+	}
+	result := []StreamEntry{}
+	entries := m.streams[key]
+	for i := len(entries) - 1; i >= 0; i-- {
+		id, _ := parseMockStreamID(entries[i].ID, 0)
+		if id.less(from) || to.less(id) {
+			continue
+		}
+		if count > 0 && int64(len(result)) >= count {
+			break
+		}
+		result = append(result, entries[i])
+	}
 	return result, nil
 }
 
-// ConfigSet mock implementation
-func (m *MockClient) ConfigSet(ctx context.Context, parameter, value string) (bool, error) {
-	return true, nil
+// parseMockStreamRange parses the inclusive bounds of a range, turning
+// exclusive bounds "(id" into the next ID inwards.
+func parseMockStreamRange(cmd, start, end string) (mockStreamID, mockStreamID, error) {
+	invalid := fmt.Errorf("%s failed: ERR Invalid stream ID specified as stream command argument", cmd)
+	from, ok := parseMockStreamID(strings.TrimPrefix(start, "("), 0)
+	if !ok {
+		return mockStreamID{}, mockStreamID{}, invalid
+	}
+	to, ok := parseMockStreamID(strings.TrimPrefix(end, "("), math.MaxUint64)
+	if !ok {
+		return mockStreamID{}, mockStreamID{}, invalid
+	}
+	if strings.HasPrefix(start, "(") {
+		if from == (mockStreamID{math.MaxUint64, math.MaxUint64}) {
+			return mockStreamID{}, mockStreamID{}, fmt.Errorf("%s failed: ERR invalid start ID for the interval", cmd)
+		}
+		if from.seq++; from.seq == 0 {
+			from.ms++
+		}
+	}
+	if strings.HasPrefix(end, "(") {
+		if to == (mockStreamID{}) {
+			return mockStreamID{}, mockStreamID{}, fmt.Errorf("%s failed: ERR invalid end ID for the interval", cmd)
+		}
+		if to.seq--; to.seq == math.MaxUint64 {
+			to.ms--
+		}
+	}
+	return from, to, nil
 }
 
-// GetDatabaseSize mock implementation
-func (m *MockClient) GetDatabaseSize(ctx context.Context) (int64, error) {
+// GetStreamLength mock implementation
+func (m *MockClient) GetStreamLength(ctx context.Context, key string) (int64, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	return int64(len(m.strings)), nil
+	return int64(len(m.streams[key])), nil
 }
 
-// GetSlowlog mock implementation
-func (m *MockClient) GetSlowlog(ctx context.Context, count int64) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{}, nil
-}
+// ReadStream mock implementation. Entries after id are returned.
+func (m *MockClient) ReadStream(ctx context.Context, key string, id string, count int64) ([]StreamEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 
-// GetClusterInfo mock implementation
-func (m *MockClient) GetClusterInfo(ctx context.Context) (map[string]string, error) {
-	result := make(map[string]string)
-	result["cluster_state"] = "ok"
+	after, ok := parseMockStreamID(id, 0)
+	if !ok {
+		return nil, fmt.Errorf("XREAD failed: ERR Invalid stream ID specified as stream command argument")
+	}
+	result := []StreamEntry{}
+	for _, entry := range m.streams[key] {
+		entryID, _ := parseMockStreamID(entry.ID, 0)
+		if !after.less(entryID) {
+			continue
+		}
+		if count > 0 && int64(len(result)) >= count {
+			break
+		}
+		result = append(result, entry)
+	}
 	return result, nil
 }
 
-// GetClusterNodes mock implementation
-func (m *MockClient) GetClusterNodes(ctx context.Context) (string, error) {
-	return "mock_cluster_nodes", nil
+// mockStreamGroup is a consumer group of a mock stream.
+type mockStreamGroup struct {
+	lastID      mockStreamID
+	entriesRead int64
+	pending     map[string]*mockPendingEntry
+	// consumers maps each consumer to the time it last read or claimed.
+	consumers map[string]time.Time
 }
 
-// GetKeySlot mock implementation
-func (m *MockClient) GetKeySlot(ctx context.Context, key string) (int64, error) {
-	return 0, nil
+// mockPendingEntry is a delivered entry awaiting XACK.
+type mockPendingEntry struct {
+	consumer   string
+	delivered  time.Time
+	deliveries int64
 }
 
-// CountKeysInSlot mock implementation
-func (m *MockClient) CountKeysInSlot(ctx context.Context, slot int64) (int64, error) {
-	return 0, nil
+// now returns the mock's current time.
+func (m *MockClient) now() time.Time {
+	if m.Clock != nil {
+		return m.Clock()
+	}
+	return time.Now()
 }
 
-// EvalScript mock implementation
-func (m *MockClient) EvalScript(ctx context.Context, script string, keys []string, args []string) (interface{}, error) {
-	return "OK", nil
+// streamGroup returns consumer group of the stream at key. The caller must
+// hold m.mu.
+func (m *MockClient) streamGroup(cmd, key, group string) (*mockStreamGroup, error) {
+	g, ok := m.groups[key][group]
+	if !ok {
+		return nil, fmt.Errorf("%s failed: NOGROUP No such key '%s' or consumer group '%s'", cmd, key, group)
+	}
+	return g, nil
 }
 
-// LoadScript mock implementation
-func (m *MockClient) LoadScript(ctx context.Context, script string) (string, error) {
-	return "abc123def456", nil
+// streamEntry returns the entry id of the stream at key. The caller must
+// hold m.mu.
+func (m *MockClient) streamEntry(key, id string) (StreamEntry, bool) {
+	for _, entry := range m.streams[key] {
+		if entry.ID == id {
+			return entry, true
+		}
+	}
+	return StreamEntry{}, false
 }
 
-// EvalSHA mock implementation
-func (m *MockClient) EvalSHA(ctx context.Context, sha string, keys []string, args []string) (interface{}, error) {
-	return "OK", nil
+// pendingIDs returns the pending IDs of g in stream order.
+func (g *mockStreamGroup) pendingIDs() []string {
+	ids := make([]string, 0, len(g.pending))
+	for id := range g.pending {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		idA, _ := parseMockStreamID(a, 0)
+		idB, _ := parseMockStreamID(b, 0)
+		switch {
+		case idA.less(idB):
+			return -1
+		case idB.less(idA):
+			return 1
+		}
+		return 0
+	})
+	return ids
+}
+
+// CreateStreamGroup mock implementation
+func (m *MockClient) CreateStreamGroup(ctx context.Context, key, group, id string, mkstream bool, entriesRead *int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch m.keyType(key) {
+	case "stream":
+	case "none":
+		if !mkstream {
+			return fmt.Errorf("XGROUP CREATE failed: ERR The XGROUP subcommand requires the key to exist")
+		}
+		m.streams[key] = []StreamEntry{}
+	default:
+		return fmt.Errorf("XGROUP CREATE failed: WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+	if _, exists := m.groups[key][group]; exists {
+		return fmt.Errorf("XGROUP CREATE failed: BUSYGROUP Consumer Group name already exists")
+	}
+
+	g := &mockStreamGroup{pending: map[string]*mockPendingEntry{}, consumers: map[string]time.Time{}}
+	if id == "$" {
+		top := m.streamTop(key)
+		g.lastID, g.entriesRead = top.lastID, top.added
+	} else {
+		var ok bool
+		if g.lastID, ok = parseMockStreamID(id, 0); !ok {
+			return fmt.Errorf("XGROUP CREATE failed: ERR Invalid stream ID specified as stream command argument")
+		}
+		g.entriesRead = m.countThrough(key, g.lastID)
+	}
+	if entriesRead != nil {
+		g.entriesRead = *entriesRead
+	}
+	if m.groups[key] == nil {
+		m.groups[key] = map[string]*mockStreamGroup{}
+	}
+	m.groups[key][group] = g
+	return nil
+}
+
+// countThrough returns the number of entries ever added to the stream at key
+// up to and including id. The caller must hold m.mu.
+func (m *MockClient) countThrough(key string, id mockStreamID) int64 {
+	n := m.streamTop(key).added
+	for _, entry := range m.streams[key] {
+		if entryID, _ := parseMockStreamID(entry.ID, 0); id.less(entryID) {
+			n--
+		}
+	}
+	return n
+}
+
+// DestroyStreamGroup mock implementation
+func (m *MockClient) DestroyStreamGroup(ctx context.Context, key, group string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.keyType(key) == "none" {
+		return false, fmt.Errorf("XGROUP DESTROY failed: ERR The XGROUP subcommand requires the key to exist")
+	}
+	if _, exists := m.groups[key][group]; !exists {
+		return false, nil
+	}
+	delete(m.groups[key], group)
+	return true, nil
+}
+
+// SetStreamGroupID mock implementation
+func (m *MockClient) SetStreamGroupID(ctx context.Context, key, group, id string, entriesRead *int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XGROUP SETID", key, group)
+	if err != nil {
+		return err
+	}
+	if id == "$" {
+		g.lastID = m.streamTop(key).lastID
+	} else {
+		var ok bool
+		if g.lastID, ok = parseMockStreamID(id, 0); !ok {
+			return fmt.Errorf("XGROUP SETID failed: ERR Invalid stream ID specified as stream command argument")
+		}
+	}
+	g.entriesRead = m.countThrough(key, g.lastID)
+	if entriesRead != nil {
+		g.entriesRead = *entriesRead
+	}
+	return nil
+}
+
+// CreateStreamConsumer mock implementation
+func (m *MockClient) CreateStreamConsumer(ctx context.Context, key, group, consumer string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XGROUP CREATECONSUMER", key, group)
+	if err != nil {
+		return false, err
+	}
+	if _, exists := g.consumers[consumer]; exists {
+		return false, nil
+	}
+	g.consumers[consumer] = m.now()
+	return true, nil
+}
+
+// DeleteStreamConsumer mock implementation
+func (m *MockClient) DeleteStreamConsumer(ctx context.Context, key, group, consumer string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XGROUP DELCONSUMER", key, group)
+	if err != nil {
+		return 0, err
+	}
+	var pending int64
+	for id, p := range g.pending {
+		if p.consumer == consumer {
+			delete(g.pending, id)
+			pending++
+		}
+	}
+	delete(g.consumers, consumer)
+	return pending, nil
+}
+
+// ReadStreamGroup mock implementation. Pending entries read again through a
+// history id have their delivery count incremented.
+func (m *MockClient) ReadStreamGroup(ctx context.Context, key, group, consumer, id string, count int64, noack bool) ([]StreamEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XREADGROUP", key, group)
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	g.consumers[consumer] = now
+	result := []StreamEntry{}
+
+	if id == ">" {
+		for _, entry := range m.streams[key] {
+			entryID, _ := parseMockStreamID(entry.ID, 0)
+			if !g.lastID.less(entryID) {
+				continue
+			}
+			if count > 0 && int64(len(result)) >= count {
+				break
+			}
+			result = append(result, entry)
+			g.lastID = entryID
+			g.entriesRead++
+			if !noack {
+				g.pending[entry.ID] = &mockPendingEntry{consumer: consumer, delivered: now, deliveries: 1}
+			}
+		}
+		return result, nil
+	}
+
+	after, ok := parseMockStreamID(id, 0)
+	if !ok {
+		return nil, fmt.Errorf("XREADGROUP failed: ERR Invalid stream ID specified as stream command argument")
+	}
+	for _, pendingID := range g.pendingIDs() {
+		p := g.pending[pendingID]
+		entryID, _ := parseMockStreamID(pendingID, 0)
+		if p.consumer != consumer || !after.less(entryID) {
+			continue
+		}
+		if count > 0 && int64(len(result)) >= count {
+			break
+		}
+		entry, ok := m.streamEntry(key, pendingID)
+		if !ok {
+			entry = StreamEntry{ID: pendingID}
+		}
+		result = append(result, entry)
+		p.delivered = now
+		p.deliveries++
+	}
+	return result, nil
+}
+
+// AckStream mock implementation
+func (m *MockClient) AckStream(ctx context.Context, key, group string, ids []string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XACK", key, group)
+	if err != nil {
+		// XACK reports unknown groups as nothing acknowledged.
+		return 0, nil
+	}
+	var acked int64
+	for _, id := range ids {
+		if _, ok := g.pending[id]; ok {
+			delete(g.pending, id)
+			acked++
+		}
+	}
+	return acked, nil
+}
+
+// PendingStreamSummary mock implementation
+func (m *MockClient) PendingStreamSummary(ctx context.Context, key, group string) (*StreamPendingSummary, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	g, err := m.streamGroup("XPENDING", key, group)
+	if err != nil {
+		return nil, err
+	}
+	summary := &StreamPendingSummary{Consumers: map[string]int64{}}
+	ids := g.pendingIDs()
+	summary.Count = int64(len(ids))
+	if len(ids) > 0 {
+		summary.Lowest, summary.Highest = ids[0], ids[len(ids)-1]
+	}
+	for _, p := range g.pending {
+		summary.Consumers[p.consumer]++
+	}
+	return summary, nil
+}
+
+// PendingStream mock implementation
+func (m *MockClient) PendingStream(ctx context.Context, key, group string, opts StreamPendingOptions) ([]StreamPendingEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	g, err := m.streamGroup("XPENDING", key, group)
+	if err != nil {
+		return nil, err
+	}
+	start, end := opts.Start, opts.End
+	if start == "" {
+		start = "-"
+	}
+	if end == "" {
+		end = "+"
+	}
+	from, ok := parseMockStreamID(start, 0)
+	if !ok {
+		return nil, fmt.Errorf("XPENDING failed: ERR Invalid stream ID specified as stream command argument")
+	}
+	to, ok := parseMockStreamID(end, math.MaxUint64)
+	if !ok {
+		return nil, fmt.Errorf("XPENDING failed: ERR Invalid stream ID specified as stream command argument")
+	}
+	count := opts.Count
+	if count <= 0 {
+		count = 100
+	}
+
+	now := m.now()
+	result := []StreamPendingEntry{}
+	for _, id := range g.pendingIDs() {
+		p := g.pending[id]
+		entryID, _ := parseMockStreamID(id, 0)
+		idle := now.Sub(p.delivered).Milliseconds()
+		switch {
+		case entryID.less(from) || to.less(entryID):
+			continue
+		case opts.Consumer != "" && p.consumer != opts.Consumer:
+			continue
+		case idle < opts.MinIdle:
+			continue
+		}
+		if int64(len(result)) >= count {
+			break
+		}
+		result = append(result, StreamPendingEntry{ID: id, Consumer: p.consumer, IdleMs: idle, Deliveries: p.deliveries})
+	}
+	return result, nil
+}
+
+// ClaimStream mock implementation
+func (m *MockClient) ClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, ids []string, opts StreamClaimOptions) ([]StreamEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XCLAIM", key, group)
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	g.consumers[consumer] = now
+	result := []StreamEntry{}
+	for _, id := range ids {
+		entry, inStream := m.streamEntry(key, id)
+		p, isPending := g.pending[id]
+		if !isPending {
+			if !opts.Force || !inStream {
+				continue
+			}
+			p = &mockPendingEntry{delivered: now}
+			g.pending[id] = p
+		}
+		if now.Sub(p.delivered).Milliseconds() < minIdle {
+			continue
+		}
+		if !inStream {
+			delete(g.pending, id)
+			continue
+		}
+		p.consumer = consumer
+		p.delivered = now
+		switch {
+		case opts.Idle != nil:
+			p.delivered = now.Add(-time.Duration(*opts.Idle) * time.Millisecond)
+		case opts.Time != nil:
+			p.delivered = time.UnixMilli(*opts.Time)
+		}
+		if opts.RetryCount != nil {
+			p.deliveries = *opts.RetryCount
+		} else {
+			p.deliveries++
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// AutoClaimStream mock implementation
+func (m *MockClient) AutoClaimStream(ctx context.Context, key, group, consumer string, minIdle int64, start string, count int64) (*StreamAutoClaim, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g, err := m.streamGroup("XAUTOCLAIM", key, group)
+	if err != nil {
+		return nil, err
+	}
+	from, ok := parseMockStreamID(start, 0)
+	if !ok {
+		return nil, fmt.Errorf("XAUTOCLAIM failed: ERR Invalid stream ID specified as stream command argument")
+	}
+	if count <= 0 {
+		count = 100
+	}
+
+	now := m.now()
+	g.consumers[consumer] = now
+	result := &StreamAutoClaim{NextStart: "0-0", Entries: []StreamEntry{}, Deleted: []string{}}
+	var scanned int64
+	for _, id := range g.pendingIDs() {
+		entryID, _ := parseMockStreamID(id, 0)
+		if entryID.less(from) {
+			continue
+		}
+		if scanned >= count {
+			result.NextStart = id
+			break
+		}
+		scanned++
+		p := g.pending[id]
+		if now.Sub(p.delivered).Milliseconds() < minIdle {
+			continue
+		}
+		entry, inStream := m.streamEntry(key, id)
+		if !inStream {
+			delete(g.pending, id)
+			result.Deleted = append(result.Deleted, id)
+			continue
+		}
+		p.consumer, p.delivered = consumer, now
+		p.deliveries++
+		result.Entries = append(result.Entries, entry)
+	}
+	return result, nil
+}
+
+// streamKey returns an error unless key holds a stream. The caller must hold
+// m.mu.
+func (m *MockClient) streamKey(cmd, key string) error {
+	switch m.keyType(key) {
+	case "stream":
+		return nil
+	case "none":
+		return fmt.Errorf("%s failed: ERR no such key", cmd)
+	}
+	return fmt.Errorf("%s failed: WRONGTYPE Operation against a key holding the wrong kind of value", cmd)
+}
+
+// groupNames returns the consumer groups of the stream at key, sorted. The
+// caller must hold m.mu.
+func (m *MockClient) groupNames(key string) []string {
+	names := make([]string, 0, len(m.groups[key]))
+	for name := range m.groups[key] {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// lag returns the number of entries of the stream at key not yet delivered to
+// g, or nil when entries deleted after its last delivered ID make it
+// unknown. The caller must hold m.mu.
+func (m *MockClient) lag(key string, g *mockStreamGroup) *int64 {
+	top := m.streamTop(key)
+	var lag int64
+	switch {
+	case !g.lastID.less(top.lastID):
+	case g.lastID.less(top.maxDeleted):
+		return nil
+	default:
+		lag = max(top.added-g.entriesRead, 0)
+	}
+	return &lag
+}
+
+// GetStreamInfo mock implementation
+func (m *MockClient) GetStreamInfo(ctx context.Context, key string, full bool, count int64) (*StreamInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.streamKey("XINFO STREAM", key); err != nil {
+		return nil, err
+	}
+	entries := m.streams[key]
+	top := m.streamTop(key)
+	info := &StreamInfo{
+		Length:               int64(len(entries)),
+		LastGeneratedID:      top.lastID.String(),
+		MaxDeletedEntryID:    top.maxDeleted.String(),
+		EntriesAdded:         top.added,
+		RecordedFirstEntryID: "0-0",
+		Groups:               int64(len(m.groups[key])),
+	}
+	if len(entries) > 0 {
+		first, last := entries[0], entries[len(entries)-1]
+		info.RecordedFirstEntryID = first.ID
+		if !full {
+			info.FirstEntry, info.LastEntry = &first, &last
+		}
+	}
+	if !full {
+		return info, nil
+	}
+
+	if count <= 0 {
+		count = 10
+	}
+	info.Entries = slices.Clone(entries[:min(int64(len(entries)), count)])
+	info.GroupDetails = []StreamGroupDetail{}
+	for _, name := range m.groupNames(key) {
+		g := m.groups[key][name]
+		entriesRead := g.entriesRead
+		detail := StreamGroupDetail{
+			Name:            name,
+			LastDeliveredID: g.lastID.String(),
+			EntriesRead:     &entriesRead,
+			Lag:             m.lag(key, g),
+			Pending:         int64(len(g.pending)),
+		}
+		consumers := make(map[string]*StreamConsumerDetail, len(g.consumers))
+		for consumer, seen := range g.consumers {
+			consumers[consumer] = &StreamConsumerDetail{Name: consumer, SeenTime: seen.UnixMilli(), ActiveTime: seen.UnixMilli()}
+		}
+		// Pending counts cover every entry; the lists stop at count.
+		for _, id := range g.pendingIDs() {
+			p := g.pending[id]
+			delivery := StreamPendingDelivery{ID: id, Consumer: p.consumer, DeliveryTime: p.delivered.UnixMilli(), Deliveries: p.deliveries}
+			if int64(len(detail.PendingEntries)) < count {
+				detail.PendingEntries = append(detail.PendingEntries, delivery)
+			}
+			if c, ok := consumers[p.consumer]; ok {
+				c.Pending++
+				if int64(len(c.PendingEntries)) < count {
+					c.PendingEntries = append(c.PendingEntries, delivery)
+				}
+			}
+		}
+		names := make([]string, 0, len(consumers))
+		for consumer := range consumers {
+			names = append(names, consumer)
+		}
+		slices.Sort(names)
+		for _, consumer := range names {
+			detail.Consumers = append(detail.Consumers, *consumers[consumer])
+		}
+		info.GroupDetails = append(info.GroupDetails, detail)
+	}
+	return info, nil
+}
+
+// GetStreamGroups mock implementation
+func (m *MockClient) GetStreamGroups(ctx context.Context, key string) ([]StreamGroupInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if err := m.streamKey("XINFO GROUPS", key); err != nil {
+		return nil, err
+	}
+	groups := []StreamGroupInfo{}
+	for _, name := range m.groupNames(key) {
+		g := m.groups[key][name]
+		entriesRead := g.entriesRead
+		groups = append(groups, StreamGroupInfo{
+			Name:            name,
+			Consumers:       int64(len(g.consumers)),
+			Pending:         int64(len(g.pending)),
+			LastDeliveredID: g.lastID.String(),
+			EntriesRead:     &entriesRead,
+			Lag:             m.lag(key, g),
+		})
+	}
+	return groups, nil
+}
+
+// GetStreamConsumers mock implementation. The mock tracks one activity time
+// per consumer, reported as both idle and inactive time.
+func (m *MockClient) GetStreamConsumers(ctx context.Context, key, group string) ([]StreamConsumerInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	g, err := m.streamGroup("XINFO CONSUMERS", key, group)
+	if err != nil {
+		return nil, err
+	}
+	pending := map[string]int64{}
+	for _, p := range g.pending {
+		pending[p.consumer]++
+	}
+	names := make([]string, 0, len(g.consumers))
+	for consumer := range g.consumers {
+		names = append(names, consumer)
+	}
+	slices.Sort(names)
+
+	now := m.now()
+	consumers := make([]StreamConsumerInfo, 0, len(names))
+	for _, name := range names {
+		idle := now.Sub(g.consumers[name]).Milliseconds()
+		consumers = append(consumers, StreamConsumerInfo{Name: name, Pending: pending[name], IdleMs: idle, InactiveMs: idle})
+	}
+	return consumers, nil
+}
+
+// mockStreamID is a parsed stream entry ID.
+type mockStreamID struct {
+	ms, seq uint64
+}
+
+func (id mockStreamID) less(other mockStreamID) bool {
+	return id.ms < other.ms || id.ms == other.ms && id.seq < other.seq
+}
+
+func (id mockStreamID) String() string {
+	return strconv.FormatUint(id.ms, 10) + "-" + strconv.FormatUint(id.seq, 10)
+}
+
+// parseMockStreamID parses "ms-seq", "ms", "-" or "+". An ID without a
+// sequence number takes seq, which is 0 for a start and MaxUint64 for an end.
+func parseMockStreamID(s string, seq uint64) (mockStreamID, bool) {
+	switch s {
+	case "-":
+		return mockStreamID{}, true
+	case "+":
+		return mockStreamID{math.MaxUint64, math.MaxUint64}, true
+	}
+	msPart, seqPart, hasSeq := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return mockStreamID{}, false
+	}
+	if hasSeq {
+		if seq, err = strconv.ParseUint(seqPart, 10, 64); err != nil {
+			return mockStreamID{}, false
+		}
+	}
+	return mockStreamID{ms, seq}, true
+}
+
+// DumpKey mock implementation
+func (m *MockClient) DumpKey(ctx context.Context, key string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if value, exists := m.strings[key]; exists {
+		result := make([]byte, len(value))
+		copy(result, value)
+		return result, nil
+	}
+	return nil, nil
+}
+
+// RestoreKey mock implementation. Payloads are stored as string values, as
+// DumpKey only serializes strings.
+func (m *MockClient) RestoreKey(ctx context.Context, key string, ttl int64, serialized []byte, opts RestoreOptions) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.keyType(key) != "none" {
+		if !opts.Replace {
+			return false, fmt.Errorf("RESTORE failed: BUSYKEY Target key name already exists")
+		}
+		m.deleteKey(key)
+	}
+	m.strings[key] = serialized
+	delete(m.ttls, key)
+	if ttl > 0 {
+		m.ttls[key] = (ttl + 999) / 1000
+	}
+	return true, nil
+}
+
+// errSingleDatabase is returned by MockClient operations that address
+// another database; the mock holds a single one.
+var errSingleDatabase = fmt.Errorf("mock client has a single database")
+
+// SameSlot mock implementation. The mock is not a cluster.
+func (m *MockClient) SameSlot(a, b string) bool {
+	return true
+}
+
+// CopyKey mock implementation. Only copies within the mock's single database
+// are supported.
+func (m *MockClient) CopyKey(ctx context.Context, source, destination string, db int, replace bool) (bool, error) {
+	if db >= 0 {
+		return false, errSingleDatabase
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.keyType(source) == "none" || source == destination {
+		return false, nil
+	}
+	if m.keyType(destination) != "none" {
+		if !replace {
+			return false, nil
+		}
+		m.deleteKey(destination)
+	}
+	if value, ok := m.strings[source]; ok {
+		m.strings[destination] = slices.Clone(value)
+	}
+	if hash, ok := m.hashes[source]; ok {
+		copied := make(map[string][]byte, len(hash))
+		for field, value := range hash {
+			copied[field] = slices.Clone(value)
+		}
+		m.hashes[destination] = copied
+	}
+	if list, ok := m.lists[source]; ok {
+		m.lists[destination] = slices.Clone(list)
+	}
+	if set, ok := m.sets[source]; ok {
+		copied := make(map[string]bool, len(set))
+		for member := range set {
+			copied[member] = true
+		}
+		m.sets[destination] = copied
+	}
+	if zset, ok := m.zsets[source]; ok {
+		m.zsets[destination] = slices.Clone(zset)
+	}
+	if stream, ok := m.streams[source]; ok {
+		m.streams[destination] = slices.Clone(stream)
+		if top, ok := m.tops[source]; ok {
+			copied := *top
+			m.tops[destination] = &copied
+		}
+	}
+	if ttl, ok := m.ttls[source]; ok {
+		m.ttls[destination] = ttl
+	}
+	return true, nil
+}
+
+// MoveKey mock implementation; the mock holds a single database.
+func (m *MockClient) MoveKey(ctx context.Context, key string, db int) (bool, error) {
+	return false, errSingleDatabase
+}
+
+// ObjectIdletime mock implementation
+func (m *MockClient) ObjectIdletime(ctx context.Context, key string) (int64, error) {
+	return 0, nil
+}
+
+// ConfigGet mock implementation. Parameters stored with ConfigSet are returned
+// as set; any other parameter reads as "mock_value". Glob patterns match
+// stored parameters only.
+func (m *MockClient) ConfigGet(ctx context.Context, parameter string) (map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make(map[string]string)
+	if strings.ContainsAny(parameter, "*?[") {
+		for name, value := range m.configs {
+			if ok, _ := path.Match(parameter, name); ok {
+				result[name] = value
+			}
+		}
+		return result, nil
+	}
+	if value, exists := m.configs[parameter]; exists {
+		result[parameter] = value
+	} else {
+		result[parameter] = "mock_value"
+	}
+	return result, nil
+}
+
+// ConfigSet mock implementation
+func (m *MockClient) ConfigSet(ctx context.Context, parameter, value string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.configs[parameter] = value
+	return true, nil
+}
+
+// GetDatabaseSize mock implementation
+func (m *MockClient) GetDatabaseSize(ctx context.Context) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return int64(len(m.strings)), nil
+}
+
+// NodeDatabaseSize mock implementation
+func (m *MockClient) NodeDatabaseSize(ctx context.Context, node string) (int64, error) {
+	if node != "" {
+		return 0, fmt.Errorf("node %s is no longer part of the cluster", node)
+	}
+	return m.GetDatabaseSize(ctx)
+}
+
+// GetSlowlog mock implementation
+func (m *MockClient) GetSlowlog(ctx context.Context, count int64) ([]map[string]interface{}, error) {
+	return []map[string]interface{}{}, nil
+}
+
+// GetClusterInfo mock implementation
+func (m *MockClient) GetClusterInfo(ctx context.Context) (map[string]string, error) {
+	result := make(map[string]string)
+	result["cluster_state"] = "ok"
+	return result, nil
+}
+
+// GetClusterNodes mock implementation
+func (m *MockClient) GetClusterNodes(ctx context.Context) (string, error) {
+	return "mock_cluster_nodes", nil
+}
+
+// GetKeySlot mock implementation
+func (m *MockClient) GetKeySlot(ctx context.Context, key string) (int64, error) {
+	return 0, nil
+}
+
+// CountKeysInSlot mock implementation
+func (m *MockClient) CountKeysInSlot(ctx context.Context, slot int64) (int64, error) {
+	return 0, nil
+}
+
+// EvalScript mock implementation
+func (m *MockClient) EvalScript(ctx context.Context, script string, keys []string, args []string) (interface{}, error) {
+	return "OK", nil
+}
+
+// LoadScript mock implementation
+func (m *MockClient) LoadScript(ctx context.Context, script string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.scripts["abc123def456"] = true
+	return "abc123def456", nil
+}
+
+// EvalSHA mock implementation
+func (m *MockClient) EvalSHA(ctx context.Context, sha string, keys []string, args []string) (interface{}, error) {
+	return "OK", nil
+}
+
+// ScanKeys mock implementation. The cursor is an offset into the sorted key list.
+func (m *MockClient) ScanKeys(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if pattern == "" {
+		pattern = "*"
+	}
+	if count <= 0 {
+		count = 10
+	}
+
+	all := m.allKeys()
+	keys := make([]string, 0, count)
+	i := cursor
+	for ; i < uint64(len(all)) && int64(len(keys)) < count; i++ {
+		if ok, _ := path.Match(pattern, all[i]); ok {
+			keys = append(keys, all[i])
+		}
+	}
+	if i >= uint64(len(all)) {
+		i = 0
+	}
+	return keys, i, nil
+}
+
+// ScanNodes mock implementation; the mock is a single standalone node.
+func (m *MockClient) ScanNodes(ctx context.Context) ([]string, error) {
+	return []string{""}, nil
+}
+
+// ScanNodeKeys mock implementation
+func (m *MockClient) ScanNodeKeys(ctx context.Context, node string, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	if node != "" {
+		return nil, 0, fmt.Errorf("node %s is no longer part of the cluster", node)
+	}
+	return m.ScanKeys(ctx, cursor, pattern, count)
+}
+
+// UnlinkKeys mock implementation
+func (m *MockClient) UnlinkKeys(ctx context.Context, keys []string) (int64, error) {
+	var removed int64
+	for _, key := range keys {
+		if deleted, _ := m.DeleteKey(ctx, key); deleted {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// ExpireKeys mock implementation
+func (m *MockClient) ExpireKeys(ctx context.Context, keys []string, seconds int64) (int64, error) {
+	var updated int64
+	for _, key := range keys {
+		if ok, _ := m.ExpireKey(ctx, key, seconds); ok {
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// InspectKeys mock implementation
+func (m *MockClient) InspectKeys(ctx context.Context, keys []string) ([]KeyInfo, error) {
+	return inspectKeys(ctx, m, keys)
+}
+
+// KeyLengths mock implementation
+func (m *MockClient) KeyLengths(ctx context.Context, keys, types []string) ([]int64, error) {
+	lengths := make([]int64, len(keys))
+	for i, key := range keys {
+		var err error
+		switch types[i] {
+		case "string":
+			var value []byte
+			value, _, err = m.GetString(ctx, key)
+			lengths[i] = int64(len(value))
+		case "hash":
+			lengths[i], err = m.GetMapLength(ctx, key)
+		case "list":
+			lengths[i], err = m.GetListLength(ctx, key)
+		case "set":
+			lengths[i], err = m.GetSetSize(ctx, key)
+		case "zset":
+			m.mu.RLock()
+			lengths[i] = int64(len(m.zsets[key]))
+			m.mu.RUnlock()
+		case "stream":
+			lengths[i], err = m.GetStreamLength(ctx, key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return lengths, nil
+}
+
+// ObjectFreqs mock implementation; frequencies are set with SetObjectFreq.
+func (m *MockClient) ObjectFreqs(ctx context.Context, keys []string) ([]int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	freqs := make([]int64, len(keys))
+	for i, key := range keys {
+		if m.keyType(key) == "none" {
+			freqs[i] = -1
+		} else {
+			freqs[i] = m.freqs[key]
+		}
+	}
+	return freqs, nil
+}
+
+// ObjectIdletimes mock implementation
+func (m *MockClient) ObjectIdletimes(ctx context.Context, keys []string) ([]int64, error) {
+	idle := make([]int64, len(keys))
+	for i, key := range keys {
+		idle[i], _ = m.ObjectIdletime(ctx, key)
+	}
+	return idle, nil
+}
+
+// inspectKeys builds InspectKeys results from the single-key calls of c.
+func inspectKeys(ctx context.Context, c ValkeyClient, keys []string) ([]KeyInfo, error) {
+	infos := make([]KeyInfo, 0, len(keys))
+	for _, key := range keys {
+		info := KeyInfo{Key: key}
+		var err error
+		if info.Type, err = c.GetKeyType(ctx, key); err != nil {
+			return nil, err
+		}
+		if info.Type != "none" {
+			if info.Encoding, err = c.ObjectEncoding(ctx, key); err != nil {
+				return nil, err
+			}
+			if info.MemoryBytes, err = c.MemoryUsage(ctx, key); err != nil {
+				return nil, err
+			}
+			if info.PTTL, err = c.GetPTTL(ctx, key); err != nil {
+				return nil, err
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// GetKeyType mock implementation
+func (m *MockClient) GetKeyType(ctx context.Context, key string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.keyType(key), nil
+}
+
+// GetSortedSetRange mock implementation
+func (m *MockClient) GetSortedSetRange(ctx context.Context, key string, start, stop int64) ([]SortedSetMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	members, exists := m.zsets[key]
+	if !exists {
+		return []SortedSetMember{}, nil
+	}
+
+	length := int64(len(members))
+	if start < 0 {
+		start = length + start
+	}
+	if stop < 0 {
+		stop = length + stop
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+	if start > stop {
+		return []SortedSetMember{}, nil
+	}
+
+	result := make([]SortedSetMember, stop-start+1)
+	copy(result, members[start:stop+1])
+	return result, nil
+}
+
+// AddSortedSet mock implementation. Members are kept sorted by score, then
+// by member.
+func (m *MockClient) AddSortedSet(ctx context.Context, key string, members []SortedSetMember) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if t := m.keyType(key); t != "none" && t != "zset" {
+		return 0, fmt.Errorf("ZADD failed: WRONGTYPE Operation against a key holding the wrong kind of value")
+	}
+	existing := m.zsets[key]
+	var added int64
+	for _, member := range members {
+		i := slices.IndexFunc(existing, func(e SortedSetMember) bool { return bytes.Equal(e.Member, member.Member) })
+		if i >= 0 {
+			existing[i].Score = member.Score
+			continue
+		}
+		existing = append(existing, SortedSetMember{Member: bytes.Clone(member.Member), Score: member.Score})
+		added++
+	}
+	sortMockZset(existing)
+	m.zsets[key] = existing
+	return added, nil
+}
+
+// sortMockZset orders members by score, then by member, as sorted sets are.
+func sortMockZset(members []SortedSetMember) {
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return bytes.Compare(members[i].Member, members[j].Member) < 0
+	})
+}
+
+// Geo sets are sorted sets scored by the 52-bit interleaved geohash of each
+// position, as on the server, so positions read back are the centre of the
+// geohash cell rather than the exact values added.
+
+const (
+	mockGeoLatLimit    = 85.05112878
+	mockGeoStep        = 26
+	mockEarthRadiusM   = 6372797.560856
+	mockGeoHashLetters = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+// mockGeoUnits are the meters in each distance unit.
+var mockGeoUnits = map[string]float64{"m": 1, "km": 1000, "ft": 0.3048, "mi": 1609.34}
+
+// mockGeoEncode returns the geohash of a position with latitudes ranging
+// over ±latLimit.
+func mockGeoEncode(p GeoPosition, latLimit float64) uint64 {
+	lat := uint64((p.Latitude + latLimit) / (2 * latLimit) * (1 << mockGeoStep))
+	lon := uint64((p.Longitude + 180) / 360 * (1 << mockGeoStep))
+	var hash uint64
+	for i := mockGeoStep - 1; i >= 0; i-- {
+		hash = hash<<2 | (lon>>i&1)<<1 | lat>>i&1
+	}
+	return hash
+}
+
+// mockGeoDecode returns the centre of the geohash cell of score.
+func mockGeoDecode(score float64) GeoPosition {
+	hash := uint64(score)
+	var lat, lon uint64
+	for i := mockGeoStep - 1; i >= 0; i-- {
+		lon |= (hash >> (2*i + 1) & 1) << i
+		lat |= (hash >> (2 * i) & 1) << i
+	}
+	cell := func(v uint64, low, span float64) float64 {
+		return low + (float64(v)+0.5)/(1<<mockGeoStep)*span
+	}
+	return GeoPosition{
+		Longitude: max(-180, min(180, cell(lon, -180, 360))),
+		Latitude:  max(-mockGeoLatLimit, min(mockGeoLatLimit, cell(lat, -mockGeoLatLimit, 2*mockGeoLatLimit))),
+	}
+}
+
+// mockGeoDistance returns the haversine distance between a and b in meters.
+func mockGeoDistance(a, b GeoPosition) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	u := math.Sin((lat2 - lat1) / 2)
+	v := math.Sin((b.Longitude - a.Longitude) * math.Pi / 180 / 2)
+	return 2 * mockEarthRadiusM * math.Asin(math.Sqrt(u*u+math.Cos(lat1)*math.Cos(lat2)*v*v))
+}
+
+// geoMember returns the position of member of the geo set at key. The caller
+// must hold m.mu.
+func (m *MockClient) geoMember(key, member string) (GeoPosition, bool) {
+	for _, e := range m.zsets[key] {
+		if string(e.Member) == member {
+			return mockGeoDecode(e.Score), true
+		}
+	}
+	return GeoPosition{}, false
+}
+
+// geoSet returns the geo set at key, failing when key holds another type.
+// The caller must hold m.mu.
+func (m *MockClient) geoSet(cmd, key string) ([]SortedSetMember, error) {
+	if t := m.keyType(key); t != "none" && t != "zset" {
+		return nil, fmt.Errorf("%s failed: WRONGTYPE Operation against a key holding the wrong kind of value", cmd)
+	}
+	return m.zsets[key], nil
+}
+
+// GeoAdd mock implementation
+func (m *MockClient) GeoAdd(ctx context.Context, key string, locations []GeoLocation, opts GeoAddOptions) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if opts.NX && opts.XX {
+		return 0, fmt.Errorf("GEOADD failed: ERR XX and NX options at the same time are not compatible")
+	}
+	existing, err := m.geoSet("GEOADD", key)
+	if err != nil {
+		return 0, err
+	}
+	for _, l := range locations {
+		if l.Longitude < -180 || l.Longitude > 180 || l.Latitude < -mockGeoLatLimit || l.Latitude > mockGeoLatLimit {
+			return 0, fmt.Errorf("GEOADD failed: ERR invalid longitude,latitude pair %f,%f", l.Longitude, l.Latitude)
+		}
+	}
+	existing = slices.Clone(existing)
+	var added, changed int64
+	for _, l := range locations {
+		score := float64(mockGeoEncode(l.GeoPosition, mockGeoLatLimit))
+		i := slices.IndexFunc(existing, func(e SortedSetMember) bool { return string(e.Member) == l.Member })
+		switch {
+		case i >= 0 && !opts.NX:
+			if existing[i].Score != score {
+				existing[i].Score = score
+				changed++
+			}
+		case i < 0 && !opts.XX:
+			existing = append(existing, SortedSetMember{Member: []byte(l.Member), Score: score})
+			added++
+		}
+	}
+	if len(existing) > 0 {
+		sortMockZset(existing)
+		m.zsets[key] = existing
+	}
+	if opts.CH {
+		return added + changed, nil
+	}
+	return added, nil
+}
+
+// GeoPos mock implementation
+func (m *MockClient) GeoPos(ctx context.Context, key string, members []string) ([]*GeoPosition, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, err := m.geoSet("GEOPOS", key); err != nil {
+		return nil, err
+	}
+	positions := make([]*GeoPosition, len(members))
+	for i, member := range members {
+		if p, ok := m.geoMember(key, member); ok {
+			positions[i] = &p
+		}
+	}
+	return positions, nil
+}
+
+// GeoDist mock implementation
+func (m *MockClient) GeoDist(ctx context.Context, key, member1, member2, unit string) (*float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, err := m.geoSet("GEODIST", key); err != nil {
+		return nil, err
+	}
+	meters, ok := mockGeoUnits[strings.ToLower(unit)]
+	if !ok {
+		return nil, fmt.Errorf("GEODIST failed: ERR unsupported unit provided. please use M, KM, FT, MI")
+	}
+	a, ok1 := m.geoMember(key, member1)
+	b, ok2 := m.geoMember(key, member2)
+	if !ok1 || !ok2 {
+		return nil, nil
+	}
+	distance := math.Round(mockGeoDistance(a, b)/meters*1e4) / 1e4
+	return &distance, nil
+}
+
+// GeoHash mock implementation. Like GEOHASH it re-encodes positions with
+// the standard ±90 latitude range and pads the 52 bits to 11 characters.
+func (m *MockClient) GeoHash(ctx context.Context, key string, members []string) ([]*string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, err := m.geoSet("GEOHASH", key); err != nil {
+		return nil, err
+	}
+	hashes := make([]*string, len(members))
+	for i, member := range members {
+		p, ok := m.geoMember(key, member)
+		if !ok {
+			continue
+		}
+		bits := mockGeoEncode(p, 90)
+		buf := make([]byte, 11)
+		for j := range 10 {
+			buf[j] = mockGeoHashLetters[bits>>(52-(j+1)*5)&0x1f]
+		}
+		buf[10] = '0'
+		hash := string(buf)
+		hashes[i] = &hash
+	}
+	return hashes, nil
+}
+
+// geoSearch returns the members of the geo set at key that q matches, with
+// their scores and distances in q's unit. The caller must hold m.mu.
+func (m *MockClient) geoSearch(cmd, key string, q GeoSearchQuery) ([]SortedSetMember, []float64, error) {
+	members, err := m.geoSet(cmd, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	meters, ok := mockGeoUnits[strings.ToLower(q.Unit)]
+	if !ok {
+		return nil, nil, fmt.Errorf("%s failed: ERR unsupported unit provided. please use M, KM, FT, MI", cmd)
+	}
+	var center GeoPosition
+	switch {
+	case q.FromMember != "":
+		if center, ok = m.geoMember(key, q.FromMember); !ok {
+			return nil, nil, fmt.Errorf("%s failed: ERR could not decode requested zset member", cmd)
+		}
+	case q.FromLonLat != nil:
+		center = *q.FromLonLat
+	default:
+		return nil, nil, fmt.Errorf("%s failed: ERR exactly one of FROMMEMBER or FROMLONLAT can be specified", cmd)
+	}
+
+	var matched []SortedSetMember
+	var distances []float64
+	for _, e := range members {
+		p := mockGeoDecode(e.Score)
+		distance := mockGeoDistance(center, p)
+		if q.Radius > 0 {
+			if distance > q.Radius*meters {
+				continue
+			}
+		} else {
+			height := mockEarthRadiusM * math.Abs(p.Latitude-center.Latitude) * math.Pi / 180
+			width := mockGeoDistance(GeoPosition{Longitude: center.Longitude, Latitude: p.Latitude}, p)
+			if height > q.Height*meters/2 || width > q.Width*meters/2 {
+				continue
+			}
+		}
+		matched = append(matched, e)
+		distances = append(distances, distance/meters)
+		if q.Any && int64(len(matched)) == q.Count {
+			break
+		}
+	}
+
+	order := q.Order
+	if order == "" && q.Count > 0 && !q.Any {
+		order = "ASC"
+	}
+	if order != "" {
+		index := make([]int, len(matched))
+		for i := range index {
+			index[i] = i
+		}
+		sort.SliceStable(index, func(i, j int) bool {
+			if order == "DESC" {
+				return distances[index[i]] > distances[index[j]]
+			}
+			return distances[index[i]] < distances[index[j]]
+		})
+		sortedMatched, sortedDistances := make([]SortedSetMember, len(index)), make([]float64, len(index))
+		for i, j := range index {
+			sortedMatched[i], sortedDistances[i] = matched[j], distances[j]
+		}
+		matched, distances = sortedMatched, sortedDistances
+	}
+	if q.Count > 0 && int64(len(matched)) > q.Count {
+		matched, distances = matched[:q.Count], distances[:q.Count]
+	}
+	return matched, distances, nil
+}
+
+// GeoSearch mock implementation
+func (m *MockClient) GeoSearch(ctx context.Context, key string, q GeoSearchQuery) ([]GeoMatch, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	members, distances, err := m.geoSearch("GEOSEARCH", key, q)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]GeoMatch, len(members))
+	for i, e := range members {
+		matches[i].Member = string(e.Member)
+		if q.WithDist {
+			distance := math.Round(distances[i]*1e4) / 1e4
+			matches[i].Distance = &distance
+		}
+		if q.WithHash {
+			hash := int64(e.Score)
+			matches[i].Hash = &hash
+		}
+		if q.WithCoord {
+			p := mockGeoDecode(e.Score)
+			matches[i].Position = &p
+		}
+	}
+	return matches, nil
+}
+
+// GeoSearchStore mock implementation
+func (m *MockClient) GeoSearchStore(ctx context.Context, destination, key string, q GeoSearchQuery, storeDist bool) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	members, distances, err := m.geoSearch("GEOSEARCHSTORE", key, q)
+	if err != nil {
+		return 0, err
+	}
+	m.deleteKey(destination)
+	if len(members) == 0 {
+		return 0, nil
+	}
+	stored := make([]SortedSetMember, len(members))
+	for i, e := range members {
+		stored[i] = SortedSetMember{Member: bytes.Clone(e.Member), Score: e.Score}
+		if storeDist {
+			stored[i].Score = distances[i]
+		}
+	}
+	sortMockZset(stored)
+	m.zsets[destination] = stored
+	return int64(len(stored)), nil
+}
+
+// Publish mock implementation. Every channel subscription and matching
+// pattern counts as a receiver, as with PUBLISH.
+func (m *MockClient) Publish(ctx context.Context, channel, message string, sharded bool) (int64, error) {
+	var deliveries []func()
+	m.mu.RLock()
+	for _, sub := range m.subscribers {
+		onMessage := sub.onMessage
+		if sharded {
+			if slices.Contains(sub.shardChannels, channel) {
+				deliveries = append(deliveries, func() { onMessage(PubSubMessage{Channel: channel, Message: []byte(message)}) })
+			}
+			continue
+		}
+		if slices.Contains(sub.channels, channel) {
+			deliveries = append(deliveries, func() { onMessage(PubSubMessage{Channel: channel, Message: []byte(message)}) })
+		}
+		for _, pattern := range sub.patterns {
+			if ok, _ := path.Match(pattern, channel); ok {
+				deliveries = append(deliveries, func() {
+					onMessage(PubSubMessage{Channel: channel, Pattern: pattern, Message: []byte(message)})
+				})
+			}
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, deliver := range deliveries {
+		deliver()
+	}
+	return int64(len(deliveries)), nil
+}
+
+// PubSubChannels mock implementation
+func (m *MockClient) PubSubChannels(ctx context.Context, pattern string, sharded bool) ([]string, error) {
+	counts, _ := m.PubSubNumSub(ctx, nil, sharded)
+	channels := make([]string, 0, len(counts))
+	for channel := range counts {
+		if ok, _ := path.Match(pattern, channel); ok || pattern == "" {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Strings(channels)
+	return channels, nil
+}
+
+// PubSubNumSub mock implementation. With no channels it counts the
+// subscribers of every subscribed channel.
+func (m *MockClient) PubSubNumSub(ctx context.Context, channels []string, sharded bool) (map[string]int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := make(map[string]int64, len(channels))
+	for _, channel := range channels {
+		counts[channel] = 0
+	}
+	for _, sub := range m.subscribers {
+		subscribed := sub.channels
+		if sharded {
+			subscribed = sub.shardChannels
+		}
+		for _, channel := range subscribed {
+			if _, ok := counts[channel]; ok || len(channels) == 0 {
+				counts[channel]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// PubSubNumPat mock implementation
+func (m *MockClient) PubSubNumPat(ctx context.Context) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	patterns := make(map[string]bool)
+	for _, sub := range m.subscribers {
+		for _, pattern := range sub.patterns {
+			patterns[pattern] = true
+		}
+	}
+	return int64(len(patterns)), nil
+}
+
+// Subscribe mock implementation. Messages are delivered by Publish until
+// ctx is cancelled.
+func (m *MockClient) Subscribe(ctx context.Context, channels, patterns, shardChannels []string, onMessage func(PubSubMessage)) error {
+	if len(channels) == 0 && len(patterns) == 0 && len(shardChannels) == 0 {
+		return fmt.Errorf("no channels or patterns to subscribe to")
+	}
+
+	m.mu.Lock()
+	id := m.nextID
+	m.nextID++
+	m.subscribers[id] = mockSubscriber{channels: channels, patterns: patterns, shardChannels: shardChannels, onMessage: onMessage}
+	m.mu.Unlock()
+
+	<-ctx.Done()
+
+	m.mu.Lock()
+	delete(m.subscribers, id)
+	m.mu.Unlock()
+	return nil
+}
+
+// block stands in for a server-side blocking command: it polls try until
+// try reports a result, timeout passes or ctx ends.
+func (m *MockClient) block(ctx context.Context, cmd string, timeout time.Duration, try func() bool) error {
+	if timeout <= 0 {
+		return fmt.Errorf("%s failed: timeout must be positive", cmd)
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	poll := time.NewTicker(time.Millisecond)
+	defer poll.Stop()
+	for !try() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return nil
+		case <-poll.C:
+		}
+	}
+	return nil
+}
+
+// BlockingReadStream mock implementation. "$" waits for entries added after
+// the call.
+func (m *MockClient) BlockingReadStream(ctx context.Context, key, id string, count int64, timeout time.Duration) ([]StreamEntry, error) {
+	if id == "$" {
+		m.mu.RLock()
+		id = m.streamTop(key).lastID.String()
+		m.mu.RUnlock()
+	}
+	var entries []StreamEntry
+	var err error
+	if blockErr := m.block(ctx, "XREAD", timeout, func() bool {
+		entries, err = m.ReadStream(ctx, key, id, count)
+		return err != nil || len(entries) > 0
+	}); blockErr != nil {
+		return nil, blockErr
+	}
+	return entries, err
+}
+
+// popListEnd removes the element at the left or right end of the list at
+// key, deleting the list once empty. The caller must hold m.mu.
+func (m *MockClient) popListEnd(key string, left bool) []byte {
+	list := m.lists[key]
+	var value []byte
+	if left {
+		value, list = list[0], list[1:]
+	} else {
+		value, list = list[len(list)-1], list[:len(list)-1]
+	}
+	if len(list) == 0 {
+		delete(m.lists, key)
+	} else {
+		m.lists[key] = list
+	}
+	return value
+}
+
+// BlockingPopList mock implementation
+func (m *MockClient) BlockingPopList(ctx context.Context, keys []string, timeout time.Duration) (*ListPop, error) {
+	var pop *ListPop
+	var err error
+	if blockErr := m.block(ctx, "BLPOP", timeout, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, key := range keys {
+			switch m.keyType(key) {
+			case "list":
+				if len(m.lists[key]) > 0 {
+					pop = &ListPop{Key: key, Value: m.popListEnd(key, true)}
+					return true
+				}
+			case "none":
+			default:
+				err = fmt.Errorf("BLPOP failed: WRONGTYPE Operation against a key holding the wrong kind of value")
+				return true
+			}
+		}
+		return false
+	}); blockErr != nil {
+		return nil, blockErr
+	}
+	return pop, err
+}
+
+// BlockingMoveList mock implementation
+func (m *MockClient) BlockingMoveList(ctx context.Context, source, destination, from, to string, timeout time.Duration) ([]byte, bool, error) {
+	for _, end := range []string{from, to} {
+		if end != "LEFT" && end != "RIGHT" {
+			return nil, false, fmt.Errorf("BLMOVE failed: ERR syntax error")
+		}
+	}
+	var value []byte
+	var moved bool
+	var err error
+	if blockErr := m.block(ctx, "BLMOVE", timeout, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, key := range []string{source, destination} {
+			if t := m.keyType(key); t != "none" && t != "list" {
+				err = fmt.Errorf("BLMOVE failed: WRONGTYPE Operation against a key holding the wrong kind of value")
+				return true
+			}
+		}
+		if len(m.lists[source]) == 0 {
+			return false
+		}
+		value = m.popListEnd(source, from == "LEFT")
+		if to == "LEFT" {
+			m.lists[destination] = append([][]byte{value}, m.lists[destination]...)
+		} else {
+			m.lists[destination] = append(m.lists[destination], value)
+		}
+		moved = true
+		return true
+	}); blockErr != nil {
+		return nil, false, blockErr
+	}
+	return value, moved, err
+}
+
+// BlockingPopMinSortedSet mock implementation
+func (m *MockClient) BlockingPopMinSortedSet(ctx context.Context, keys []string, timeout time.Duration) (*SortedSetPop, error) {
+	var pop *SortedSetPop
+	var err error
+	if blockErr := m.block(ctx, "BZPOPMIN", timeout, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, key := range keys {
+			switch m.keyType(key) {
+			case "zset":
+				members := m.zsets[key]
+				if len(members) == 0 {
+					continue
+				}
+				pop = &SortedSetPop{Key: key, Member: members[0].Member, Score: members[0].Score}
+				if len(members) == 1 {
+					delete(m.zsets, key)
+				} else {
+					m.zsets[key] = members[1:]
+				}
+				return true
+			case "none":
+			default:
+				err = fmt.Errorf("BZPOPMIN failed: WRONGTYPE Operation against a key holding the wrong kind of value")
+				return true
+			}
+		}
+		return false
+	}); blockErr != nil {
+		return nil, blockErr
+	}
+	return pop, err
+}
+
+// WatchKeyspace mock implementation. Events are delivered by EmitKeyspaceEvent
+// until ctx is cancelled.
+func (m *MockClient) WatchKeyspace(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error {
+	if keyPattern == "" {
+		keyPattern = "*"
+	}
+
+	m.mu.Lock()
+	id := m.nextID
+	m.nextID++
+	m.watchers[id] = keyspaceWatcher{pattern: keyPattern, onEvent: onEvent}
+	m.mu.Unlock()
+
+	<-ctx.Done()
+
+	m.mu.Lock()
+	delete(m.watchers, id)
+	m.mu.Unlock()
+	return nil
+}
+
+// WatchKeyevents mock implementation. EmitKeyspaceEvent feeds it like
+// WatchKeyspace, whatever notify-keyspace-events holds.
+func (m *MockClient) WatchKeyevents(ctx context.Context, keyPattern string, onEvent func(KeyspaceEvent)) error {
+	return m.WatchKeyspace(ctx, keyPattern, onEvent)
+}
+
+// ListLoadedScripts mock implementation
+func (m *MockClient) ListLoadedScripts(ctx context.Context) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	shas := make([]string, 0, len(m.scripts))
+	for sha := range m.scripts {
+		shas = append(shas, sha)
+	}
+	sort.Strings(shas)
+	return shas, nil
 }
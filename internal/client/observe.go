@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/logging"
+)
+
+// DefaultSlowCommandThreshold is the duration above which commands are
+// logged as slow when Config.SlowCommandThreshold is zero.
+const DefaultSlowCommandThreshold = 100 * time.Millisecond
+
+// observedClient wraps a valkey.Client to log slow commands, connection
+// errors and reconnects.
+type observedClient struct {
+	valkey.Client
+	observer *observer
+}
+
+func (c *observedClient) Do(ctx context.Context, cmd valkey.Completed) valkey.ValkeyResult {
+	start := time.Now()
+	name := commandName(cmd.Commands())
+	result := c.Client.Do(ctx, cmd)
+	c.observer.record(ctx, name, time.Since(start), result.Error())
+	return result
+}
+
+func (c *observedClient) DoMulti(ctx context.Context, multi ...valkey.Completed) []valkey.ValkeyResult {
+	start := time.Now()
+	name := "MULTI"
+	if len(multi) > 0 {
+		name = commandName(multi[0].Commands())
+	}
+	results := c.Client.DoMulti(ctx, multi...)
+	var err error
+	for _, r := range results {
+		if err = r.Error(); err != nil {
+			break
+		}
+	}
+	c.observer.record(ctx, name, time.Since(start), err)
+	return results
+}
+
+// commandName returns the command name without its arguments, which may
+// hold user data.
+func commandName(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// observer tracks connection health across commands.
+type observer struct {
+	slowThreshold time.Duration
+
+	mu           sync.Mutex
+	disconnected bool
+}
+
+// record logs the outcome of one command.
+func (o *observer) record(ctx context.Context, command string, elapsed time.Duration, err error) {
+	logger := logging.FromContext(ctx)
+
+	if elapsed >= o.slowThreshold {
+		logger.WarnContext(ctx, "slow command", "command", command, "duration", elapsed, "threshold", o.slowThreshold)
+	}
+
+	connErr := isConnectionError(err)
+	o.mu.Lock()
+	wasDisconnected := o.disconnected
+	o.disconnected = connErr
+	o.mu.Unlock()
+
+	// Connection state changes concern every client, not only the request
+	// that noticed them.
+	broadcast := context.Background()
+	switch {
+	case connErr && !wasDisconnected:
+		logger.ErrorContext(broadcast, "valkey connection lost", "command", command, "error", err)
+	case connErr:
+		logger.DebugContext(ctx, "valkey still unreachable", "command", command, "error", err)
+	case wasDisconnected:
+		logger.InfoContext(broadcast, "valkey connection restored", "command", command)
+	}
+}
+
+// isConnectionError reports whether err is a transport failure rather than a
+// reply from the server or a cancelled request.
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var valkeyErr *valkey.ValkeyError
+	if errors.As(err, &valkeyErr) {
+		return false
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
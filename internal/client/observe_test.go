@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-go"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/logging"
+)
+
+func TestObserver_LogsSlowCommands(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := logging.WithLogger(context.Background(), slog.New(slog.NewTextHandler(&buf, nil)))
+	o := &observer{slowThreshold: 100 * time.Millisecond}
+
+	o.record(ctx, "GET", 10*time.Millisecond, nil)
+	assert.Empty(t, buf.String())
+
+	o.record(ctx, "KEYS", 250*time.Millisecond, nil)
+	assert.Contains(t, buf.String(), "slow command")
+	assert.Contains(t, buf.String(), "command=KEYS")
+}
+
+func TestObserver_LogsConnectionLossAndRestore(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := logging.WithLogger(context.Background(), slog.New(slog.NewTextHandler(&buf, nil)))
+	o := &observer{slowThreshold: time.Second}
+
+	o.record(ctx, "GET", 0, errors.New("dial tcp: connection refused"))
+	o.record(ctx, "GET", 0, errors.New("dial tcp: connection refused"))
+	o.record(ctx, "GET", 0, nil)
+
+	out := buf.String()
+	assert.Equal(t, 1, bytes.Count([]byte(out), []byte("valkey connection lost")))
+	assert.Contains(t, out, "valkey connection restored")
+}
+
+func TestIsConnectionError(t *testing.T) {
+	assert.False(t, isConnectionError(nil))
+	assert.False(t, isConnectionError(valkey.Nil))
+	assert.False(t, isConnectionError(context.Canceled))
+	assert.True(t, isConnectionError(errors.New("EOF")))
+}
@@ -0,0 +1,46 @@
+package client
+
+import "github.com/valkey-io/valkey-go"
+
+// SameSlot reports whether a multi-key command may name keys a and b. Outside
+// a cluster it always may; in a cluster the keys must hash to the same slot,
+// as the cluster client refuses to build the command otherwise.
+func (c *Client) SameSlot(a, b string) bool {
+	return c.client.Mode() != valkey.ClientModeCluster || keySlot(a) == keySlot(b)
+}
+
+// keySlot returns the cluster hash slot of key, hashing only the part inside
+// the first non-empty {hash tag}.
+func keySlot(key string) uint16 {
+	for s := 0; s < len(key); s++ {
+		if key[s] != '{' {
+			continue
+		}
+		for e := s + 1; e < len(key); e++ {
+			if key[e] == '}' {
+				if e > s+1 {
+					key = key[s+1 : e]
+				}
+				break
+			}
+		}
+		break
+	}
+	return crc16(key) & 16383
+}
+
+// crc16 is the CRC16-CCITT (XMODEM) checksum used for hash slots.
+func crc16(s string) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeySlot(t *testing.T) {
+	assert.Equal(t, uint16(12739), keySlot("123456789"))
+	assert.Equal(t, uint16(12182), keySlot("foo"))
+	assert.Equal(t, keySlot("user1000"), keySlot("{user1000}.following"))
+	assert.Equal(t, keySlot("{user1000}.following"), keySlot("{user1000}.followers"))
+	assert.Equal(t, keySlot("{}foo"), keySlot("{}foo"))
+	assert.NotEqual(t, keySlot("foo"), keySlot("{}foo"))
+	assert.Equal(t, keySlot("bar"), keySlot("foo{bar}{zap}"))
+}
@@ -7,3 +7,303 @@ type StreamEntry struct {
 	ID          string
 	FieldValues map[string][]byte
 }
+
+// SortedSetMember represents a sorted set member with its score.
+// Member holds raw bytes so binary members survive JSON conversion via base.SafeValue.
+type SortedSetMember struct {
+	Member []byte
+	Score  float64
+}
+
+// KeyspaceEvent is a single keyspace notification: Event is the command-derived
+// event name (for example "set", "del" or "expired") that touched Key in DB.
+type KeyspaceEvent struct {
+	DB    int
+	Key   string
+	Event string
+}
+
+// RestoreOptions are the optional arguments of RESTORE.
+type RestoreOptions struct {
+	// Replace overwrites an existing key instead of failing.
+	Replace bool
+	// AbsTTL makes ttl an absolute Unix time in milliseconds.
+	AbsTTL bool
+	// IdleTime sets the idle time in seconds reported by OBJECT IDLETIME,
+	// and Freq the access frequency reported by OBJECT FREQ. Nil leaves
+	// them unset; at most one may be set.
+	IdleTime *int64
+	Freq     *int64
+}
+
+// KeyInfo is what InspectKeys reports about a key.
+type KeyInfo struct {
+	Key         string
+	Type        string
+	Encoding    string
+	MemoryBytes int64
+	// PTTL is the remaining time to live in milliseconds, or -1 for none.
+	PTTL int64
+}
+
+// StreamPendingSummary is the summary form of XPENDING: the number of pending
+// entries of a group, their lowest and highest IDs and the count per consumer.
+type StreamPendingSummary struct {
+	Count     int64
+	Lowest    string
+	Highest   string
+	Consumers map[string]int64
+}
+
+// StreamPendingOptions selects entries for the extended form of XPENDING.
+type StreamPendingOptions struct {
+	// Start and End bound the IDs; empty means "-" and "+".
+	Start string
+	End   string
+	Count int64
+	// Consumer restricts the entries to one consumer when set.
+	Consumer string
+	// MinIdle keeps entries idle for at least this many milliseconds.
+	MinIdle int64
+}
+
+// StreamPendingEntry is one entry of the extended form of XPENDING.
+type StreamPendingEntry struct {
+	ID         string
+	Consumer   string
+	IdleMs     int64
+	Deliveries int64
+}
+
+// StreamClaimOptions are the optional arguments of XCLAIM. Nil values are
+// left unset.
+type StreamClaimOptions struct {
+	// Idle sets the idle time of the claimed entries in milliseconds.
+	Idle *int64
+	// Time sets the idle time as an absolute Unix time in milliseconds.
+	Time *int64
+	// RetryCount sets the delivery counter.
+	RetryCount *int64
+	// Force creates pending entries for IDs not pending in the group.
+	Force bool
+}
+
+// StreamAutoClaim is the result of XAUTOCLAIM.
+type StreamAutoClaim struct {
+	// NextStart is the cursor for the next call; "0-0" when the scan is done.
+	NextStart string
+	Entries   []StreamEntry
+	// Deleted lists pending IDs no longer in the stream, removed from the
+	// pending list.
+	Deleted []string
+}
+
+// StreamInfo is the reply of XINFO STREAM.
+type StreamInfo struct {
+	Length               int64
+	RadixTreeKeys        int64
+	RadixTreeNodes       int64
+	LastGeneratedID      string
+	MaxDeletedEntryID    string
+	EntriesAdded         int64
+	RecordedFirstEntryID string
+	// Groups is the number of consumer groups.
+	Groups int64
+	// FirstEntry and LastEntry are nil for an empty stream and in the FULL
+	// form.
+	FirstEntry *StreamEntry
+	LastEntry  *StreamEntry
+	// Entries and GroupDetails are only set by the FULL form.
+	Entries      []StreamEntry
+	GroupDetails []StreamGroupDetail
+}
+
+// StreamGroupInfo is a consumer group as listed by XINFO GROUPS.
+type StreamGroupInfo struct {
+	Name            string
+	Consumers       int64
+	Pending         int64
+	LastDeliveredID string
+	// EntriesRead and Lag are nil when the server cannot tell them, such as
+	// before Valkey 7.0 or after entries were deleted from the stream.
+	EntriesRead *int64
+	Lag         *int64
+}
+
+// StreamConsumerInfo is a consumer as listed by XINFO CONSUMERS.
+type StreamConsumerInfo struct {
+	Name    string
+	Pending int64
+	// IdleMs is the time since the consumer last attempted to read or claim.
+	IdleMs int64
+	// InactiveMs is the time since the consumer last read or claimed an
+	// entry, or -1 when it never did or the server predates Valkey 7.2.
+	InactiveMs int64
+}
+
+// StreamGroupDetail is a consumer group as listed by XINFO STREAM FULL.
+type StreamGroupDetail struct {
+	Name            string
+	LastDeliveredID string
+	EntriesRead     *int64
+	Lag             *int64
+	Pending         int64
+	PendingEntries  []StreamPendingDelivery
+	Consumers       []StreamConsumerDetail
+}
+
+// StreamConsumerDetail is a consumer as listed by XINFO STREAM FULL. Times
+// are Unix times in milliseconds; ActiveTime is -1 when the consumer never
+// read or claimed an entry.
+type StreamConsumerDetail struct {
+	Name           string
+	SeenTime       int64
+	ActiveTime     int64
+	Pending        int64
+	PendingEntries []StreamPendingDelivery
+}
+
+// StreamPendingDelivery is a pending entry as listed by XINFO STREAM FULL,
+// delivered at DeliveryTime (Unix time in milliseconds).
+type StreamPendingDelivery struct {
+	ID           string
+	Consumer     string
+	DeliveryTime int64
+	Deliveries   int64
+}
+
+// StreamTrim caps a stream, for XADD and XTRIM.
+type StreamTrim struct {
+	// Strategy is "MAXLEN", keeping at most Threshold entries, or "MINID",
+	// evicting entries with IDs lower than Threshold.
+	Strategy  string
+	Threshold string
+	// Approximate trims with "~": the server only evicts whole nodes, so
+	// the stream may keep a few more entries, but trimming is cheaper.
+	Approximate bool
+	// Limit caps the entries an approximate trim evicts; 0 leaves the
+	// server's default.
+	Limit int64
+}
+
+// StreamAddOptions are the optional arguments of XADD.
+type StreamAddOptions struct {
+	// NoMkStream leaves a missing stream uncreated; AddStream then returns
+	// an empty ID.
+	NoMkStream bool
+	Trim       *StreamTrim
+}
+
+// PubSubMessage is a message received on a Subscribe call. Pattern is the
+// pattern it matched, empty for channel and shard channel subscriptions.
+type PubSubMessage struct {
+	Channel string
+	Pattern string
+	Message []byte
+}
+
+// ListPop is an element popped by BLPOP from one of its keys.
+type ListPop struct {
+	Key   string
+	Value []byte
+}
+
+// ListPositionOptions are the optional arguments of LPOS. Rank picks the
+// match to start from, counting back from the tail when negative (0 means
+// 1); Count caps the matches returned (0 means all); MaxLen caps how many
+// elements are compared (0 means the whole list).
+type ListPositionOptions struct {
+	Rank   int64
+	Count  int64
+	MaxLen int64
+}
+
+// ListMultiPop is the elements popped by LMPOP from the first non-empty of
+// its keys.
+type ListMultiPop struct {
+	Key    string
+	Values [][]byte
+}
+
+// SortedSetPop is a member popped by BZPOPMIN from one of its keys.
+type SortedSetPop struct {
+	Key    string
+	Member []byte
+	Score  float64
+}
+
+// BitRange limits BITCOUNT and BITPOS to the bytes, or with Bit the bits,
+// from Start to End inclusive. Negative offsets count from the end of the
+// string; a nil End means its last byte or bit.
+type BitRange struct {
+	Start int64
+	End   *int64
+	Bit   bool
+}
+
+// BitFieldOp is one subcommand of BITFIELD.
+type BitFieldOp struct {
+	// Op is "GET", "SET", "INCRBY" or "OVERFLOW".
+	Op string
+	// Encoding is the integer type of the field, such as "u8" or "i16".
+	Encoding string
+	// Offset is a bit offset, or "#n" for the n-th field of Encoding's width.
+	Offset string
+	// Value is the value of SET or the increment of INCRBY.
+	Value int64
+	// Overflow is "WRAP", "SAT" or "FAIL" and applies to the SET and INCRBY
+	// operations that follow it.
+	Overflow string
+}
+
+// GeoPosition is a longitude and latitude in degrees.
+type GeoPosition struct {
+	Longitude float64
+	Latitude  float64
+}
+
+// GeoLocation is a member of a geo set and its position.
+type GeoLocation struct {
+	Member string
+	GeoPosition
+}
+
+// GeoAddOptions are the optional arguments of GEOADD. With CH, GeoAdd counts
+// moved members as well as added ones.
+type GeoAddOptions struct {
+	NX bool
+	XX bool
+	CH bool
+}
+
+// GeoSearchQuery is a GEOSEARCH query. It is centred on FromMember, or on
+// FromLonLat when FromMember is empty, and covers a circle of Radius or,
+// when Radius is zero, a box of Width by Height. Unit is m, km, ft or mi.
+type GeoSearchQuery struct {
+	FromMember string
+	FromLonLat *GeoPosition
+	Radius     float64
+	Width      float64
+	Height     float64
+	Unit       string
+	// Order is ASC, DESC or empty for no particular order.
+	Order string
+	// Count limits the matches when positive. With Any the search stops at
+	// the first Count matches rather than returning the nearest.
+	Count int64
+	Any   bool
+	// WithCoord, WithDist and WithHash ask GeoSearch for the position,
+	// distance and geohash of each match.
+	WithCoord bool
+	WithDist  bool
+	WithHash  bool
+}
+
+// GeoMatch is a GEOSEARCH match. Distance is in the query's unit; Distance,
+// Hash and Position are only set when the query asked for them.
+type GeoMatch struct {
+	Member   string
+	Distance *float64
+	Hash     *int64
+	Position *GeoPosition
+}
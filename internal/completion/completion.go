@@ -0,0 +1,361 @@
+// Package completion answers MCP completion/complete requests with values
+// read from Valkey: key names, configuration parameters, loaded script SHAs
+// and stream entry IDs.
+package completion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/resources"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/ItsJooL/valkey-mcp-server/internal/types"
+)
+
+const (
+	// maxValues is the most values a completion may return, as set by MCP.
+	maxValues = 100
+	// maxScanRounds bounds the SCAN calls spent on one key completion.
+	maxScanRounds = 10
+	// cacheTTL is how long completion results are reused. Clients request
+	// completions on every keystroke, so even a short TTL saves most round trips.
+	cacheTTL = 5 * time.Second
+)
+
+// streamIDArguments maps stream tools to the arguments that take entry IDs and
+// the special IDs each argument accepts.
+var streamIDArguments = map[string]map[string][]string{
+	"xack_stream":          {"ids": nil},
+	"xautoclaim_stream":    {"start": {"0-0"}},
+	"xclaim_stream":        {"ids": nil},
+	"xdel_stream":          {"ids": nil},
+	"xgroup_create_stream": {"id": {"$", "0"}},
+	"xgroup_setid_stream":  {"id": {"$", "0"}},
+	"xrange_stream":        {"start": {"-"}, "end": {"+"}},
+	"xread_block_stream":   {"id": {"$", "0"}},
+	"xread_stream":         {"id": {"$", "0"}},
+	"xreadgroup_stream":    {"id": {">", "0"}},
+	"xrevrange_stream":     {"end": {"+"}, "start": {"-"}},
+	"xtrim_stream":         {"minid": nil},
+}
+
+// Completer serves completion requests for one connection and database.
+type Completer struct {
+	client     client.ValkeyClient
+	connection string
+	db         types.DBIndex
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// cacheEntry is a cached completion result.
+type cacheEntry struct {
+	values  []string
+	hasMore bool
+	expires time.Time
+}
+
+// New creates a completer. connection and db are offered when completing the
+// corresponding variables of the key resource template.
+func New(c client.ValkeyClient, connection string, db types.DBIndex) *Completer {
+	if connection == "" {
+		connection = resources.DefaultConnection
+	}
+	return &Completer{
+		client:     c,
+		connection: connection,
+		db:         db,
+		now:        time.Now,
+		cache:      make(map[string]cacheEntry),
+	}
+}
+
+// Complete handles completion/complete. Use it as
+// mcp.ServerOptions.CompletionHandler.
+//
+// MCP only defines prompt and resource references. Tools have no reference
+// type, so a ref/prompt whose name is a tool name completes that tool's
+// arguments; tool and prompt names do not overlap.
+func (c *Completer) Complete(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	params := req.Params
+	if params == nil || params.Ref == nil {
+		return nil, fmt.Errorf("completion request has no reference")
+	}
+	var contextArgs map[string]string
+	if params.Context != nil {
+		contextArgs = params.Context.Arguments
+	}
+
+	var (
+		values  []string
+		hasMore bool
+		err     error
+	)
+	switch params.Ref.Type {
+	case "ref/prompt":
+		values, hasMore, err = c.completeArgument(ctx, params.Ref.Name, params.Argument, contextArgs)
+	case "ref/resource":
+		values, hasMore, err = c.completeResource(ctx, params.Ref.URI, params.Argument)
+	default:
+		return nil, fmt.Errorf("unsupported completion reference type %q", params.Ref.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if values == nil {
+		values = []string{}
+	}
+	return &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{
+			Values:  values,
+			HasMore: hasMore,
+		},
+	}, nil
+}
+
+// completeArgument completes an argument of the named prompt or tool.
+func (c *Completer) completeArgument(ctx context.Context, name string, arg mcp.CompleteParamsArgument, contextArgs map[string]string) ([]string, bool, error) {
+	if specials, ok := streamIDArguments[name][arg.Name]; ok {
+		return c.streamIDs(ctx, contextArgs["key"], specials, arg.Value)
+	}
+
+	switch {
+	case isKeyArgument(arg.Name):
+		return c.keys(ctx, arg.Value)
+	case arg.Name == "parameter":
+		return c.configParameters(ctx, arg.Value)
+	case arg.Name == "sha":
+		return c.scriptSHAs(ctx, arg.Value)
+	}
+	return nil, false, nil
+}
+
+// completeResource completes a variable of the key resource template.
+func (c *Completer) completeResource(ctx context.Context, uri string, arg mcp.CompleteParamsArgument) ([]string, bool, error) {
+	if uri != resources.URITemplate {
+		return nil, false, nil
+	}
+	switch arg.Name {
+	case "key":
+		return c.keys(ctx, arg.Value)
+	case "connection":
+		return filterPrefix([]string{c.connection}, arg.Value), false, nil
+	case "db":
+		return filterPrefix([]string{strconv.Itoa(c.db.Int())}, arg.Value), false, nil
+	}
+	return nil, false, nil
+}
+
+// isKeyArgument reports whether an argument takes key names or key patterns.
+func isKeyArgument(name string) bool {
+	switch name {
+	case "key", "keys", "pattern", "key_pattern":
+		return true
+	}
+	return strings.HasSuffix(name, "_key")
+}
+
+// keys returns key names starting with prefix, found with a bounded SCAN.
+func (c *Completer) keys(ctx context.Context, prefix string) ([]string, bool, error) {
+	return c.cached("keys\x00"+prefix, func() ([]string, bool, error) {
+		pattern := base.EscapeGlob(prefix) + "*"
+		seen := make(map[string]bool)
+		var cursor uint64
+		for round := 0; round < maxScanRounds; round++ {
+			keys, next, err := c.client.ScanKeys(ctx, cursor, pattern, maxValues)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to scan keys: %w", err)
+			}
+			for _, key := range keys {
+				seen[key] = true
+			}
+			cursor = next
+			if cursor == 0 || len(seen) > maxValues {
+				break
+			}
+		}
+		values, truncated := sortedLimited(seen)
+		return values, truncated || cursor != 0, nil
+	})
+}
+
+// configParameters returns configuration parameter names starting with prefix.
+func (c *Completer) configParameters(ctx context.Context, prefix string) ([]string, bool, error) {
+	return c.cached("config\x00"+prefix, func() ([]string, bool, error) {
+		config, err := c.client.ConfigGet(ctx, "*")
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get config: %w", err)
+		}
+		names := make(map[string]bool)
+		for name := range config {
+			if strings.HasPrefix(name, prefix) {
+				names[name] = true
+			}
+		}
+		values, truncated := sortedLimited(names)
+		return values, truncated, nil
+	})
+}
+
+// scriptSHAs returns SHAs of loaded scripts starting with prefix.
+func (c *Completer) scriptSHAs(ctx context.Context, prefix string) ([]string, bool, error) {
+	return c.cached("sha\x00"+prefix, func() ([]string, bool, error) {
+		shas, err := c.client.ListLoadedScripts(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list scripts: %w", err)
+		}
+		values := filterPrefix(shas, prefix)
+		if len(values) > maxValues {
+			return values[:maxValues], true, nil
+		}
+		return values, false, nil
+	})
+}
+
+// streamIDs returns entry IDs of the stream at key starting with prefix,
+// preceded by the special IDs the argument accepts. Without a key only the
+// special IDs are offered.
+func (c *Completer) streamIDs(ctx context.Context, key string, specials []string, prefix string) ([]string, bool, error) {
+	values := filterPrefix(specials, prefix)
+	if key == "" {
+		return values, false, nil
+	}
+	ids, hasMore, err := c.cached("stream\x00"+key+"\x00"+prefix, func() ([]string, bool, error) {
+		start := "-"
+		if prefix != "" {
+			// A bare time prefix is padded to the width of the oldest entry's
+			// time, which no later entry is narrower than.
+			width := 0
+			if isDigits(prefix) {
+				first, err := c.client.GetStreamRange(ctx, key, "-", "+", 1)
+				if err != nil {
+					return nil, false, fmt.Errorf("failed to read stream %q: %w", key, err)
+				}
+				if len(first) == 0 {
+					return nil, false, nil
+				}
+				firstMs, _, _ := strings.Cut(first[0].ID, "-")
+				width = len(firstMs)
+			}
+			var ok bool
+			if start, ok = streamIDLowerBound(prefix, width); !ok {
+				return nil, false, nil
+			}
+		}
+		entries, err := c.client.GetStreamRange(ctx, key, start, "+", maxValues)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read stream %q: %w", key, err)
+		}
+		ids := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.ID, prefix) {
+				ids = append(ids, entry.ID)
+			}
+		}
+		return ids, len(entries) == maxValues, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	values = append(values, ids...)
+	if len(values) > maxValues {
+		return values[:maxValues], true, nil
+	}
+	return values, hasMore, nil
+}
+
+// streamIDLowerBound returns the lowest ID that starts with prefix and has a
+// time at least width digits wide, or false when there is none. Times have
+// no leading zeros, so a bare time prefix is padded with zeros to width.
+func streamIDLowerBound(prefix string, width int) (string, bool) {
+	ms, seq, hasSeq := strings.Cut(prefix, "-")
+	if ms == "" || !isDigits(ms) || !isDigits(seq) {
+		return "", false
+	}
+	if !hasSeq && len(ms) < width {
+		ms += strings.Repeat("0", width-len(ms))
+	}
+	if seq == "" {
+		seq = "0"
+	}
+	if !isUint64(ms) || !isUint64(seq) {
+		return "", false
+	}
+	return ms + "-" + seq, true
+}
+
+// isDigits reports whether s holds only decimal digits.
+func isDigits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// isUint64 reports whether s is a decimal number that fits a stream ID part.
+func isUint64(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
+
+// cached returns the cached result for cacheKey, or computes and stores it.
+// Failures are not cached.
+func (c *Completer) cached(cacheKey string, compute func() ([]string, bool, error)) ([]string, bool, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.cache[cacheKey]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.values, entry.hasMore, nil
+	}
+
+	values, hasMore, err := compute()
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.mu.Lock()
+	for k, e := range c.cache {
+		if !now.Before(e.expires) {
+			delete(c.cache, k)
+		}
+	}
+	c.cache[cacheKey] = cacheEntry{values: values, hasMore: hasMore, expires: now.Add(cacheTTL)}
+	c.mu.Unlock()
+	return values, hasMore, nil
+}
+
+// sortedLimited returns the sorted members of set, capped at maxValues, and
+// whether any were dropped.
+func sortedLimited(set map[string]bool) ([]string, bool) {
+	values := make([]string, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	if len(values) > maxValues {
+		return values[:maxValues], true
+	}
+	return values, false
+}
+
+// filterPrefix returns the values starting with prefix.
+func filterPrefix(values []string, prefix string) []string {
+	var out []string
+	for _, v := range values {
+		if strings.HasPrefix(v, prefix) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
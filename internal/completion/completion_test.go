@@ -0,0 +1,260 @@
+package completion
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/resources"
+	"github.com/ItsJooL/valkey-mcp-server/internal/types"
+)
+
+func complete(t *testing.T, c *Completer, ref *mcp.CompleteReference, name, value string, contextArgs map[string]string) mcp.CompletionResultDetails {
+	t.Helper()
+	params := &mcp.CompleteParams{
+		Ref:      ref,
+		Argument: mcp.CompleteParamsArgument{Name: name, Value: value},
+	}
+	if contextArgs != nil {
+		params.Context = &mcp.CompleteContext{Arguments: contextArgs}
+	}
+	res, err := c.Complete(context.Background(), &mcp.CompleteRequest{Params: params})
+	require.NoError(t, err)
+	return res.Completion
+}
+
+func toolRef(name string) *mcp.CompleteReference {
+	return &mcp.CompleteReference{Type: "ref/prompt", Name: name}
+}
+
+func TestComplete_KeyArguments(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("user:1", []byte("a"))
+	mockClient.SetRawBytes("user:2", []byte("b"))
+	mockClient.SetRawBytes("order:1", []byte("c"))
+	c := New(mockClient, "", types.DBIndex(0))
+
+	for _, arg := range []string{"key", "new_key", "key_pattern"} {
+		got := complete(t, c, toolRef("get_string"), arg, "user:", nil)
+		assert.Equal(t, []string{"user:1", "user:2"}, got.Values, arg)
+		assert.False(t, got.HasMore)
+	}
+}
+
+func TestComplete_KeysEscapesPrefix(t *testing.T) {
+	var pattern string
+	c := New(&client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, p string, count int64) ([]string, uint64, error) {
+			pattern = p
+			return nil, 0, nil
+		},
+	}, "", types.DBIndex(0))
+
+	complete(t, c, toolRef("get_string"), "key", "a*[b]", nil)
+	assert.Equal(t, `a\*\[b\]*`, pattern)
+}
+
+func TestComplete_KeysBoundedScan(t *testing.T) {
+	calls := 0
+	c := New(&client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			calls++
+			return nil, cursor + 1, nil
+		},
+	}, "", types.DBIndex(0))
+
+	got := complete(t, c, toolRef("get_string"), "key", "", nil)
+	assert.Equal(t, maxScanRounds, calls)
+	assert.Empty(t, got.Values)
+	assert.True(t, got.HasMore)
+}
+
+func TestComplete_ConfigParameter(t *testing.T) {
+	mockClient := client.NewMockClient()
+	ctx := context.Background()
+	for _, name := range []string{"maxmemory", "maxmemory-policy", "timeout"} {
+		_, err := mockClient.ConfigSet(ctx, name, "0")
+		require.NoError(t, err)
+	}
+	c := New(mockClient, "", types.DBIndex(0))
+
+	got := complete(t, c, toolRef("config_get"), "parameter", "maxm", nil)
+	assert.Equal(t, []string{"maxmemory", "maxmemory-policy"}, got.Values)
+}
+
+func TestComplete_ScriptSHA(t *testing.T) {
+	mockClient := client.NewMockClient()
+	sha, err := mockClient.LoadScript(context.Background(), "return 1")
+	require.NoError(t, err)
+	c := New(mockClient, "", types.DBIndex(0))
+
+	got := complete(t, c, toolRef("evalsha_script"), "sha", sha[:3], nil)
+	assert.Equal(t, []string{sha}, got.Values)
+}
+
+func TestComplete_StreamIDs(t *testing.T) {
+	var readKey string
+	c := New(&client.MockValkeyClient{
+		GetStreamRangeFunc: func(ctx context.Context, key, start, end string, count int64) ([]client.StreamEntry, error) {
+			readKey = key
+			return []client.StreamEntry{{ID: "1-0"}, {ID: "1-1"}, {ID: "2-0"}}, nil
+		},
+	}, "", types.DBIndex(0))
+
+	got := complete(t, c, toolRef("xrange_stream"), "start", "", map[string]string{"key": "events"})
+	assert.Equal(t, "events", readKey)
+	assert.Equal(t, []string{"-", "1-0", "1-1", "2-0"}, got.Values)
+
+	got = complete(t, c, toolRef("xread_stream"), "id", "1-", map[string]string{"key": "events"})
+	assert.Equal(t, []string{"1-0", "1-1"}, got.Values)
+
+	got = complete(t, c, toolRef("xrange_stream"), "end", "", nil)
+	assert.Equal(t, []string{"+"}, got.Values)
+
+	got = complete(t, c, toolRef("xdel_stream"), "ids", "2", map[string]string{"key": "events"})
+	assert.Equal(t, []string{"2-0"}, got.Values)
+
+	got = complete(t, c, toolRef("xtrim_stream"), "minid", "", map[string]string{"key": "events"})
+	assert.Equal(t, []string{"1-0", "1-1", "2-0"}, got.Values)
+
+	got = complete(t, c, toolRef("xreadgroup_stream"), "id", "", nil)
+	assert.Equal(t, []string{">", "0"}, got.Values)
+
+	got = complete(t, c, toolRef("xrevrange_stream"), "start", "", nil)
+	assert.Equal(t, []string{"-"}, got.Values)
+}
+
+func TestComplete_StreamIDsStartAtPrefix(t *testing.T) {
+	// 300 entries with IDs 1700000000000-0 to 1700000000299-0.
+	var entries []client.StreamEntry
+	for ms := uint64(1700000000000); ms < 1700000000300; ms++ {
+		entries = append(entries, client.StreamEntry{ID: strconv.FormatUint(ms, 10) + "-0"})
+	}
+	var starts []string
+	c := New(&client.MockValkeyClient{
+		GetStreamRangeFunc: func(ctx context.Context, key, start, end string, count int64) ([]client.StreamEntry, error) {
+			starts = append(starts, start)
+			from := 0
+			if start != "-" {
+				from = sort.Search(len(entries), func(i int) bool { return entries[i].ID >= start })
+			}
+			return entries[from:min(from+int(count), len(entries))], nil
+		},
+	}, "", types.DBIndex(0))
+
+	got := complete(t, c, toolRef("xdel_stream"), "ids", "17000000002", map[string]string{"key": "events"})
+	assert.Equal(t, []string{"-", "1700000000200-0"}, starts)
+	assert.Len(t, got.Values, maxValues)
+	assert.Equal(t, "1700000000200-0", got.Values[0])
+	assert.Equal(t, "1700000000299-0", got.Values[maxValues-1])
+
+	starts = nil
+	got = complete(t, c, toolRef("xdel_stream"), "ids", "1700000000250-", map[string]string{"key": "events"})
+	assert.Equal(t, []string{"1700000000250-0"}, starts)
+	assert.Equal(t, []string{"1700000000250-0"}, got.Values)
+
+	// A prefix that no ID can start with does not read the stream.
+	starts = nil
+	got = complete(t, c, toolRef("xdel_stream"), "ids", "abc", map[string]string{"key": "events"})
+	assert.Empty(t, starts)
+	assert.Empty(t, got.Values)
+}
+
+func TestStreamIDLowerBound(t *testing.T) {
+	tests := []struct {
+		prefix string
+		width  int
+		want   string
+		ok     bool
+	}{
+		{"17", 13, "1700000000000-0", true},
+		{"123", 1, "123-0", true},
+		{"5-", 13, "5-0", true},
+		{"5-12", 0, "5-12", true},
+		{"9", 19, "9000000000000000000-0", true},
+		// Every 20-digit time starting with 9 exceeds the largest time.
+		{"9", 20, "", false},
+		{"-1", 0, "", false},
+		{"1-x", 0, "", false},
+		{"x", 0, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := streamIDLowerBound(tt.prefix, tt.width)
+		assert.Equal(t, tt.ok, ok, tt.prefix)
+		assert.Equal(t, tt.want, got, tt.prefix)
+	}
+}
+
+func TestComplete_ResourceTemplate(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("user:1", []byte("a"))
+	c := New(mockClient, "main", types.DBIndex(3))
+	ref := &mcp.CompleteReference{Type: "ref/resource", URI: resources.URITemplate}
+
+	assert.Equal(t, []string{"user:1"}, complete(t, c, ref, "key", "us", nil).Values)
+	assert.Equal(t, []string{"main"}, complete(t, c, ref, "connection", "", nil).Values)
+	assert.Equal(t, []string{"3"}, complete(t, c, ref, "db", "", nil).Values)
+
+	other := &mcp.CompleteReference{Type: "ref/resource", URI: "file:///{path}"}
+	assert.Empty(t, complete(t, c, other, "key", "", nil).Values)
+}
+
+func TestComplete_UnknownArgument(t *testing.T) {
+	c := New(client.NewMockClient(), "", types.DBIndex(0))
+	got := complete(t, c, toolRef("set_string"), "value", "x", nil)
+	assert.NotNil(t, got.Values)
+	assert.Empty(t, got.Values)
+}
+
+func TestComplete_CachesResults(t *testing.T) {
+	calls := 0
+	c := New(&client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			calls++
+			return []string{"user:1"}, 0, nil
+		},
+	}, "", types.DBIndex(0))
+	now := time.Unix(1000, 0)
+	c.now = func() time.Time { return now }
+
+	complete(t, c, toolRef("get_string"), "key", "u", nil)
+	complete(t, c, toolRef("get_string"), "key", "u", nil)
+	assert.Equal(t, 1, calls)
+
+	now = now.Add(cacheTTL)
+	complete(t, c, toolRef("get_string"), "key", "u", nil)
+	assert.Equal(t, 2, calls)
+}
+
+func TestComplete_OverMCP(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("user:1", []byte("a"))
+	c := New(mockClient, "", types.DBIndex(0))
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, &mcp.ServerOptions{
+		CompletionHandler: c.Complete,
+	})
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, nil).Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	res, err := session.Complete(ctx, &mcp.CompleteParams{
+		Ref:      &mcp.CompleteReference{Type: "ref/prompt", Name: "explain_key"},
+		Argument: mcp.CompleteParamsArgument{Name: "key", Value: "user"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user:1"}, res.Completion.Values)
+}
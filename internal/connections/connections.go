@@ -0,0 +1,142 @@
+// Package connections manages the named Valkey connections tools can address
+// besides the server's own.
+package connections
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// Dialer opens a client for a connection URL.
+type Dialer func(ctx context.Context, url string) (client.ValkeyClient, error)
+
+// Manager holds the default connection and dials named ones on first use.
+type Manager struct {
+	defaultName   string
+	defaultClient client.ValkeyClient
+	dial          Dialer
+
+	mu      sync.Mutex
+	urls    map[string]string
+	clients map[string]client.ValkeyClient
+}
+
+// New creates a manager whose default connection is defaultClient, known as
+// defaultName. Other connections are opened with dial.
+func New(defaultName string, defaultClient client.ValkeyClient, dial Dialer) *Manager {
+	return &Manager{
+		defaultName:   defaultName,
+		defaultClient: defaultClient,
+		dial:          dial,
+		urls:          make(map[string]string),
+		clients:       make(map[string]client.ValkeyClient),
+	}
+}
+
+// Add declares the connection name to the server at url. It is dialed on
+// first use.
+func (m *Manager) Add(name, url string) error {
+	if name == "" || url == "" {
+		return fmt.Errorf("connection name and URL cannot be empty")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.urls[name]; exists || name == m.defaultName {
+		return fmt.Errorf("connection %q is already defined", name)
+	}
+	m.urls[name] = url
+	return nil
+}
+
+// Default returns the name of the default connection.
+func (m *Manager) Default() string {
+	return m.defaultName
+}
+
+// Get returns the client of connection name, dialing it if needed. An empty
+// name is the default connection.
+func (m *Manager) Get(ctx context.Context, name string) (client.ValkeyClient, error) {
+	if name == "" || name == m.defaultName {
+		return m.defaultClient, nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.clients[name]; ok {
+		return c, nil
+	}
+	url, ok := m.urls[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown connection %q (known: %s)", name, strings.Join(m.names(), ", "))
+	}
+	c, err := m.dial(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %q: %w", name, err)
+	}
+	m.clients[name] = c
+	return c, nil
+}
+
+// Names returns the connection names, the default one first.
+func (m *Manager) Names() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.names()
+}
+
+// names returns the connection names. The caller must hold m.mu.
+func (m *Manager) names() []string {
+	names := make([]string, 0, len(m.urls))
+	for name := range m.urls {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return append([]string{m.defaultName}, names...)
+}
+
+// Close closes the connections the manager dialed. The default connection is
+// left to its owner.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var firstErr error
+	for name, c := range m.clients {
+		if closer, ok := c.(io.Closer); ok {
+			if err := closer.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		delete(m.clients, name)
+	}
+	return firstErr
+}
+
+// ParseList parses a comma-separated list of name=url pairs, as given to the
+// -connections flag.
+func ParseList(s string) (map[string]string, error) {
+	connections := make(map[string]string)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, url, ok := strings.Cut(item, "=")
+		name, url = strings.TrimSpace(name), strings.TrimSpace(url)
+		if !ok || name == "" || url == "" {
+			return nil, fmt.Errorf("invalid connection %q: expected name=url", item)
+		}
+		if _, exists := connections[name]; exists {
+			return nil, fmt.Errorf("connection %q is defined twice", name)
+		}
+		connections[name] = url
+	}
+	return connections, nil
+}
@@ -0,0 +1,80 @@
+package connections
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// closingClient records whether it was closed.
+type closingClient struct {
+	*client.MockClient
+	closed bool
+}
+
+func (c *closingClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestManager_Get(t *testing.T) {
+	ctx := context.Background()
+	primary := client.NewMockClient()
+	var dialed []string
+	dialedClient := &closingClient{MockClient: client.NewMockClient()}
+	m := New("default", primary, func(ctx context.Context, url string) (client.ValkeyClient, error) {
+		dialed = append(dialed, url)
+		if url == "valkey://down:6379" {
+			return nil, errors.New("connection refused")
+		}
+		return dialedClient, nil
+	})
+	require.NoError(t, m.Add("replica", "valkey://replica:6379"))
+	require.NoError(t, m.Add("down", "valkey://down:6379"))
+	assert.EqualError(t, m.Add("default", "valkey://other:6379"), `connection "default" is already defined`)
+
+	c, err := m.Get(ctx, "")
+	require.NoError(t, err)
+	assert.Same(t, primary, c)
+	c, err = m.Get(ctx, "default")
+	require.NoError(t, err)
+	assert.Same(t, primary, c)
+
+	for range 2 {
+		c, err = m.Get(ctx, "replica")
+		require.NoError(t, err)
+		assert.Same(t, dialedClient, c)
+	}
+	assert.Equal(t, []string{"valkey://replica:6379"}, dialed)
+
+	_, err = m.Get(ctx, "down")
+	assert.EqualError(t, err, `failed to connect to "down": connection refused`)
+	_, err = m.Get(ctx, "missing")
+	assert.EqualError(t, err, `unknown connection "missing" (known: default, down, replica)`)
+
+	require.NoError(t, m.Close())
+	assert.True(t, dialedClient.closed)
+}
+
+func TestParseList(t *testing.T) {
+	list, err := ParseList(" staging=valkey://staging:6379 , prod=rediss://user:pw@prod:6380/2,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"staging": "valkey://staging:6379",
+		"prod":    "rediss://user:pw@prod:6380/2",
+	}, list)
+
+	list, err = ParseList("")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	_, err = ParseList("staging")
+	assert.EqualError(t, err, `invalid connection "staging": expected name=url`)
+	_, err = ParseList("a=valkey://x,a=valkey://y")
+	assert.EqualError(t, err, `connection "a" is defined twice`)
+}
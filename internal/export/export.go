@@ -0,0 +1,543 @@
+// Package export converts keys to portable records and back. A record holds
+// a key's type, TTL and a type-aware value, and optionally its DUMP payload;
+// files hold one record per line as JSON Lines.
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// Record is one exported key.
+//
+// Value depends on Type: a string for a string, an array of strings for a
+// list or set, an object of field to value for a hash, an array of
+// {"member", "score"} objects for a sorted set and an array of
+// {"id", "fields"} objects for a stream. When Base64 is set every string in
+// Value, including hash and stream field names, and every consumer group name
+// in Stream is base64-encoded because at least one of them is not valid
+// UTF-8.
+type Record struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+	// PTTL is the remaining time to live in milliseconds when the key was
+	// exported, or -1 for none.
+	PTTL   int64           `json:"pttl"`
+	Base64 bool            `json:"base64,omitempty"`
+	Value  json.RawMessage `json:"value"`
+	// Stream holds the IDs and consumer groups of a stream, which keep an
+	// empty stream from being lost.
+	Stream *StreamState `json:"stream,omitempty"`
+	// Dump is the base64 DUMP payload. It only restores on servers with a
+	// compatible RDB version.
+	Dump string `json:"dump,omitempty"`
+}
+
+// ZsetMember is a sorted set member in a record.
+type ZsetMember struct {
+	Member string `json:"member"`
+	Score  Score  `json:"score"`
+}
+
+// StreamEntry is a stream entry in a record.
+type StreamEntry struct {
+	ID     string            `json:"id"`
+	Fields map[string]string `json:"fields"`
+}
+
+// StreamState is the state of a stream beyond its entries. Consumers and
+// their pending entries are not exported.
+type StreamState struct {
+	LastID string `json:"last_id"`
+	// EntriesAdded and MaxDeletedID are empty before Valkey 7.0.
+	EntriesAdded int64         `json:"entries_added,omitempty"`
+	MaxDeletedID string        `json:"max_deleted_id,omitempty"`
+	Groups       []StreamGroup `json:"groups,omitempty"`
+}
+
+// StreamGroup is a consumer group in a record.
+type StreamGroup struct {
+	Name            string `json:"name"`
+	LastDeliveredID string `json:"last_delivered_id"`
+	EntriesRead     *int64 `json:"entries_read,omitempty"`
+}
+
+// Score is a sorted set score. Infinite scores, which JSON numbers cannot
+// hold, are written as the strings "inf" and "-inf".
+type Score float64
+
+// MarshalJSON implements json.Marshaler.
+func (s Score) MarshalJSON() ([]byte, error) {
+	switch {
+	case math.IsInf(float64(s), 1):
+		return []byte(`"inf"`), nil
+	case math.IsInf(float64(s), -1):
+		return []byte(`"-inf"`), nil
+	}
+	return json.Marshal(float64(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Score) UnmarshalJSON(b []byte) error {
+	switch string(b) {
+	case `"inf"`, `"+inf"`:
+		*s = Score(math.Inf(1))
+		return nil
+	case `"-inf"`:
+		*s = Score(math.Inf(-1))
+		return nil
+	}
+	return json.Unmarshal(b, (*float64)(s))
+}
+
+// Read exports key. withDump adds its DUMP payload. It returns nil when the
+// key does not exist.
+func Read(ctx context.Context, c client.ValkeyClient, key string, withDump bool) (*Record, error) {
+	keyType, err := c.GetKeyType(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get type of key %q: %w", key, err)
+	}
+	if keyType == "none" {
+		return nil, nil
+	}
+	pttl, err := c.GetPTTL(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TTL of key %q: %w", key, err)
+	}
+	if pttl == -2 {
+		return nil, nil
+	}
+
+	// raw collects every string of the value, so Base64 can be decided
+	// before value encodes them.
+	var raw [][]byte
+	var value func(encoder) any
+	var stream func(encoder) *StreamState
+	switch keyType {
+	case "string":
+		s, found, err := c.GetString(ctx, key)
+		if err != nil || !found {
+			return nil, readError(key, err)
+		}
+		raw = [][]byte{s}
+		value = func(enc encoder) any { return enc(s) }
+	case "list":
+		elements, err := c.GetListRange(ctx, key, 0, -1)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		raw = elements
+		value = func(enc encoder) any { return encodeAll(enc, elements) }
+	case "set":
+		members, err := c.ListSetMembers(ctx, key)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		slices.SortFunc(members, bytes.Compare)
+		raw = members
+		value = func(enc encoder) any { return encodeAll(enc, members) }
+	case "hash":
+		fields, err := c.GetMap(ctx, key)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		for name, v := range fields {
+			raw = append(raw, []byte(name), v)
+		}
+		value = func(enc encoder) any {
+			m := make(map[string]string, len(fields))
+			for name, v := range fields {
+				m[enc([]byte(name))] = enc(v)
+			}
+			return m
+		}
+	case "zset":
+		members, err := c.GetSortedSetRange(ctx, key, 0, -1)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		for _, m := range members {
+			raw = append(raw, m.Member)
+		}
+		value = func(enc encoder) any {
+			out := make([]ZsetMember, len(members))
+			for i, m := range members {
+				out[i] = ZsetMember{Member: enc(m.Member), Score: Score(m.Score)}
+			}
+			return out
+		}
+	case "stream":
+		entries, err := c.GetStreamRange(ctx, key, "-", "+", 0)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		for _, e := range entries {
+			for name, v := range e.FieldValues {
+				raw = append(raw, []byte(name), v)
+			}
+		}
+		value = func(enc encoder) any {
+			out := make([]StreamEntry, len(entries))
+			for i, e := range entries {
+				fields := make(map[string]string, len(e.FieldValues))
+				for name, v := range e.FieldValues {
+					fields[enc([]byte(name))] = enc(v)
+				}
+				out[i] = StreamEntry{ID: e.ID, Fields: fields}
+			}
+			return out
+		}
+		info, err := c.GetStreamInfo(ctx, key, false, 0)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		groups, err := c.GetStreamGroups(ctx, key)
+		if err != nil {
+			return nil, readError(key, err)
+		}
+		for _, g := range groups {
+			raw = append(raw, []byte(g.Name))
+		}
+		stream = func(enc encoder) *StreamState {
+			state := &StreamState{LastID: info.LastGeneratedID, EntriesAdded: info.EntriesAdded}
+			if info.MaxDeletedEntryID != "0-0" {
+				state.MaxDeletedID = info.MaxDeletedEntryID
+			}
+			for _, g := range groups {
+				state.Groups = append(state.Groups, StreamGroup{
+					Name:            enc([]byte(g.Name)),
+					LastDeliveredID: g.LastDeliveredID,
+					EntriesRead:     g.EntriesRead,
+				})
+			}
+			return state
+		}
+	default:
+		return nil, fmt.Errorf("cannot export key %q of type %q", key, keyType)
+	}
+
+	rec := &Record{Key: key, Type: keyType, PTTL: pttl}
+	enc := encoder(func(b []byte) string { return string(b) })
+	if slices.ContainsFunc(raw, func(b []byte) bool { return !utf8.Valid(b) }) {
+		rec.Base64 = true
+		enc = base64.StdEncoding.EncodeToString
+	}
+	if rec.Value, err = json.Marshal(value(enc)); err != nil {
+		return nil, fmt.Errorf("failed to encode key %q: %w", key, err)
+	}
+	if stream != nil {
+		rec.Stream = stream(enc)
+	}
+
+	if withDump {
+		payload, err := c.DumpKey(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump key %q: %w", key, err)
+		}
+		rec.Dump = base64.StdEncoding.EncodeToString(payload)
+	}
+	return rec, nil
+}
+
+// encoder converts a value read from the server to a record string.
+type encoder func([]byte) string
+
+func encodeAll(enc encoder, values [][]byte) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = enc(v)
+	}
+	return out
+}
+
+// readError reports a failure, or a key that vanished, while reading key.
+func readError(key string, err error) error {
+	if err == nil {
+		return fmt.Errorf("key %q was deleted while it was exported", key)
+	}
+	return fmt.Errorf("failed to read key %q: %w", key, err)
+}
+
+// WriteOptions configures Write.
+type WriteOptions struct {
+	// Replace deletes an existing key first. Without it the key must not
+	// exist.
+	Replace bool
+	// IgnoreTTL writes the key without an expiry.
+	IgnoreTTL bool
+}
+
+// Write recreates rec. A record with a Dump is restored with RESTORE; if the
+// server rejects the payload, for example because it comes from a newer
+// version, the key is rebuilt from Value instead. A rebuilt key is not
+// written atomically.
+func Write(ctx context.Context, c client.ValkeyClient, rec *Record, opts WriteOptions) error {
+	ttl := rec.PTTL
+	if opts.IgnoreTTL || ttl < 0 {
+		ttl = 0
+	}
+
+	if rec.Dump != "" {
+		payload, err := base64.StdEncoding.DecodeString(rec.Dump)
+		if err != nil {
+			return fmt.Errorf("invalid dump of key %q: %w", rec.Key, err)
+		}
+		_, restoreErr := c.RestoreKey(ctx, rec.Key, ttl, payload, client.RestoreOptions{Replace: opts.Replace})
+		if restoreErr == nil {
+			return nil
+		}
+		if len(rec.Value) == 0 {
+			return fmt.Errorf("failed to restore key %q: %w", rec.Key, restoreErr)
+		}
+	}
+
+	if opts.Replace {
+		if _, err := c.DeleteKey(ctx, rec.Key); err != nil {
+			return fmt.Errorf("failed to delete key %q: %w", rec.Key, err)
+		}
+	}
+	if err := writeValue(ctx, c, rec); err != nil {
+		return fmt.Errorf("failed to write key %q: %w", rec.Key, err)
+	}
+	if ttl > 0 {
+		if _, err := c.PExpireKey(ctx, rec.Key, ttl); err != nil {
+			return fmt.Errorf("failed to set TTL of key %q: %w", rec.Key, err)
+		}
+	}
+	return nil
+}
+
+// writeValue writes the value of rec to a key that does not exist.
+func writeValue(ctx context.Context, c client.ValkeyClient, rec *Record) error {
+	dec := func(s string) (string, error) { return s, nil }
+	if rec.Base64 {
+		dec = func(s string) (string, error) {
+			b, err := base64.StdEncoding.DecodeString(s)
+			return string(b), err
+		}
+	}
+	decAll := func(values []string) ([]string, error) {
+		out := make([]string, len(values))
+		for i, v := range values {
+			var err error
+			if out[i], err = dec(v); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	decMap := func(fields map[string]string) (map[string]string, error) {
+		out := make(map[string]string, len(fields))
+		for name, v := range fields {
+			name, err := dec(name)
+			if err != nil {
+				return nil, err
+			}
+			if out[name], err = dec(v); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+
+	switch rec.Type {
+	case "string":
+		var s string
+		if err := json.Unmarshal(rec.Value, &s); err != nil {
+			return err
+		}
+		s, err := dec(s)
+		if err != nil {
+			return err
+		}
+		_, err = c.SetString(ctx, rec.Key, s, nil, false, false)
+		return err
+	case "list", "set":
+		var values []string
+		if err := json.Unmarshal(rec.Value, &values); err != nil {
+			return err
+		}
+		values, err := decAll(values)
+		if err != nil {
+			return err
+		}
+		if len(values) == 0 {
+			return errors.New("empty collection")
+		}
+		if rec.Type == "list" {
+			_, err = c.PushList(ctx, rec.Key, values, true)
+		} else {
+			_, err = c.AddSet(ctx, rec.Key, values)
+		}
+		return err
+	case "hash":
+		var fields map[string]string
+		if err := json.Unmarshal(rec.Value, &fields); err != nil {
+			return err
+		}
+		fields, err := decMap(fields)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			return errors.New("empty collection")
+		}
+		_, err = c.SetMap(ctx, rec.Key, fields)
+		return err
+	case "zset":
+		var members []ZsetMember
+		if err := json.Unmarshal(rec.Value, &members); err != nil {
+			return err
+		}
+		if len(members) == 0 {
+			return errors.New("empty collection")
+		}
+		out := make([]client.SortedSetMember, len(members))
+		for i, m := range members {
+			member, err := dec(m.Member)
+			if err != nil {
+				return err
+			}
+			out[i] = client.SortedSetMember{Member: []byte(member), Score: float64(m.Score)}
+		}
+		_, err := c.AddSortedSet(ctx, rec.Key, out)
+		return err
+	case "stream":
+		var entries []StreamEntry
+		if err := json.Unmarshal(rec.Value, &entries); err != nil {
+			return err
+		}
+		if len(entries) == 0 && rec.Stream == nil {
+			return errors.New("empty stream")
+		}
+		for _, e := range entries {
+			fields, err := decMap(e.Fields)
+			if err != nil {
+				return err
+			}
+			if _, err := c.AddStream(ctx, rec.Key, e.ID, fields, client.StreamAddOptions{}); err != nil {
+				return err
+			}
+		}
+		if rec.Stream == nil {
+			return nil
+		}
+		return writeStreamState(ctx, c, rec.Key, len(entries) == 0, rec.Stream, dec)
+	default:
+		return fmt.Errorf("unsupported type %q", rec.Type)
+	}
+}
+
+// placeholderGroup creates a stream that has no entries and no consumer
+// groups, and is destroyed once the stream exists.
+const placeholderGroup = "export-placeholder"
+
+// writeStreamState sets the IDs of the stream at key and creates its consumer
+// groups. An empty stream is created by XGROUP CREATE MKSTREAM, with its first
+// group or with placeholderGroup when it has none.
+func writeStreamState(ctx context.Context, c client.ValkeyClient, key string, empty bool, state *StreamState, dec func(string) (string, error)) error {
+	names := make([]string, len(state.Groups))
+	for i, g := range state.Groups {
+		var err error
+		if names[i], err = dec(g.Name); err != nil {
+			return err
+		}
+	}
+
+	created := ""
+	if empty {
+		created = placeholderGroup
+		if len(names) > 0 {
+			created = names[0]
+		}
+		if err := c.CreateStreamGroup(ctx, key, created, "0", true, nil); err != nil {
+			return err
+		}
+	}
+	if err := c.SetStreamID(ctx, key, state.LastID, state.EntriesAdded, state.MaxDeletedID); err != nil {
+		return err
+	}
+	for i, g := range state.Groups {
+		var err error
+		if names[i] == created {
+			err = c.SetStreamGroupID(ctx, key, names[i], g.LastDeliveredID, g.EntriesRead)
+		} else {
+			err = c.CreateStreamGroup(ctx, key, names[i], g.LastDeliveredID, false, g.EntriesRead)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if created == placeholderGroup && len(names) == 0 {
+		if _, err := c.DestroyStreamGroup(ctx, key, placeholderGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Encode writes rec as one line of JSON.
+func Encode(w io.Writer, rec *Record) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(rec)
+}
+
+// Decode reads every record of a JSON Lines stream.
+func Decode(r io.Reader) ([]*Record, error) {
+	dec := json.NewDecoder(r)
+	var records []*Record
+	for {
+		rec := &Record{}
+		err := dec.Decode(rec)
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid record %d: %w", len(records)+1, err)
+		}
+		if rec.Key == "" || rec.Type == "" {
+			return nil, fmt.Errorf("invalid record %d: key and type are required", len(records)+1)
+		}
+		records = append(records, rec)
+	}
+}
+
+// Create creates or truncates the file name inside dir for writing. Names
+// that resolve outside dir are refused, as is every name when dir is empty.
+func Create(dir, name string) (*os.File, error) {
+	return openInDir(dir, name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+}
+
+// Open opens the file name inside dir for reading, with the same
+// restrictions as Create.
+func Open(dir, name string) (*os.File, error) {
+	return openInDir(dir, name, os.O_RDONLY)
+}
+
+func openInDir(dir, name string, flag int) (*os.File, error) {
+	if dir == "" {
+		return nil, errors.New("file access is disabled: no export directory is configured")
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open export directory: %w", err)
+	}
+	defer root.Close()
+	f, err := root.OpenFile(name, flag, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", name, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,227 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seed stores one key of every type in c.
+func seed(t *testing.T, c *client.MockClient) []string {
+	t.Helper()
+	ctx := context.Background()
+	hour := int64(3600)
+
+	_, err := c.SetString(ctx, "str", "hello", &hour, false, false)
+	require.NoError(t, err)
+	c.SetRawBytes("bin", []byte{0xff, 0x00, 'x'})
+	_, err = c.PushList(ctx, "list", []string{"a", "b", "a"}, true)
+	require.NoError(t, err)
+	_, err = c.AddSet(ctx, "set", []string{"y", "x"})
+	require.NoError(t, err)
+	_, err = c.SetMap(ctx, "hash", map[string]string{"name": "ann", "age": "30"})
+	require.NoError(t, err)
+	_, err = c.AddSortedSet(ctx, "zset", []client.SortedSetMember{
+		{Member: []byte("low"), Score: math.Inf(-1)},
+		{Member: []byte("mid"), Score: 1.5},
+	})
+	require.NoError(t, err)
+	_, err = c.AddStream(ctx, "stream", "1-1", map[string]string{"f": "v"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	_, err = c.AddStream(ctx, "stream", "2-0", map[string]string{"g": "w"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	return []string{"bin", "hash", "list", "set", "str", "stream", "zset"}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := client.NewMockClient()
+	keys := seed(t, source)
+
+	var file bytes.Buffer
+	var exported []*Record
+	for _, key := range keys {
+		rec, err := Read(ctx, source, key, false)
+		require.NoError(t, err)
+		require.NotNil(t, rec, key)
+		require.NoError(t, Encode(&file, rec))
+		exported = append(exported, rec)
+	}
+	assert.Equal(t, len(keys), strings.Count(file.String(), "\n"))
+
+	records, err := Decode(&file)
+	require.NoError(t, err)
+	require.Len(t, records, len(keys))
+
+	target := client.NewMockClient()
+	for _, rec := range records {
+		require.NoError(t, Write(ctx, target, rec, WriteOptions{}))
+	}
+	for i, key := range keys {
+		rec, err := Read(ctx, target, key, false)
+		require.NoError(t, err)
+		assert.Equal(t, exported[i], rec, key)
+	}
+}
+
+func TestRoundTrip_EmptyStreamWithGroup(t *testing.T) {
+	ctx := context.Background()
+	source := client.NewMockClient()
+	_, err := source.AddStream(ctx, "jobs", "5-0", map[string]string{"f": "v"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	require.NoError(t, source.CreateStreamGroup(ctx, "jobs", "workers", "$", false, nil))
+	_, err = source.DeleteStreamEntries(ctx, "jobs", []string{"5-0"})
+	require.NoError(t, err)
+
+	rec, err := Read(ctx, source, "jobs", false)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(rec.Value))
+	require.NotNil(t, rec.Stream)
+	assert.Equal(t, "5-0", rec.Stream.LastID)
+
+	var file bytes.Buffer
+	require.NoError(t, Encode(&file, rec))
+	records, err := Decode(&file)
+	require.NoError(t, err)
+
+	target := client.NewMockClient()
+	require.NoError(t, Write(ctx, target, records[0], WriteOptions{}))
+
+	info, err := target.GetStreamInfo(ctx, "jobs", false, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.Length)
+	assert.Equal(t, "5-0", info.LastGeneratedID)
+	assert.Equal(t, "5-0", info.MaxDeletedEntryID)
+	assert.Equal(t, int64(1), info.EntriesAdded)
+	groups, err := target.GetStreamGroups(ctx, "jobs")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, "workers", groups[0].Name)
+	assert.Equal(t, "5-0", groups[0].LastDeliveredID)
+	assert.Equal(t, int64(1), *groups[0].EntriesRead)
+
+	// A stream without entries or groups still round-trips.
+	empty := &Record{Key: "empty", Type: "stream", PTTL: -1, Value: []byte(`[]`), Stream: &StreamState{LastID: "3-0"}}
+	require.NoError(t, Write(ctx, target, empty, WriteOptions{}))
+	info, err = target.GetStreamInfo(ctx, "empty", false, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "3-0", info.LastGeneratedID)
+	assert.Equal(t, int64(0), info.Groups)
+}
+
+func TestRead_Values(t *testing.T) {
+	ctx := context.Background()
+	c := client.NewMockClient()
+	seed(t, c)
+
+	rec, err := Read(ctx, c, "str", false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600000), rec.PTTL)
+	assert.JSONEq(t, `"hello"`, string(rec.Value))
+
+	rec, err = Read(ctx, c, "bin", false)
+	require.NoError(t, err)
+	assert.True(t, rec.Base64)
+	assert.Equal(t, int64(-1), rec.PTTL)
+	assert.JSONEq(t, `"/wB4"`, string(rec.Value))
+
+	rec, err = Read(ctx, c, "zset", false)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"member":"low","score":"-inf"},{"member":"mid","score":1.5}]`, string(rec.Value))
+
+	rec, err = Read(ctx, c, "stream", false)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"1-1","fields":{"f":"v"}},{"id":"2-0","fields":{"g":"w"}}]`, string(rec.Value))
+
+	rec, err = Read(ctx, c, "missing", false)
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+}
+
+func TestWrite_Replace(t *testing.T) {
+	ctx := context.Background()
+	c := client.NewMockClient()
+	_, err := c.AddSet(ctx, "key", []string{"old"})
+	require.NoError(t, err)
+
+	rec := &Record{Key: "key", Type: "set", PTTL: 5000, Value: []byte(`["new"]`)}
+	require.NoError(t, Write(ctx, c, rec, WriteOptions{Replace: true}))
+
+	members, err := c.ListSetMembers(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("new")}, members)
+	ttl, err := c.GetTTL(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), ttl)
+
+	require.NoError(t, Write(ctx, c, &Record{Key: "plain", Type: "string", PTTL: 5000, Value: []byte(`"v"`)}, WriteOptions{IgnoreTTL: true}))
+	ttl, err = c.GetTTL(ctx, "plain")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), ttl)
+}
+
+func TestWrite_Dump(t *testing.T) {
+	ctx := context.Background()
+	var restored, set bool
+	c := &client.MockValkeyClient{
+		RestoreKeyFunc: func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
+			restored = true
+			assert.Equal(t, []byte("payload"), serialized)
+			assert.Equal(t, int64(5000), ttl)
+			assert.True(t, opts.Replace)
+			return true, nil
+		},
+		SetStringFunc: func(ctx context.Context, key, value string, ttlSeconds *int64, nx, xx bool) (bool, error) {
+			set = true
+			return true, nil
+		},
+	}
+	rec := &Record{Key: "k", Type: "string", PTTL: 5000, Value: []byte(`"v"`), Dump: "cGF5bG9hZA=="}
+
+	require.NoError(t, Write(ctx, c, rec, WriteOptions{Replace: true}))
+	assert.True(t, restored)
+	assert.False(t, set)
+
+	// A payload the server rejects falls back to the value.
+	c.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
+		return false, errors.New("ERR DUMP payload version or checksum are wrong")
+	}
+	require.NoError(t, Write(ctx, c, rec, WriteOptions{Replace: true}))
+	assert.True(t, set)
+
+	rec.Value = nil
+	assert.ErrorContains(t, Write(ctx, c, rec, WriteOptions{}), "payload version")
+}
+
+func TestWrite_Invalid(t *testing.T) {
+	ctx := context.Background()
+	c := client.NewMockClient()
+
+	err := Write(ctx, c, &Record{Key: "k", Type: "list", PTTL: -1, Value: []byte(`[]`)}, WriteOptions{})
+	assert.EqualError(t, err, `failed to write key "k": empty collection`)
+
+	err = Write(ctx, c, &Record{Key: "k", Type: "string", Base64: true, PTTL: -1, Value: []byte(`"%%"`)}, WriteOptions{})
+	assert.Error(t, err)
+
+	err = Write(ctx, c, &Record{Key: "k", Type: "module", PTTL: -1, Value: []byte(`null`)}, WriteOptions{})
+	assert.EqualError(t, err, `failed to write key "k": unsupported type "module"`)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	_, err := Decode(strings.NewReader(`{"key":"a","type":"string","pttl":-1,"value":"x"}` + "\n" + `{"key":"b"}`))
+	assert.EqualError(t, err, "invalid record 2: key and type are required")
+
+	_, err = Decode(strings.NewReader(`{"key":`))
+	assert.ErrorContains(t, err, "invalid record 1")
+
+	records, err := Decode(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
@@ -0,0 +1,148 @@
+// Package keyspace groups keys by name prefix and summarises the memory,
+// types, encodings and TTLs of each group. It backs the analysis of both a
+// live server and an RDB file.
+package keyspace
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultDelimiter separates the segments of a key prefix.
+const DefaultDelimiter = ":"
+
+// TTL bucket bounds in milliseconds.
+const (
+	hourMillis = 60 * 60 * 1000
+	dayMillis  = 24 * hourMillis
+)
+
+// Group summarises the keys sharing a prefix.
+type Group struct {
+	Prefix               string           `json:"prefix"`
+	Keys                 int64            `json:"keys"`
+	MemoryBytes          int64            `json:"memory_bytes"`
+	AvgMemoryBytes       int64            `json:"avg_memory_bytes"`
+	EstimatedKeys        int64            `json:"estimated_keys,omitempty"`
+	EstimatedMemoryBytes int64            `json:"estimated_memory_bytes,omitempty"`
+	Types                map[string]int64 `json:"types"`
+	Encodings            map[string]int64 `json:"encodings"`
+	TTL                  TTLBuckets       `json:"ttl"`
+}
+
+// TTLBuckets counts keys by remaining time to live.
+type TTLBuckets struct {
+	None      int64 `json:"no_ttl"`
+	UnderHour int64 `json:"under_1h"`
+	UnderDay  int64 `json:"under_1d"`
+	Longer    int64 `json:"over_1d"`
+}
+
+func (b *TTLBuckets) add(pttl int64) {
+	switch {
+	case pttl < 0:
+		b.None++
+	case pttl < hourMillis:
+		b.UnderHour++
+	case pttl < dayMillis:
+		b.UnderDay++
+	default:
+		b.Longer++
+	}
+}
+
+// Analyzer accumulates keys into groups.
+type Analyzer struct {
+	delimiter   string
+	depth       int
+	groups      map[string]*Group
+	keys        int64
+	memoryBytes int64
+}
+
+// NewAnalyzer groups keys by their first depth segments split on delimiter.
+// An empty delimiter means DefaultDelimiter and a depth below 1 means 1.
+func NewAnalyzer(delimiter string, depth int) *Analyzer {
+	if delimiter == "" {
+		delimiter = DefaultDelimiter
+	}
+	if depth < 1 {
+		depth = 1
+	}
+	return &Analyzer{delimiter: delimiter, depth: depth, groups: make(map[string]*Group)}
+}
+
+// Add records a key. pttl is the remaining time to live in milliseconds, or
+// negative for none.
+func (a *Analyzer) Add(key, keyType, encoding string, memoryBytes, pttl int64) {
+	prefix := GroupPrefix(key, a.delimiter, a.depth)
+	group, ok := a.groups[prefix]
+	if !ok {
+		group = &Group{Prefix: prefix, Types: map[string]int64{}, Encodings: map[string]int64{}}
+		a.groups[prefix] = group
+	}
+	group.Keys++
+	group.MemoryBytes += memoryBytes
+	group.Types[keyType]++
+	if encoding != "" {
+		group.Encodings[encoding]++
+	}
+	group.TTL.add(pttl)
+	a.keys++
+	a.memoryBytes += memoryBytes
+}
+
+// Keys returns the number of keys added.
+func (a *Analyzer) Keys() int64 {
+	return a.keys
+}
+
+// MemoryBytes returns the memory of all keys added.
+func (a *Analyzer) MemoryBytes() int64 {
+	return a.memoryBytes
+}
+
+// Groups returns at most limit groups sorted by memory, largest first, and
+// the number of groups left out. A scaleFactor above zero fills in the
+// estimates of each group. limit <= 0 means no limit.
+func (a *Analyzer) Groups(scaleFactor float64, limit int) ([]*Group, int) {
+	groups := make([]*Group, 0, len(a.groups))
+	for _, group := range a.groups {
+		group.AvgMemoryBytes = group.MemoryBytes / group.Keys
+		if scaleFactor > 0 {
+			group.EstimatedKeys = Scale(group.Keys, scaleFactor)
+			group.EstimatedMemoryBytes = Scale(group.MemoryBytes, scaleFactor)
+		}
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if a.MemoryBytes != b.MemoryBytes {
+			return a.MemoryBytes > b.MemoryBytes
+		}
+		return a.Prefix < b.Prefix
+	})
+	if limit > 0 && len(groups) > limit {
+		return groups[:limit], len(groups) - limit
+	}
+	return groups, 0
+}
+
+// GroupPrefix returns the first depth segments of key, each followed by
+// delimiter. The last segment, the key's own name, is never included.
+func GroupPrefix(key, delimiter string, depth int) string {
+	end := 0
+	for i := 0; i < depth; i++ {
+		idx := strings.Index(key[end:], delimiter)
+		if idx < 0 {
+			break
+		}
+		end += idx + len(delimiter)
+	}
+	return key[:end]
+}
+
+// Scale multiplies n by factor, rounding to the nearest integer.
+func Scale(n int64, factor float64) int64 {
+	return int64(float64(n)*factor + 0.5)
+}
@@ -0,0 +1,44 @@
+package keyspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzer_Groups(t *testing.T) {
+	a := NewAnalyzer("", 0)
+	a.Add("user:1", "hash", "listpack", 300, -1)
+	a.Add("user:2", "hash", "hashtable", 500, 90*60*1000)
+	a.Add("session:1", "string", "embstr", 100, 1000)
+	a.Add("counter", "string", "int", 100, 2*dayMillis)
+
+	assert.Equal(t, int64(4), a.Keys())
+	assert.Equal(t, int64(1000), a.MemoryBytes())
+
+	groups, omitted := a.Groups(2, 2)
+	assert.Equal(t, 1, omitted)
+	require.Len(t, groups, 2)
+
+	user := groups[0]
+	assert.Equal(t, "user:", user.Prefix)
+	assert.Equal(t, int64(400), user.AvgMemoryBytes)
+	assert.Equal(t, int64(4), user.EstimatedKeys)
+	assert.Equal(t, int64(1600), user.EstimatedMemoryBytes)
+	assert.Equal(t, map[string]int64{"hash": 2}, user.Types)
+	assert.Equal(t, map[string]int64{"listpack": 1, "hashtable": 1}, user.Encodings)
+	assert.Equal(t, TTLBuckets{None: 1, UnderDay: 1}, user.TTL)
+
+	// Equal memory sorts by prefix.
+	assert.Equal(t, "", groups[1].Prefix)
+	assert.Equal(t, TTLBuckets{Longer: 1}, groups[1].TTL)
+}
+
+func TestGroupPrefix(t *testing.T) {
+	assert.Equal(t, "user:", GroupPrefix("user:1:name", ":", 1))
+	assert.Equal(t, "user:1:", GroupPrefix("user:1:name", ":", 2))
+	assert.Equal(t, "user:1:", GroupPrefix("user:1:name", ":", 5))
+	assert.Equal(t, "", GroupPrefix("counter", ":", 1))
+	assert.Equal(t, "a::", GroupPrefix("a::b", "::", 1))
+}
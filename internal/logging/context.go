@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type loggerKey struct{}
+
+type sessionKey struct{}
+
+// WithLogger returns a context carrying logger.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, or slog.Default.
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// sessionFromContext returns the session whose request ctx belongs to.
+func sessionFromContext(ctx context.Context) *mcp.ServerSession {
+	ss, _ := ctx.Value(sessionKey{}).(*mcp.ServerSession)
+	return ss
+}
+
+// sessionIDs holds generated IDs of sessions whose transport has none (stdio).
+var sessionIDs sync.Map
+
+// sessionID returns the transport session ID of ss, or a generated one that
+// stays stable for the lifetime of the session.
+func sessionID(ss *mcp.ServerSession) string {
+	if ss == nil {
+		return ""
+	}
+	if id := ss.ID(); id != "" {
+		return id
+	}
+	if id, ok := sessionIDs.Load(ss); ok {
+		return id.(string)
+	}
+	id, loaded := sessionIDs.LoadOrStore(ss, newID())
+	if !loaded {
+		go func() {
+			_ = ss.Wait()
+			sessionIDs.Delete(ss)
+		}()
+	}
+	return id.(string)
+}
+
+// Middleware returns MCP receiving middleware that gives every request a
+// logger tagged with request_id, session_id and method. Handlers retrieve it
+// with FromContext; records logged with the request context are forwarded
+// only to the session that sent the request.
+func Middleware(logger *slog.Logger) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			ss, _ := req.GetSession().(*mcp.ServerSession)
+			reqLogger := logger.With(
+				"request_id", newID(),
+				"session_id", sessionID(ss),
+				"method", method,
+			)
+			ctx = WithLogger(ctx, reqLogger)
+			if ss != nil {
+				ctx = context.WithValue(ctx, sessionKey{}, ss)
+			}
+
+			start := time.Now()
+			result, err := next(ctx, method, req)
+			if err != nil {
+				reqLogger.DebugContext(ctx, "request failed", "duration", time.Since(start), "error", err)
+			} else {
+				reqLogger.DebugContext(ctx, "request handled", "duration", time.Since(start))
+			}
+			return result, err
+		}
+	}
+}
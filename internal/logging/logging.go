@@ -0,0 +1,207 @@
+// Package logging provides the server's structured logger. Records go to a
+// local handler (stderr) and are forwarded to MCP clients as
+// notifications/message, filtered by the level each client requested with
+// logging/setLevel.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Output formats of the local handler.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// LoggerName is the "logger" field of MCP log notifications.
+const LoggerName = "valkey-mcp-server"
+
+// ParseLevel parses a level name: debug, info, warn (or warning) or error.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("invalid log level %q: must be debug, info, warn or error", s)
+}
+
+// NewLocalHandler returns a text or JSON handler writing records at or above
+// level to w.
+func NewLocalHandler(w io.Writer, format string, level slog.Leveler) (slog.Handler, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	switch format {
+	case FormatText:
+		return slog.NewTextHandler(w, opts), nil
+	case FormatJSON:
+		return slog.NewJSONHandler(w, opts), nil
+	}
+	return nil, fmt.Errorf("invalid log format %q: must be %s or %s", format, FormatText, FormatJSON)
+}
+
+// Handler writes records to a local handler and forwards them to MCP clients.
+// Records logged with a request context (see Middleware) go to the session
+// that sent the request; all other records go to every connected session.
+type Handler struct {
+	local  slog.Handler
+	target *target
+	attrs  []slog.Attr
+	prefix string
+}
+
+// target is the MCP server whose sessions receive log notifications.
+type target struct {
+	mu     sync.Mutex
+	server *mcp.Server
+}
+
+// NewHandler wraps local. Records are forwarded to MCP clients once a server
+// is attached with Attach.
+func NewHandler(local slog.Handler) *Handler {
+	return &Handler{local: local, target: &target{}}
+}
+
+// Attach starts forwarding records to the sessions of server.
+func (h *Handler) Attach(server *mcp.Server) {
+	h.target.mu.Lock()
+	h.target.server = server
+	h.target.mu.Unlock()
+}
+
+func (h *Handler) server() *mcp.Server {
+	h.target.mu.Lock()
+	defer h.target.mu.Unlock()
+	return h.target.server
+}
+
+// Enabled implements slog.Handler. Clients choose their own level, so every
+// record is enabled once a server is attached.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.local.Enabled(ctx, level) || h.server() != nil
+}
+
+// Handle implements slog.Handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	var err error
+	if h.local.Enabled(ctx, r.Level) {
+		err = h.local.Handle(ctx, r)
+	}
+
+	server := h.server()
+	if server == nil {
+		return err
+	}
+	params := &mcp.LoggingMessageParams{
+		Level:  mcpLevel(r.Level),
+		Logger: LoggerName,
+		Data:   h.data(r),
+	}
+	// Log notifications must not be lost because the request that caused
+	// them has finished.
+	notifyCtx := context.WithoutCancel(ctx)
+	if ss := sessionFromContext(ctx); ss != nil {
+		_ = ss.Log(notifyCtx, params)
+		return err
+	}
+	for ss := range server.Sessions() {
+		_ = ss.Log(notifyCtx, params)
+	}
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.local = h.local.WithAttrs(attrs)
+	clone.attrs = append([]slog.Attr(nil), h.attrs...)
+	for _, a := range attrs {
+		clone.attrs = append(clone.attrs, slog.Attr{Key: h.prefix + a.Key, Value: a.Value})
+	}
+	return &clone
+}
+
+// WithGroup implements slog.Handler.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.local = h.local.WithGroup(name)
+	clone.prefix = h.prefix + name + "."
+	return &clone
+}
+
+// data renders a record as the JSON object sent to clients.
+func (h *Handler) data(r slog.Record) map[string]any {
+	data := map[string]any{"msg": r.Message}
+	for _, a := range h.attrs {
+		addAttr(data, "", a)
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		addAttr(data, h.prefix, a)
+		return true
+	})
+	return data
+}
+
+// addAttr stores a under prefix+key, flattening groups into dotted keys.
+func addAttr(data map[string]any, prefix string, a slog.Attr) {
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		for _, ga := range v.Group() {
+			addAttr(data, prefix+a.Key+".", ga)
+		}
+		return
+	}
+	if a.Key == "" {
+		return
+	}
+	switch v.Kind() {
+	case slog.KindDuration:
+		data[prefix+a.Key] = v.Duration().String()
+	case slog.KindTime:
+		data[prefix+a.Key] = v.Time().Format(time.RFC3339Nano)
+	default:
+		if err, ok := v.Any().(error); ok {
+			data[prefix+a.Key] = err.Error()
+		} else {
+			data[prefix+a.Key] = v.Any()
+		}
+	}
+}
+
+// mcpLevel maps a slog level to the closest MCP logging level.
+func mcpLevel(l slog.Level) mcp.LoggingLevel {
+	switch {
+	case l >= slog.LevelError:
+		return "error"
+	case l >= slog.LevelWarn:
+		return "warning"
+	case l >= slog.LevelInfo:
+		return "info"
+	}
+	return "debug"
+}
+
+// newID returns a random identifier for correlating log records.
+func newID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,146 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevel(t *testing.T) {
+	for in, want := range map[string]slog.Level{
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+	} {
+		got, err := ParseLevel(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseLevel("verbose")
+	assert.Error(t, err)
+}
+
+func TestNewLocalHandler(t *testing.T) {
+	var buf bytes.Buffer
+	h, err := NewLocalHandler(&buf, FormatJSON, slog.LevelInfo)
+	require.NoError(t, err)
+	slog.New(h).Debug("hidden")
+	slog.New(h).Info("shown", "key", "value")
+	assert.NotContains(t, buf.String(), "hidden")
+	assert.Contains(t, buf.String(), `"key":"value"`)
+
+	_, err = NewLocalHandler(&buf, "xml", slog.LevelInfo)
+	assert.Error(t, err)
+}
+
+// testSession connects a client to server and returns it along with a channel
+// receiving its log notifications.
+func testSession(t *testing.T, server *mcp.Server) (*mcp.ClientSession, chan *mcp.LoggingMessageParams) {
+	t.Helper()
+	ctx := context.Background()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { serverSession.Close() })
+
+	messages := make(chan *mcp.LoggingMessageParams, 10)
+	c := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, &mcp.ClientOptions{
+		LoggingMessageHandler: func(ctx context.Context, req *mcp.LoggingMessageRequest) {
+			messages <- req.Params
+		},
+	})
+	session, err := c.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { session.Close() })
+	return session, messages
+}
+
+func receive(t *testing.T, messages chan *mcp.LoggingMessageParams) *mcp.LoggingMessageParams {
+	t.Helper()
+	select {
+	case msg := <-messages:
+		return msg
+	case <-time.After(2 * time.Second):
+		t.Fatal("no log notification received")
+		return nil
+	}
+}
+
+func assertNone(t *testing.T, messages chan *mcp.LoggingMessageParams) {
+	t.Helper()
+	select {
+	case msg := <-messages:
+		t.Fatalf("unexpected log notification: %v", msg.Data)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHandler_ForwardsAtClientLevel(t *testing.T) {
+	var buf bytes.Buffer
+	local, err := NewLocalHandler(&buf, FormatText, slog.LevelError)
+	require.NoError(t, err)
+	h := NewHandler(local)
+	logger := slog.New(h)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	h.Attach(server)
+	session, messages := testSession(t, server)
+
+	// Nothing is sent before the client picks a level.
+	logger.Warn("before")
+	assertNone(t, messages)
+
+	require.NoError(t, session.SetLoggingLevel(context.Background(), &mcp.SetLoggingLevelParams{Level: "warning"}))
+	logger.Info("filtered")
+	logger.WithGroup("conn").Warn("slow", "duration", 2*time.Second, "error", errors.New("boom"))
+
+	msg := receive(t, messages)
+	assert.Equal(t, mcp.LoggingLevel("warning"), msg.Level)
+	assert.Equal(t, LoggerName, msg.Logger)
+	assert.Equal(t, map[string]any{"msg": "slow", "conn.duration": "2s", "conn.error": "boom"}, msg.Data)
+	assertNone(t, messages)
+
+	// The local handler applies its own level independently.
+	assert.NotContains(t, buf.String(), "slow")
+}
+
+func TestMiddleware_RoutesRequestLogsToSession(t *testing.T) {
+	local, err := NewLocalHandler(&bytes.Buffer{}, FormatText, slog.LevelInfo)
+	require.NoError(t, err)
+	h := NewHandler(local)
+	logger := slog.New(h)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	mcp.AddTool(server, &mcp.Tool{Name: "work"}, func(ctx context.Context, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		FromContext(ctx).ErrorContext(ctx, "work failed")
+		return &mcp.CallToolResult{}, nil, nil
+	})
+	server.AddReceivingMiddleware(Middleware(logger))
+	h.Attach(server)
+
+	caller, callerMessages := testSession(t, server)
+	other, otherMessages := testSession(t, server)
+	ctx := context.Background()
+	require.NoError(t, caller.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "info"}))
+	require.NoError(t, other.SetLoggingLevel(ctx, &mcp.SetLoggingLevelParams{Level: "info"}))
+
+	_, err = caller.CallTool(ctx, &mcp.CallToolParams{Name: "work", Arguments: map[string]any{}})
+	require.NoError(t, err)
+
+	msg := receive(t, callerMessages)
+	data := msg.Data.(map[string]any)
+	assert.Equal(t, "work failed", data["msg"])
+	assert.Equal(t, "tools/call", data["method"])
+	assert.NotEmpty(t, data["request_id"])
+	assert.NotEmpty(t, data["session_id"])
+	assertNone(t, otherMessages)
+}
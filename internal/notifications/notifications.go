@@ -0,0 +1,80 @@
+// Package notifications shares notify-keyspace-events between the users of
+// keyspace notifications, so that one of them turning notifications back off
+// does not cut off the others.
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// Parameter is the configuration parameter selecting the notifications the
+// server publishes.
+const Parameter = "notify-keyspace-events"
+
+// Leases counts the active users of keyspace notifications on one server.
+// A value set with Enable is restored once the last lease is released.
+type Leases struct {
+	client client.ValkeyClient
+
+	mu     sync.Mutex
+	active int
+	// original is the value Enable replaced, or nil when Parameter was not
+	// changed.
+	original *string
+}
+
+// New creates the leases of the server behind c.
+func New(c client.ValkeyClient) *Leases {
+	return &Leases{client: c}
+}
+
+// Acquire registers a user of keyspace notifications. Call the returned
+// function once when done; the last release restores Parameter if Enable
+// changed it, and returns the error of doing so.
+func (l *Leases) Acquire() func(ctx context.Context) error {
+	l.mu.Lock()
+	l.active++
+	l.mu.Unlock()
+
+	var once sync.Once
+	return func(ctx context.Context) (err error) {
+		once.Do(func() { err = l.release(ctx) })
+		return err
+	}
+}
+
+func (l *Leases) release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.active--
+	if l.active > 0 || l.original == nil {
+		return nil
+	}
+	original := *l.original
+	l.original = nil
+	if _, err := l.client.ConfigSet(ctx, Parameter, original); err != nil {
+		return fmt.Errorf("failed to restore %s to %q: %w", Parameter, original, err)
+	}
+	return nil
+}
+
+// Enable sets Parameter to flags, replacing current. The caller must hold a
+// lease. When another lease already enabled notifications the value to
+// restore stays the one that lease replaced.
+func (l *Leases) Enable(ctx context.Context, current, flags string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.client.ConfigSet(ctx, Parameter, flags); err != nil {
+		return fmt.Errorf("failed to enable keyspace notifications: %w", err)
+	}
+	if l.original == nil {
+		l.original = &current
+	}
+	return nil
+}
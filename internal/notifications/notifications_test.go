@@ -0,0 +1,69 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+func value(t *testing.T, c client.ValkeyClient) string {
+	t.Helper()
+	config, err := c.ConfigGet(context.Background(), Parameter)
+	require.NoError(t, err)
+	return config[Parameter]
+}
+
+func TestLeases_LastReleaseRestores(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.ConfigSet(ctx, Parameter, "")
+	require.NoError(t, err)
+	l := New(mockClient)
+
+	first := l.Acquire()
+	require.NoError(t, l.Enable(ctx, "", "KA"))
+	// A second user finds notifications on and enables nothing.
+	second := l.Acquire()
+	third := l.Acquire()
+	require.NoError(t, l.Enable(ctx, "KA", "KA"))
+
+	require.NoError(t, first(ctx))
+	require.NoError(t, first(ctx))
+	assert.Equal(t, "KA", value(t, mockClient))
+	require.NoError(t, second(ctx))
+	assert.Equal(t, "KA", value(t, mockClient))
+	require.NoError(t, third(ctx))
+	assert.Equal(t, "", value(t, mockClient))
+
+	// Leases that never enabled leave the value alone.
+	_, err = mockClient.ConfigSet(ctx, Parameter, "Eg")
+	require.NoError(t, err)
+	require.NoError(t, l.Acquire()(ctx))
+	assert.Equal(t, "Eg", value(t, mockClient))
+}
+
+// deniedClient refuses CONFIG SET.
+type deniedClient struct {
+	*client.MockClient
+}
+
+var errDenied = errors.New("ERR denied")
+
+func (c deniedClient) ConfigSet(ctx context.Context, parameter, value string) (bool, error) {
+	return false, errDenied
+}
+
+func TestLeases_Errors(t *testing.T) {
+	ctx := context.Background()
+	l := New(deniedClient{client.NewMockClient()})
+
+	release := l.Acquire()
+	assert.ErrorIs(t, l.Enable(ctx, "", "KA"), errDenied)
+	// Nothing was changed, so there is nothing to restore.
+	assert.NoError(t, release(ctx))
+}
@@ -0,0 +1,235 @@
+// Package policy asks the user to confirm destructive tool calls before they
+// run. Clients that support MCP elicitation are asked directly; other clients
+// receive a confirm token that must be sent back with an identical call.
+package policy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+)
+
+// DefaultMaxKeys is the suggested Config.MaxKeys.
+const DefaultMaxKeys = 10
+
+// DefaultTokenTTL is used when Config.TokenTTL is zero.
+const DefaultTokenTTL = 5 * time.Minute
+
+// Config controls which calls need confirmation.
+type Config struct {
+	// MaxKeys is the number of keys a call may delete or modify without
+	// confirmation. Zero makes every call that affects a key need confirmation.
+	MaxKeys int
+	// ProtectedPatterns are glob patterns of keys whose changes always need
+	// confirmation.
+	ProtectedPatterns []string
+	// TokenTTL is how long a confirm token stays valid. Zero means
+	// DefaultTokenTTL.
+	TokenTTL time.Duration
+}
+
+// Policy implements registry.Guard.
+type Policy struct {
+	maxKeys   int
+	protected []string
+	tokenTTL  time.Duration
+	now       func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]pendingCall
+}
+
+// pendingCall is a call awaiting confirmation through a confirm token.
+type pendingCall struct {
+	tool    string
+	digest  string
+	expires time.Time
+}
+
+// ConfirmationRequired is returned instead of running a destructive call when
+// the client cannot be asked through elicitation.
+type ConfirmationRequired struct {
+	ConfirmationRequired bool     `json:"confirmation_required"`
+	Tool                 string   `json:"tool"`
+	Summary              string   `json:"summary"`
+	Reasons              []string `json:"reasons"`
+	ConfirmToken         string   `json:"confirm_token"`
+	ExpiresInSeconds     int      `json:"expires_in_seconds"`
+	Instructions         string   `json:"instructions"`
+}
+
+// New creates a confirmation policy.
+func New(cfg Config) *Policy {
+	if cfg.TokenTTL <= 0 {
+		cfg.TokenTTL = DefaultTokenTTL
+	}
+	return &Policy{
+		maxKeys:   cfg.MaxKeys,
+		protected: cfg.ProtectedPatterns,
+		tokenTTL:  cfg.TokenTTL,
+		now:       time.Now,
+		tokens:    make(map[string]pendingCall),
+	}
+}
+
+// Check implements registry.Guard.
+func (p *Policy) Check(ctx context.Context, call *registry.GuardedCall) (interface{}, error) {
+	reasons := p.classify(call.Impact)
+	if len(reasons) == 0 {
+		return nil, nil
+	}
+
+	digest := inputDigest(call.Input)
+	if call.ConfirmToken != "" {
+		if p.redeem(call.ConfirmToken, call.Tool, digest) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: confirm token is invalid, expired or was issued for different arguments: %w", call.Tool, registry.ErrPolicyDenied)
+	}
+
+	if supportsElicitation(call.Session) {
+		confirmed, err := elicit(ctx, call.Session, call.Impact.Summary, reasons)
+		if err == nil {
+			if confirmed {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("%s: user declined: %w", call.Tool, registry.ErrPolicyDenied)
+		}
+		// The client advertised elicitation but could not answer; fall back
+		// to the confirm token.
+	}
+
+	return &ConfirmationRequired{
+		ConfirmationRequired: true,
+		Tool:                 call.Tool,
+		Summary:              call.Impact.Summary,
+		Reasons:              reasons,
+		ConfirmToken:         p.issue(call.Tool, digest),
+		ExpiresInSeconds:     int(p.tokenTTL.Seconds()),
+		Instructions:         fmt.Sprintf("Show the summary to the user. If they agree, call %s again with the same arguments plus %q set to the token.", call.Tool, registry.ConfirmTokenArgument),
+	}, nil
+}
+
+// classify returns why the impact needs confirmation, or nil if it does not.
+func (p *Policy) classify(impact *registry.Impact) []string {
+	var reasons []string
+	if n := len(impact.Keys); n > p.maxKeys {
+		reasons = append(reasons, fmt.Sprintf("affects %d keys (more than %d)", n, p.maxKeys))
+	}
+	if protected := p.protectedKeys(impact); len(protected) > 0 {
+		reasons = append(reasons, "touches protected keys: "+strings.Join(protected, ", "))
+	}
+	if len(impact.Overwrites) > 0 {
+		reasons = append(reasons, "overwrites existing keys: "+strings.Join(impact.Overwrites, ", "))
+	}
+	if len(impact.ConfigParameters) > 0 {
+		reasons = append(reasons, "changes configuration: "+strings.Join(impact.ConfigParameters, ", "))
+	}
+	return reasons
+}
+
+// protectedKeys returns the affected keys that match a protected pattern.
+func (p *Policy) protectedKeys(impact *registry.Impact) []string {
+	var matched []string
+	seen := make(map[string]bool)
+	for _, key := range append(append([]string(nil), impact.Keys...), impact.Overwrites...) {
+		if seen[key] {
+			continue
+		}
+		for _, pattern := range p.protected {
+			if ok, _ := path.Match(pattern, key); ok {
+				matched = append(matched, key)
+				seen[key] = true
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// issue stores a pending call and returns its confirm token.
+func (p *Policy) issue(tool, digest string) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	token := hex.EncodeToString(b)
+
+	now := p.now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for t, pending := range p.tokens {
+		if !now.Before(pending.expires) {
+			delete(p.tokens, t)
+		}
+	}
+	p.tokens[token] = pendingCall{tool: tool, digest: digest, expires: now.Add(p.tokenTTL)}
+	return token
+}
+
+// redeem consumes token if it was issued for this exact call and is still valid.
+func (p *Policy) redeem(token, tool, digest string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, ok := p.tokens[token]
+	if !ok {
+		return false
+	}
+	delete(p.tokens, token)
+	return pending.tool == tool && pending.digest == digest && p.now().Before(pending.expires)
+}
+
+// inputDigest hashes the call arguments in a canonical form, so that argument
+// order does not matter.
+func inputDigest(input json.RawMessage) string {
+	canonical := []byte(input)
+	var decoded interface{}
+	if len(input) > 0 && json.Unmarshal(input, &decoded) == nil {
+		canonical, _ = json.Marshal(decoded)
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])
+}
+
+// supportsElicitation reports whether the client can answer form elicitations.
+func supportsElicitation(ss *mcp.ServerSession) bool {
+	if ss == nil {
+		return false
+	}
+	params := ss.InitializeParams()
+	return params != nil && params.Capabilities != nil && params.Capabilities.Elicitation != nil
+}
+
+// elicit asks the user to confirm and reports whether they did.
+func elicit(ctx context.Context, ss *mcp.ServerSession, summary string, reasons []string) (bool, error) {
+	res, err := ss.Elicit(ctx, &mcp.ElicitParams{
+		Message: fmt.Sprintf("%s\n\nConfirmation required: %s.", summary, strings.Join(reasons, "; ")),
+		RequestedSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"confirm": map[string]interface{}{
+					"type":        "boolean",
+					"title":       "Proceed",
+					"description": "Run this operation",
+				},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if res.Action != "accept" {
+		return false, nil
+	}
+	confirmed, _ := res.Content["confirm"].(bool)
+	return confirmed, nil
+}
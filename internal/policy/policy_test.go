@@ -0,0 +1,167 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+)
+
+// wipeTool is a destructive tool that records whether it ran.
+type wipeTool struct {
+	ran bool
+}
+
+func (w *wipeTool) Name() string             { return "wipe" }
+func (w *wipeTool) Description() string      { return "Delete keys" }
+func (w *wipeTool) InputSchema() interface{} { return nil }
+
+func (w *wipeTool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	w.ran = true
+	return map[string]interface{}{"deleted": true}, nil
+}
+
+func (w *wipeTool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params struct {
+		Keys []string `json:"keys"`
+	}
+	if err := json.Unmarshal(input, &params); err != nil {
+		return nil, err
+	}
+	return &registry.Impact{Summary: "Delete keys", Keys: params.Keys}, nil
+}
+
+// connect serves a registry holding tool behind p. elicit, when non-nil,
+// answers elicitation requests and makes the client advertise support.
+func connect(t *testing.T, p *Policy, tool registry.Tool, elicit func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error)) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+
+	reg := registry.NewToolRegistry()
+	reg.MustRegister(tool)
+	reg.SetGuard(p)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	require.NoError(t, reg.RegisterWithMCP(server))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { serverSession.Close() })
+
+	c := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, &mcp.ClientOptions{ElicitationHandler: elicit})
+	session, err := c.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func callWipe(t *testing.T, session *mcp.ClientSession, args map[string]any) (*mcp.CallToolResult, error) {
+	t.Helper()
+	return session.CallTool(context.Background(), &mcp.CallToolParams{Name: "wipe", Arguments: args})
+}
+
+func TestPolicy_AllowsSmallCalls(t *testing.T) {
+	tool := &wipeTool{}
+	session := connect(t, New(Config{MaxKeys: 2}), tool, nil)
+
+	res, err := callWipe(t, session, map[string]any{"keys": []string{"a"}})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.True(t, tool.ran)
+}
+
+func TestPolicy_ConfirmTokenFlow(t *testing.T) {
+	tool := &wipeTool{}
+	session := connect(t, New(Config{MaxKeys: 1}), tool, nil)
+	args := map[string]any{"keys": []string{"a", "b"}}
+
+	res, err := callWipe(t, session, args)
+	require.NoError(t, err)
+	assert.False(t, tool.ran)
+	structured := res.StructuredContent.(map[string]any)
+	assert.Equal(t, true, structured["confirmation_required"])
+	token, _ := structured["confirm_token"].(string)
+	require.NotEmpty(t, token)
+
+	// A token only confirms the exact call it was issued for.
+	res, err = callWipe(t, session, map[string]any{"keys": []string{"a", "c"}, registry.ConfirmTokenArgument: token})
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.False(t, tool.ran)
+
+	res, err = callWipe(t, session, args)
+	require.NoError(t, err)
+	token = res.StructuredContent.(map[string]any)["confirm_token"].(string)
+
+	res, err = callWipe(t, session, map[string]any{"keys": []string{"a", "b"}, registry.ConfirmTokenArgument: token})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.True(t, tool.ran)
+
+	// Tokens are single use.
+	tool.ran = false
+	res, err = callWipe(t, session, map[string]any{"keys": []string{"a", "b"}, registry.ConfirmTokenArgument: token})
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.False(t, tool.ran)
+}
+
+func TestPolicy_ElicitationAccepted(t *testing.T) {
+	tool := &wipeTool{}
+	var message string
+	session := connect(t, New(Config{MaxKeys: 10, ProtectedPatterns: []string{"billing:*"}}), tool,
+		func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			message = req.Params.Message
+			return &mcp.ElicitResult{Action: "accept", Content: map[string]any{"confirm": true}}, nil
+		})
+
+	res, err := callWipe(t, session, map[string]any{"keys": []string{"billing:1"}})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.True(t, tool.ran)
+	assert.Contains(t, message, "protected keys: billing:1")
+}
+
+func TestPolicy_ElicitationDeclined(t *testing.T) {
+	tool := &wipeTool{}
+	session := connect(t, New(Config{MaxKeys: 0}), tool,
+		func(ctx context.Context, req *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			return &mcp.ElicitResult{Action: "decline"}, nil
+		})
+
+	res, err := callWipe(t, session, map[string]any{"keys": []string{"a"}})
+	require.NoError(t, err)
+	assert.True(t, res.IsError)
+	assert.False(t, tool.ran)
+}
+
+func TestPolicy_Classify(t *testing.T) {
+	p := New(Config{MaxKeys: 5, ProtectedPatterns: []string{"session:*"}})
+
+	assert.Empty(t, p.classify(&registry.Impact{Keys: []string{"a"}}))
+	assert.Len(t, p.classify(&registry.Impact{Keys: []string{"a", "b", "c", "d", "e", "f"}}), 1)
+	assert.Equal(t, []string{"touches protected keys: session:1"}, p.classify(&registry.Impact{Keys: []string{"session:1"}}))
+	assert.Equal(t, []string{"overwrites existing keys: b"}, p.classify(&registry.Impact{Keys: []string{"a", "b"}, Overwrites: []string{"b"}}))
+	assert.Equal(t, []string{"changes configuration: maxmemory"}, p.classify(&registry.Impact{ConfigParameters: []string{"maxmemory"}}))
+}
+
+func TestPolicy_TokenExpires(t *testing.T) {
+	p := New(Config{TokenTTL: time.Minute})
+	now := time.Unix(1000, 0)
+	p.now = func() time.Time { return now }
+
+	token := p.issue("wipe", "digest")
+	now = now.Add(2 * time.Minute)
+	assert.False(t, p.redeem(token, "wipe", "digest"))
+}
+
+func TestInputDigest_IgnoresArgumentOrder(t *testing.T) {
+	assert.Equal(t, inputDigest(json.RawMessage(`{"a":1,"b":2}`)), inputDigest(json.RawMessage(`{"b":2,"a":1}`)))
+	assert.NotEqual(t, inputDigest(json.RawMessage(`{"a":1}`)), inputDigest(json.RawMessage(`{"a":2}`)))
+}
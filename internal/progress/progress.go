@@ -0,0 +1,60 @@
+// Package progress lets long-running tools report how far they have got.
+// Reports are delivered as MCP notifications/progress when the client asked
+// for them by sending a progress token, and dropped otherwise.
+package progress
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// minInterval is the minimum time between two notifications for one request.
+// Final reports (processed >= total) are always sent.
+const minInterval = 100 * time.Millisecond
+
+// Reporter receives the progress of one operation. total is zero when unknown.
+type Reporter func(ctx context.Context, processed, total float64, message string)
+
+type reporterKey struct{}
+
+// WithReporter returns a context carrying r.
+func WithReporter(ctx context.Context, r Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey{}, r)
+}
+
+// Report sends progress to the reporter carried by ctx, if any.
+func Report(ctx context.Context, processed, total float64, message string) {
+	if r, ok := ctx.Value(reporterKey{}).(Reporter); ok && r != nil {
+		r(ctx, processed, total, message)
+	}
+}
+
+// ForSession returns a Reporter that sends notifications/progress for token to
+// ss, at most once per minInterval.
+func ForSession(ss *mcp.ServerSession, token any) Reporter {
+	var (
+		mu   sync.Mutex
+		last time.Time
+	)
+	return func(ctx context.Context, processed, total float64, message string) {
+		final := total > 0 && processed >= total
+		mu.Lock()
+		now := time.Now()
+		if !final && now.Sub(last) < minInterval {
+			mu.Unlock()
+			return
+		}
+		last = now
+		mu.Unlock()
+
+		_ = ss.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+			ProgressToken: token,
+			Progress:      processed,
+			Total:         total,
+			Message:       message,
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package progress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport_WithoutReporter(t *testing.T) {
+	assert.NotPanics(t, func() { Report(context.Background(), 1, 2, "") })
+}
+
+func TestReport_WithReporter(t *testing.T) {
+	var got []float64
+	ctx := WithReporter(context.Background(), func(ctx context.Context, processed, total float64, message string) {
+		got = append(got, processed, total)
+	})
+	Report(ctx, 3, 9, "working")
+	assert.Equal(t, []float64{3, 9}, got)
+}
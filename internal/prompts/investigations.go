@@ -0,0 +1,184 @@
+package prompts
+
+import (
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func diagnoseHighMemory() *Prompt {
+	return &Prompt{
+		Name:        "diagnose_high_memory",
+		Title:       "Diagnose high memory",
+		Description: "Find out what is using memory and whether the instance is at risk of evictions or OOM errors",
+		Arguments:   []*mcp.PromptArgument{keyPatternArg, timeWindowArg, sampleSizeArg},
+		build: func(args arguments) (*workflow, error) {
+			window, err := args.timeWindow()
+			if err != nil {
+				return nil, err
+			}
+			sample, err := args.sampleSize()
+			if err != nil {
+				return nil, err
+			}
+			pattern := args.get(argKeyPattern, defaultKeyPattern)
+
+			return &workflow{
+				Goal: fmt.Sprintf("Diagnose high memory usage on this Valkey instance, focusing on keys matching %q and on changes during the last %s.", pattern, window),
+				Steps: []step{
+					{Tool: "server_info", Purpose: "read used_memory, used_memory_peak, used_memory_rss, mem_fragmentation_ratio, evicted_keys and the keyspace section"},
+					{Tool: "config_get", Args: map[string]any{"parameter": "maxmemory"}, Purpose: "compare the limit with used_memory (0 means unlimited)"},
+					{Tool: "config_get", Args: map[string]any{"parameter": "maxmemory-policy"}, Purpose: "check what happens when the limit is reached"},
+					{Tool: "dbsize", Purpose: "get the total key count to put the sample in proportion"},
+					{Tool: "scan_keys", Args: map[string]any{"pattern": pattern, "count": sample}, Purpose: "collect a sample of keys"},
+					{Tool: "memory_usage", Args: map[string]any{"key": "<each sampled key>"}, Purpose: "measure the sampled keys and group them by prefix"},
+					{Tool: "object_encoding", Args: map[string]any{"key": "<largest keys>"}, Purpose: "spot large keys stored in memory-hungry encodings (hashtable, skiplist) instead of compact ones (listpack, intset)"},
+					{Tool: "get_key_ttl", Args: map[string]any{"key": "<largest keys>"}, Purpose: "check whether the largest keys ever expire"},
+				},
+				Conclusion: fmt.Sprintf("Summarise: current vs. maximum memory, whether fragmentation (ratio well above 1.5) or data explains the usage, the key prefixes consuming the most memory extrapolated from the sample to the full key count, and concrete remediations. State what changed within the last %s if the data shows it.", window),
+			}, nil
+		},
+	}
+}
+
+func investigateLatencySpike() *Prompt {
+	return &Prompt{
+		Name:        "investigate_latency_spike",
+		Title:       "Investigate latency spike",
+		Description: "Correlate slow commands, client load and server state to explain a latency spike",
+		Arguments:   []*mcp.PromptArgument{timeWindowArg, keyPatternArg},
+		build: func(args arguments) (*workflow, error) {
+			window, err := args.timeWindow()
+			if err != nil {
+				return nil, err
+			}
+			pattern := args.get(argKeyPattern, defaultKeyPattern)
+
+			return &workflow{
+				Goal: fmt.Sprintf("Investigate a latency spike that happened within the last %s, paying special attention to keys matching %q.", window, pattern),
+				Steps: []step{
+					{Tool: "slowlog_get", Args: map[string]any{"count": 128}, Purpose: fmt.Sprintf("list slow commands; keep only entries whose timestamp falls within the last %s", window)},
+					{Tool: "config_get", Args: map[string]any{"parameter": "slowlog-log-slower-than"}, Purpose: "know the threshold (microseconds) the slowlog uses"},
+					{Tool: "server_info", Purpose: "read instantaneous_ops_per_sec, connected_clients, blocked_clients, latest_fork_usec, rdb_bgsave_in_progress and aof_rewrite_in_progress"},
+					{Tool: "client_list", Purpose: "look for clients with large output buffers, long-running commands or a surge in connections"},
+					{Tool: "memory_usage", Args: map[string]any{"key": "<keys named in slow commands>"}, Purpose: "check whether slow commands hit unusually large keys"},
+					{Tool: "object_encoding", Args: map[string]any{"key": "<keys named in slow commands>"}, Purpose: "see whether those keys have outgrown compact encodings"},
+				},
+				Conclusion: "Summarise the most likely cause (expensive commands such as KEYS or large range reads, big keys, persistence forks, client load or blocked clients), the evidence for it, and how to prevent it from recurring.",
+			}, nil
+		},
+	}
+}
+
+func auditKeyTTLHygiene() *Prompt {
+	return &Prompt{
+		Name:        "audit_key_ttl_hygiene",
+		Title:       "Audit key TTL hygiene",
+		Description: "Check which keys never expire and whether stale keys are piling up",
+		Arguments:   []*mcp.PromptArgument{keyPatternArg, sampleSizeArg, timeWindowArg},
+		build: func(args arguments) (*workflow, error) {
+			window, err := args.timeWindow()
+			if err != nil {
+				return nil, err
+			}
+			sample, err := args.sampleSize()
+			if err != nil {
+				return nil, err
+			}
+			pattern := args.get(argKeyPattern, defaultKeyPattern)
+
+			return &workflow{
+				Goal: fmt.Sprintf("Audit TTL hygiene for keys matching %q. Treat keys idle for longer than %s as stale.", pattern, window),
+				Steps: []step{
+					{Tool: "server_info", Purpose: "read the keyspace section to compare keys with expires for the current database"},
+					{Tool: "config_get", Args: map[string]any{"parameter": "maxmemory-policy"}, Purpose: "note whether eviction only considers keys with a TTL (volatile-*)"},
+					{Tool: "scan_keys", Args: map[string]any{"pattern": pattern, "count": sample}, Purpose: "collect a sample of keys"},
+					{Tool: "get_key_ttl", Args: map[string]any{"key": "<each sampled key>"}, Purpose: "bucket keys into no TTL, under 1 hour, under 1 day and longer"},
+					{Tool: "object_idletime", Args: map[string]any{"key": "<keys without TTL>"}, Purpose: fmt.Sprintf("find keys without a TTL that have not been accessed for more than %s", window)},
+					{Tool: "memory_usage", Args: map[string]any{"key": "<stale keys>"}, Purpose: "estimate how much memory the stale keys hold"},
+				},
+				Conclusion: "Report the TTL distribution per key prefix, the prefixes that should have a TTL but do not, the memory held by stale keys, and whether the eviction policy can reclaim them.",
+			}, nil
+		},
+	}
+}
+
+func reviewClusterHealth() *Prompt {
+	return &Prompt{
+		Name:        "review_cluster_health",
+		Title:       "Review cluster health",
+		Description: "Check slot coverage, node failures and replication across the cluster",
+		Arguments: []*mcp.PromptArgument{
+			{
+				Name:        argKey,
+				Title:       "Key",
+				Description: "Optional key whose slot routing should be checked",
+			},
+			timeWindowArg,
+		},
+		build: func(args arguments) (*workflow, error) {
+			window, err := args.timeWindow()
+			if err != nil {
+				return nil, err
+			}
+
+			steps := []step{
+				{Tool: "cluster_info", Purpose: "check cluster_state, cluster_slots_assigned, cluster_slots_ok, cluster_slots_pfail, cluster_slots_fail and cluster_known_nodes"},
+				{Tool: "cluster_nodes", Purpose: "find nodes flagged fail or pfail, masters without slots, and masters without replicas"},
+				{Tool: "server_info", Purpose: "read the replication section: role, connected_slaves and replication offsets"},
+			}
+			if key := args.get(argKey, ""); key != "" {
+				steps = append(steps,
+					step{Tool: "cluster_keyslot", Args: map[string]any{"key": key}, Purpose: "compute the slot of the key"},
+					step{Tool: "cluster_count_keysinslot", Args: map[string]any{"slot": "<slot from the previous step>"}, Purpose: "confirm the slot is served and populated"},
+				)
+			}
+
+			return &workflow{
+				Goal:       fmt.Sprintf("Review the health of this Valkey cluster and look for failovers or topology changes during the last %s.", window),
+				Steps:      steps,
+				Conclusion: "Summarise overall state, uncovered or failing slots, unhealthy nodes, replication gaps and uneven slot distribution, and recommend the next actions in order of urgency.",
+			}, nil
+		},
+	}
+}
+
+func explainKey(keyURI func(key string) string) *Prompt {
+	return &Prompt{
+		Name:        "explain_key",
+		Title:       "Explain this key",
+		Description: "Describe a key: its type, size, encoding, expiry, access pattern and content",
+		Arguments: []*mcp.PromptArgument{
+			{
+				Name:        argKey,
+				Title:       "Key",
+				Description: "Key to explain",
+				Required:    true,
+			},
+		},
+		build: func(args arguments) (*workflow, error) {
+			key := args.get(argKey, "")
+			keyArgs := map[string]any{"key": key}
+
+			wf := &workflow{
+				Goal: fmt.Sprintf("Explain the key %q to an engineer who has never seen it.", key),
+				Steps: []step{
+					{Tool: "get_key_type", Args: keyArgs, Purpose: "learn the data type"},
+					{Tool: "get_key_ttl", Args: keyArgs, Purpose: "check whether and when it expires"},
+					{Tool: "object_encoding", Args: keyArgs, Purpose: "see the internal encoding"},
+					{Tool: "object_idletime", Args: keyArgs, Purpose: "see how recently it was accessed"},
+					{Tool: "memory_usage", Args: keyArgs, Purpose: "measure its memory footprint"},
+				},
+				Conclusion: "Then read a bounded part of its value with the tool matching its type (for example get_string, get_hash, lrange_list, get_set_members or xrange_stream) and explain what the key most likely stores, who writes it, whether its size and TTL look healthy, and anything surprising.",
+			}
+			if keyURI != nil {
+				wf.Resource = &mcp.ResourceLink{
+					URI:      keyURI(key),
+					Name:     key,
+					MIMEType: "application/json",
+				}
+			}
+			return wf, nil
+		},
+	}
+}
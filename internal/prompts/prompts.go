@@ -0,0 +1,181 @@
+// Package prompts provides guided MCP prompts for common Valkey investigations.
+// Each prompt assembles a sequence of existing tool calls into a workflow the
+// agent follows step by step.
+package prompts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Argument names shared by several prompts.
+const (
+	argKeyPattern = "key_pattern"
+	argTimeWindow = "time_window"
+	argKey        = "key"
+	argSampleSize = "sample_size"
+)
+
+// Defaults applied when optional arguments are omitted.
+const (
+	defaultKeyPattern = "*"
+	defaultTimeWindow = "1h"
+	defaultSampleSize = "100"
+)
+
+// Prompt is a single guided investigation.
+type Prompt struct {
+	Name        string
+	Title       string
+	Description string
+	Arguments   []*mcp.PromptArgument
+	// build turns the prompt arguments into a workflow.
+	build func(args arguments) (*workflow, error)
+}
+
+// workflow is the investigation rendered into the prompt message.
+type workflow struct {
+	Goal       string
+	Steps      []step
+	Conclusion string
+	// Resource optionally links a key resource the agent should read.
+	Resource *mcp.ResourceLink
+}
+
+// step is one tool call in a workflow.
+type step struct {
+	Tool    string
+	Args    map[string]any
+	Purpose string
+}
+
+// arguments holds prompt arguments with defaults applied.
+type arguments map[string]string
+
+// get returns the argument value, or def if it is missing or empty.
+func (a arguments) get(name, def string) string {
+	if v := strings.TrimSpace(a[name]); v != "" {
+		return v
+	}
+	return def
+}
+
+// timeWindow returns the validated time_window argument.
+func (a arguments) timeWindow() (string, error) {
+	window := a.get(argTimeWindow, defaultTimeWindow)
+	if _, err := time.ParseDuration(window); err != nil {
+		return "", fmt.Errorf("invalid %s %q: use a duration such as 15m or 2h", argTimeWindow, window)
+	}
+	return window, nil
+}
+
+// sampleSize returns the validated sample_size argument.
+func (a arguments) sampleSize() (int, error) {
+	raw := a.get(argSampleSize, defaultSampleSize)
+	var n int
+	if _, err := fmt.Sscanf(raw, "%d", &n); err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive integer", argSampleSize, raw)
+	}
+	return n, nil
+}
+
+// Common argument definitions.
+var (
+	keyPatternArg = &mcp.PromptArgument{
+		Name:        argKeyPattern,
+		Title:       "Key pattern",
+		Description: "Glob pattern limiting which keys are inspected (default: *)",
+	}
+	timeWindowArg = &mcp.PromptArgument{
+		Name:        argTimeWindow,
+		Title:       "Time window",
+		Description: "How far back to look, as a duration such as 15m or 2h (default: 1h)",
+	}
+	sampleSizeArg = &mcp.PromptArgument{
+		Name:        argSampleSize,
+		Title:       "Sample size",
+		Description: "Number of keys to sample (default: 100)",
+	}
+)
+
+// All returns every built-in prompt. keyURI renders the resource URI of a key
+// and may be nil, in which case prompts do not link key resources.
+func All(keyURI func(key string) string) []*Prompt {
+	return []*Prompt{
+		diagnoseHighMemory(),
+		investigateLatencySpike(),
+		auditKeyTTLHygiene(),
+		reviewClusterHealth(),
+		explainKey(keyURI),
+	}
+}
+
+// RegisterAll adds every built-in prompt to server.
+func RegisterAll(server *mcp.Server, keyURI func(key string) string) {
+	for _, p := range All(keyURI) {
+		server.AddPrompt(&mcp.Prompt{
+			Name:        p.Name,
+			Title:       p.Title,
+			Description: p.Description,
+			Arguments:   p.Arguments,
+		}, p.handle)
+	}
+}
+
+// handle serves prompts/get for p.
+func (p *Prompt) handle(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	var args arguments
+	if req.Params != nil {
+		args = req.Params.Arguments
+	}
+	return p.Get(args)
+}
+
+// Get renders the prompt for the given arguments.
+func (p *Prompt) Get(args map[string]string) (*mcp.GetPromptResult, error) {
+	for _, a := range p.Arguments {
+		if a.Required && strings.TrimSpace(args[a.Name]) == "" {
+			return nil, fmt.Errorf("prompt %s: argument %q is required", p.Name, a.Name)
+		}
+	}
+
+	wf, err := p.build(arguments(args))
+	if err != nil {
+		return nil, fmt.Errorf("prompt %s: %w", p.Name, err)
+	}
+
+	messages := []*mcp.PromptMessage{
+		{Role: "user", Content: &mcp.TextContent{Text: wf.render()}},
+	}
+	if wf.Resource != nil {
+		messages = append(messages, &mcp.PromptMessage{Role: "user", Content: wf.Resource})
+	}
+
+	return &mcp.GetPromptResult{
+		Description: p.Description,
+		Messages:    messages,
+	}, nil
+}
+
+// render formats the workflow as numbered instructions.
+func (w *workflow) render() string {
+	var b strings.Builder
+	b.WriteString(w.Goal)
+	b.WriteString("\n\nRun these tools in order, and note anything unusual before moving on:\n")
+	for i, s := range w.Steps {
+		args := "{}"
+		if len(s.Args) > 0 {
+			encoded, _ := json.Marshal(s.Args)
+			args = string(encoded)
+		}
+		fmt.Fprintf(&b, "%d. `%s` %s: %s\n", i+1, s.Tool, args, s.Purpose)
+	}
+	b.WriteString("\n")
+	b.WriteString(w.Conclusion)
+	return b.String()
+}
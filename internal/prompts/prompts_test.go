@@ -0,0 +1,103 @@
+package prompts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools"
+)
+
+func keyURI(key string) string { return "valkey://default/0/" + key }
+
+func TestAll_StepsReferenceRegisteredTools(t *testing.T) {
+	reg := registry.NewToolRegistry()
+	tools.RegisterAll(reg, client.NewMockClient())
+
+	args := arguments{argKey: "user:1"}
+	for _, p := range All(keyURI) {
+		wf, err := p.build(args)
+		require.NoError(t, err, p.Name)
+		require.NotEmpty(t, wf.Steps, p.Name)
+		for _, s := range wf.Steps {
+			_, exists := reg.GetTool(s.Tool)
+			assert.True(t, exists, "prompt %s references unknown tool %s", p.Name, s.Tool)
+		}
+	}
+}
+
+func TestPrompt_Get_AppliesArguments(t *testing.T) {
+	res, err := diagnoseHighMemory().Get(map[string]string{
+		argKeyPattern: "session:*",
+		argTimeWindow: "15m",
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 1)
+
+	text := res.Messages[0].Content.(*mcp.TextContent).Text
+	assert.Contains(t, text, `"session:*"`)
+	assert.Contains(t, text, "last 15m")
+	assert.Contains(t, text, "1. `server_info` {}")
+	assert.Contains(t, text, `"pattern":"session:*"`)
+}
+
+func TestPrompt_Get_InvalidTimeWindow(t *testing.T) {
+	_, err := investigateLatencySpike().Get(map[string]string{argTimeWindow: "yesterday"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "time_window")
+}
+
+func TestPrompt_Get_MissingRequiredArgument(t *testing.T) {
+	_, err := explainKey(keyURI).Get(map[string]string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"key"`)
+}
+
+func TestExplainKey_LinksResource(t *testing.T) {
+	res, err := explainKey(keyURI).Get(map[string]string{argKey: "user:1"})
+	require.NoError(t, err)
+	require.Len(t, res.Messages, 2)
+
+	link, ok := res.Messages[1].Content.(*mcp.ResourceLink)
+	require.True(t, ok)
+	assert.Equal(t, "valkey://default/0/user:1", link.URI)
+}
+
+func TestReviewClusterHealth_KeyAddsSlotSteps(t *testing.T) {
+	without, err := reviewClusterHealth().build(arguments{})
+	require.NoError(t, err)
+	with, err := reviewClusterHealth().build(arguments{argKey: "user:1"})
+	require.NoError(t, err)
+	assert.Len(t, with.Steps, len(without.Steps)+2)
+}
+
+func TestRegisterAll_ServesPrompts(t *testing.T) {
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	RegisterAll(server, keyURI)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, nil).Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	list, err := session.ListPrompts(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Prompts, 5)
+
+	res, err := session.GetPrompt(ctx, &mcp.GetPromptParams{
+		Name:      "audit_key_ttl_hygiene",
+		Arguments: map[string]string{argSampleSize: "20"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, res.Messages[0].Content.(*mcp.TextContent).Text, `"count":20`)
+}
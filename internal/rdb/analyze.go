@@ -0,0 +1,138 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/keyspace"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
+)
+
+// progressInterval is the number of entries read between progress reports.
+const progressInterval = 1000
+
+// AnalyzeOptions configures Analyze.
+type AnalyzeOptions struct {
+	// Delimiter and Depth group keys as keyspace.NewAnalyzer does.
+	Delimiter string
+	Depth     int
+	// Limit caps the groups returned; <= 0 means no limit.
+	Limit int
+	// DB restricts the analysis to one database; a negative DB includes all.
+	DB int
+}
+
+// Report is the keyspace breakdown of an RDB file. Memory figures are
+// estimates computed with Entry.MemoryEstimate.
+type Report struct {
+	Version int               `json:"rdb_version"`
+	Aux     map[string]string `json:"aux,omitempty"`
+	// SnapshotTime is when the file was written, from its ctime field, and is
+	// the reference for TTLs.
+	SnapshotTime    time.Time         `json:"snapshot_time"`
+	Keys            int64             `json:"keys"`
+	ExpiredKeys     int64             `json:"expired_keys,omitempty"`
+	MemoryBytes     int64             `json:"estimated_memory_bytes"`
+	SerializedBytes int64             `json:"serialized_bytes"`
+	Databases       map[int]int64     `json:"databases"`
+	Groups          []*keyspace.Group `json:"groups"`
+	OmittedGroups   int               `json:"omitted_groups,omitempty"`
+	// Partial is set when the analysis was cancelled before the end of the
+	// file; the figures cover the keys read until then.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// Analyze reads an RDB file and groups its keys by prefix. Keys already
+// expired when the file was written are counted in ExpiredKeys only.
+// Progress is reported in bytes read, out of the file size when r is a file.
+// When ctx is cancelled the keys read so far are returned with Partial set.
+func Analyze(ctx context.Context, r io.Reader, opts AnalyzeOptions) (*Report, error) {
+	var size int64
+	if f, ok := r.(interface{ Stat() (os.FileInfo, error) }); ok {
+		if info, err := f.Stat(); err == nil {
+			size = info.Size()
+		}
+	}
+	p, err := NewParser(r)
+	if err != nil {
+		return nil, err
+	}
+	analyzer := keyspace.NewAnalyzer(opts.Delimiter, opts.Depth)
+	report := &Report{Version: p.Version(), Databases: map[int]int64{}}
+
+	for read := 0; ; read++ {
+		if ctx.Err() != nil {
+			report.Partial = true
+			break
+		}
+		if read%progressInterval == 0 {
+			progress.Report(ctx, float64(p.r.offset), float64(size), fmt.Sprintf("read %d keys", read))
+		}
+		entry, err := p.Next()
+		if err == io.EOF {
+			// The checksum after the EOF opcode is not read.
+			done := float64(max(size, p.r.offset))
+			progress.Report(ctx, done, done, fmt.Sprintf("read %d keys", read))
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if opts.DB >= 0 && entry.DB != opts.DB {
+			continue
+		}
+		if report.SnapshotTime.IsZero() {
+			// Aux fields precede the first key.
+			report.SnapshotTime = SnapshotTime(p.Aux())
+		}
+
+		pttl := entry.PTTL(report.SnapshotTime)
+		if pttl == 0 {
+			report.ExpiredKeys++
+			continue
+		}
+		report.Databases[entry.DB]++
+		report.SerializedBytes += entry.SerializedBytes
+		analyzer.Add(entry.Key, entry.Type, entry.Encoding, entry.MemoryEstimate(), pttl)
+	}
+
+	if report.SnapshotTime.IsZero() {
+		report.SnapshotTime = SnapshotTime(p.Aux())
+	}
+	report.Aux = p.Aux()
+	report.Keys, report.MemoryBytes = analyzer.Keys(), analyzer.MemoryBytes()
+	report.Groups, report.OmittedGroups = analyzer.Groups(0, opts.Limit)
+	return report, nil
+}
+
+// SnapshotTime returns the time recorded in the ctime auxiliary field, or
+// the current time when the file has none.
+func SnapshotTime(aux map[string]string) time.Time {
+	if seconds, err := strconv.ParseInt(aux["ctime"], 10, 64); err == nil {
+		return time.Unix(seconds, 0)
+	}
+	return time.Now()
+}
+
+// PTTL returns the key's remaining time to live in milliseconds at the given
+// time: -1 when it has no expiry and 0 when it has already expired.
+func (e *Entry) PTTL(at time.Time) int64 {
+	if e.ExpireAt == 0 {
+		return -1
+	}
+	return max(e.ExpireAt-at.UnixMilli(), 0)
+}
+
+// Open opens the file name inside dir. Names that would resolve outside dir,
+// including through symbolic links, are refused.
+func Open(dir, name string) (*os.File, error) {
+	f, err := os.OpenInRoot(dir, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open RDB file %q: %w", name, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,268 @@
+package rdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// errCorrupt reports a compact structure that ends early or holds an
+// unknown encoding.
+func errCorrupt(structure string) error {
+	return fmt.Errorf("corrupt %s", structure)
+}
+
+// decodeListpack returns the elements of a listpack. Integers are returned
+// in decimal.
+func decodeListpack(b []byte) ([][]byte, error) {
+	const header = 6
+	if len(b) < header+1 {
+		return nil, errCorrupt("listpack")
+	}
+	count := int(binary.LittleEndian.Uint16(b[4:6]))
+	elements := make([][]byte, 0, min(count, maxPrealloc))
+
+	pos := header
+	for {
+		if pos >= len(b) {
+			return nil, errCorrupt("listpack")
+		}
+		c := b[pos]
+		if c == 0xff {
+			return elements, nil
+		}
+
+		// size is the length of the encoding byte(s) plus the data.
+		var value []byte
+		var size int
+		var num int64
+		isInt := true
+		switch {
+		case c&0x80 == 0:
+			num, size = int64(c&0x7f), 1
+		case c&0xc0 == 0x80:
+			isInt = false
+			size = 1 + int(c&0x3f)
+			if pos+size > len(b) {
+				return nil, errCorrupt("listpack")
+			}
+			value = b[pos+1 : pos+size]
+		case c&0xe0 == 0xc0:
+			if pos+2 > len(b) {
+				return nil, errCorrupt("listpack")
+			}
+			num, size = int64(c&0x1f)<<8|int64(b[pos+1]), 2
+			if num >= 1<<12 {
+				num -= 1 << 13
+			}
+		case c&0xf0 == 0xe0:
+			if pos+2 > len(b) {
+				return nil, errCorrupt("listpack")
+			}
+			isInt = false
+			size = 2 + (int(c&0x0f)<<8 | int(b[pos+1]))
+			if pos+size > len(b) {
+				return nil, errCorrupt("listpack")
+			}
+			value = b[pos+2 : pos+size]
+		case c == 0xf0:
+			if pos+5 > len(b) {
+				return nil, errCorrupt("listpack")
+			}
+			isInt = false
+			length := uint64(binary.LittleEndian.Uint32(b[pos+1 : pos+5]))
+			if uint64(pos)+5+length > uint64(len(b)) {
+				return nil, errCorrupt("listpack")
+			}
+			size = 5 + int(length)
+			value = b[pos+5 : pos+size]
+		case c >= 0xf1 && c <= 0xf4:
+			width := map[byte]int{0xf1: 2, 0xf2: 3, 0xf3: 4, 0xf4: 8}[c]
+			if pos+1+width > len(b) {
+				return nil, errCorrupt("listpack")
+			}
+			num, size = littleEndianInt(b[pos+1:pos+1+width]), 1+width
+		default:
+			return nil, errCorrupt("listpack")
+		}
+
+		if isInt {
+			value = strconv.AppendInt(nil, num, 10)
+		}
+		elements = append(elements, value)
+		pos += size + backlenSize(size)
+	}
+}
+
+// backlenSize returns the length of the back-length field that follows a
+// listpack entry of size bytes.
+func backlenSize(size int) int {
+	switch {
+	case size <= 127:
+		return 1
+	case size < 16383:
+		return 2
+	case size < 2097151:
+		return 3
+	case size < 268435455:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// littleEndianInt decodes a signed little-endian integer of len(b) bytes.
+func littleEndianInt(b []byte) int64 {
+	var v uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	shift := 64 - 8*uint(len(b))
+	return int64(v<<shift) >> shift
+}
+
+// decodeZiplist returns the elements of a ziplist, the compact encoding
+// replaced by listpack in RDB version 10. Integers are returned in decimal.
+func decodeZiplist(b []byte) ([][]byte, error) {
+	const header = 10
+	if len(b) < header+1 {
+		return nil, errCorrupt("ziplist")
+	}
+	count := int(binary.LittleEndian.Uint16(b[8:10]))
+	elements := make([][]byte, 0, min(count, maxPrealloc))
+
+	pos := header
+	for {
+		if pos >= len(b) {
+			return nil, errCorrupt("ziplist")
+		}
+		if b[pos] == 0xff {
+			return elements, nil
+		}
+
+		// Skip the length of the previous entry.
+		if b[pos] < 254 {
+			pos++
+		} else {
+			pos += 5
+		}
+		if pos >= len(b) {
+			return nil, errCorrupt("ziplist")
+		}
+
+		c := b[pos]
+		var length int
+		switch c >> 6 {
+		case 0:
+			length, pos = int(c&0x3f), pos+1
+		case 1:
+			if pos+2 > len(b) {
+				return nil, errCorrupt("ziplist")
+			}
+			length, pos = int(c&0x3f)<<8|int(b[pos+1]), pos+2
+		case 2:
+			if pos+5 > len(b) {
+				return nil, errCorrupt("ziplist")
+			}
+			length, pos = int(binary.BigEndian.Uint32(b[pos+1:pos+5])), pos+5
+		default:
+			var width int
+			switch {
+			case c == 0xc0:
+				width = 2
+			case c == 0xd0:
+				width = 4
+			case c == 0xe0:
+				width = 8
+			case c == 0xf0:
+				width = 3
+			case c == 0xfe:
+				width = 1
+			case c >= 0xf1 && c <= 0xfd:
+				elements = append(elements, strconv.AppendInt(nil, int64(c&0x0f)-1, 10))
+				pos++
+				continue
+			default:
+				return nil, errCorrupt("ziplist")
+			}
+			if pos+1+width > len(b) {
+				return nil, errCorrupt("ziplist")
+			}
+			elements = append(elements, strconv.AppendInt(nil, littleEndianInt(b[pos+1:pos+1+width]), 10))
+			pos += 1 + width
+			continue
+		}
+
+		if length < 0 || pos+length > len(b) {
+			return nil, errCorrupt("ziplist")
+		}
+		elements = append(elements, b[pos:pos+length])
+		pos += length
+	}
+}
+
+// decodeIntset returns the members of an intset in decimal.
+func decodeIntset(b []byte) ([][]byte, error) {
+	if len(b) < 8 {
+		return nil, errCorrupt("intset")
+	}
+	width := int(binary.LittleEndian.Uint32(b[0:4]))
+	count := uint64(binary.LittleEndian.Uint32(b[4:8]))
+	if width != 2 && width != 4 && width != 8 || uint64(len(b)-8) != count*uint64(width) {
+		return nil, errCorrupt("intset")
+	}
+	members := make([][]byte, 0, count)
+	for pos := 8; pos < len(b); pos += width {
+		members = append(members, strconv.AppendInt(nil, littleEndianInt(b[pos:pos+width]), 10))
+	}
+	return members, nil
+}
+
+// decodeZipmap returns the alternating fields and values of a zipmap, the
+// small hash encoding of RDB versions before 4.
+func decodeZipmap(b []byte) ([][]byte, error) {
+	var elements [][]byte
+	pos := 1
+	readLen := func() (int, bool) {
+		if pos >= len(b) || b[pos] == 0xff {
+			return 0, false
+		}
+		if b[pos] < 254 {
+			pos++
+			return int(b[pos-1]), true
+		}
+		if pos+5 > len(b) {
+			return 0, false
+		}
+		n := int(binary.LittleEndian.Uint32(b[pos+1 : pos+5]))
+		pos += 5
+		return n, true
+	}
+
+	for {
+		if pos >= len(b) {
+			return nil, errCorrupt("zipmap")
+		}
+		if b[pos] == 0xff {
+			return elements, nil
+		}
+		keyLen, ok := readLen()
+		if !ok || pos+keyLen > len(b) {
+			return nil, errCorrupt("zipmap")
+		}
+		field := b[pos : pos+keyLen]
+		pos += keyLen
+
+		valueLen, ok := readLen()
+		if !ok || pos >= len(b) {
+			return nil, errCorrupt("zipmap")
+		}
+		free := int(b[pos])
+		pos++
+		if pos+valueLen+free > len(b) {
+			return nil, errCorrupt("zipmap")
+		}
+		elements = append(elements, field, b[pos:pos+valueLen])
+		pos += valueLen + free
+	}
+}
@@ -0,0 +1,277 @@
+package rdb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Special string encodings, flagged by the top two bits of a length byte.
+const (
+	encInt8  = 0
+	encInt16 = 1
+	encInt32 = 2
+	encLZF   = 3
+)
+
+// maxPrealloc caps slice capacities taken from counts in the file, so that a
+// corrupt count fails on a short read rather than on allocation.
+const maxPrealloc = 1024
+
+// reader reads RDB primitives and counts the bytes consumed.
+type reader struct {
+	r      *bufio.Reader
+	offset int64
+}
+
+func newReader(r io.Reader) *reader {
+	return &reader{r: bufio.NewReaderSize(r, 64<<10)}
+}
+
+func (r *reader) readByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err != nil {
+		return 0, unexpected(err)
+	}
+	r.offset++
+	return b, nil
+}
+
+// readBytes reads n bytes. The buffer grows as data arrives, so a corrupt
+// length fails at the end of the file instead of allocating it up front.
+func (r *reader) readBytes(n uint64) ([]byte, error) {
+	if n <= maxPrealloc {
+		buf := make([]byte, n)
+		read, err := io.ReadFull(r.r, buf)
+		r.offset += int64(read)
+		if err != nil {
+			return nil, unexpected(err)
+		}
+		return buf, nil
+	}
+	if n > math.MaxInt64 {
+		return nil, fmt.Errorf("string length %d is too large", n)
+	}
+	var buf bytes.Buffer
+	read, err := io.CopyN(&buf, r.r, int64(n))
+	r.offset += read
+	if err != nil {
+		return nil, unexpected(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *reader) readUint32LE() (uint32, error) {
+	b, err := r.readBytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *reader) readUint64LE() (uint64, error) {
+	b, err := r.readBytes(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// readMillis reads a millisecond timestamp.
+func (r *reader) readMillis() (int64, error) {
+	v, err := r.readUint64LE()
+	return int64(v), err
+}
+
+// readLength reads a length. encoded is set when the value is instead a
+// special string encoding, returned as the length.
+func (r *reader) readLength() (length uint64, encoded bool, err error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, false, err
+	}
+	switch b >> 6 {
+	case 0:
+		return uint64(b & 0x3f), false, nil
+	case 1:
+		next, err := r.readByte()
+		if err != nil {
+			return 0, false, err
+		}
+		return uint64(b&0x3f)<<8 | uint64(next), false, nil
+	case 2:
+		switch b {
+		case 0x80:
+			v, err := r.readBytes(4)
+			if err != nil {
+				return 0, false, err
+			}
+			return uint64(binary.BigEndian.Uint32(v)), false, nil
+		case 0x81:
+			v, err := r.readBytes(8)
+			if err != nil {
+				return 0, false, err
+			}
+			return binary.BigEndian.Uint64(v), false, nil
+		default:
+			return 0, false, fmt.Errorf("unknown length encoding 0x%02x", b)
+		}
+	default:
+		return uint64(b & 0x3f), true, nil
+	}
+}
+
+// readLen reads a length that cannot be a special string encoding.
+func (r *reader) readLen() (uint64, error) {
+	length, encoded, err := r.readLength()
+	if err != nil {
+		return 0, err
+	}
+	if encoded {
+		return 0, fmt.Errorf("expected a length, found string encoding %d", length)
+	}
+	return length, nil
+}
+
+// readString reads a string, which may be stored as an integer or
+// LZF-compressed. isInt reports an integer encoding.
+func (r *reader) readString() (s []byte, isInt bool, err error) {
+	length, encoded, err := r.readLength()
+	if err != nil {
+		return nil, false, err
+	}
+	if !encoded {
+		s, err = r.readBytes(length)
+		return s, false, err
+	}
+
+	switch length {
+	case encInt8:
+		b, err := r.readByte()
+		if err != nil {
+			return nil, false, err
+		}
+		return strconv.AppendInt(nil, int64(int8(b)), 10), true, nil
+	case encInt16:
+		b, err := r.readBytes(2)
+		if err != nil {
+			return nil, false, err
+		}
+		return strconv.AppendInt(nil, int64(int16(binary.LittleEndian.Uint16(b))), 10), true, nil
+	case encInt32:
+		b, err := r.readBytes(4)
+		if err != nil {
+			return nil, false, err
+		}
+		return strconv.AppendInt(nil, int64(int32(binary.LittleEndian.Uint32(b))), 10), true, nil
+	case encLZF:
+		compressed, err := r.readLen()
+		if err != nil {
+			return nil, false, err
+		}
+		size, err := r.readLen()
+		if err != nil {
+			return nil, false, err
+		}
+		data, err := r.readBytes(compressed)
+		if err != nil {
+			return nil, false, err
+		}
+		s, err = lzfDecompress(data, size)
+		return s, false, err
+	default:
+		return nil, false, fmt.Errorf("unknown string encoding %d", length)
+	}
+}
+
+// readBlob reads a string that holds an encoded structure.
+func (r *reader) readBlob() ([]byte, error) {
+	s, _, err := r.readString()
+	return s, err
+}
+
+// readStringDouble reads a score stored as text by the original zset type.
+func (r *reader) readStringDouble() (float64, error) {
+	n, err := r.readByte()
+	if err != nil {
+		return 0, err
+	}
+	switch n {
+	case 253:
+		return math.NaN(), nil
+	case 254:
+		return math.Inf(1), nil
+	case 255:
+		return math.Inf(-1), nil
+	}
+	b, err := r.readBytes(uint64(n))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(string(b), 64)
+}
+
+// readBinaryDouble reads an IEEE 754 score.
+func (r *reader) readBinaryDouble() (float64, error) {
+	v, err := r.readUint64LE()
+	return math.Float64frombits(v), err
+}
+
+// lzfDecompress expands LZF data into size bytes.
+func lzfDecompress(in []byte, size uint64) ([]byte, error) {
+	if size > math.MaxInt32 {
+		return nil, fmt.Errorf("compressed string of %d bytes is too large", size)
+	}
+	out := make([]byte, 0, size)
+	for i := 0; i < len(in); {
+		ctrl := int(in[i])
+		i++
+		if ctrl < 32 {
+			// Literal run of ctrl+1 bytes.
+			n := ctrl + 1
+			if i+n > len(in) {
+				return nil, fmt.Errorf("corrupt LZF data")
+			}
+			out = append(out, in[i:i+n]...)
+			i += n
+			continue
+		}
+
+		// Back reference of length+2 bytes.
+		length := ctrl >> 5
+		if length == 7 {
+			if i >= len(in) {
+				return nil, fmt.Errorf("corrupt LZF data")
+			}
+			length += int(in[i])
+			i++
+		}
+		if i >= len(in) {
+			return nil, fmt.Errorf("corrupt LZF data")
+		}
+		ref := len(out) - (ctrl&0x1f)<<8 - int(in[i]) - 1
+		i++
+		if ref < 0 {
+			return nil, fmt.Errorf("corrupt LZF data")
+		}
+		for j := 0; j < length+2; j++ {
+			out = append(out, out[ref+j])
+		}
+	}
+	if uint64(len(out)) != size {
+		return nil, fmt.Errorf("LZF data expanded to %d bytes, expected %d", len(out), size)
+	}
+	return out, nil
+}
+
+// unexpected turns io.EOF inside a record into io.ErrUnexpectedEOF.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package rdb
+
+// Approximate allocation sizes, in bytes, of the structures a server builds
+// when it loads a key.
+const (
+	keyOverhead      = 56 // dict entry, value object and key header
+	expireOverhead   = 32 // entry in the expires dict
+	elementOverhead  = 24 // dict entry per set member or hash field
+	skiplistOverhead = 40 // skiplist node per sorted set member
+	quicklistNode    = 32 // quicklist node per listpack
+	streamOverhead   = 96 // stream header and radix tree
+	groupOverhead    = 64 // consumer group, per group and per consumer
+)
+
+// MemoryEstimate approximates the memory the key would use once loaded, in
+// the spirit of MEMORY USAGE. Compact encodings are assumed to take their
+// serialized size; hash tables and skiplists add per-element overheads.
+// Allocator rounding is ignored, so the estimate is usually low.
+func (e *Entry) MemoryEstimate() int64 {
+	size := keyOverhead + sdsSize(len(e.Key))
+	if e.ExpireAt != 0 {
+		size += expireOverhead
+	}
+
+	switch value := e.Value.(type) {
+	case []byte:
+		if e.Encoding != "int" {
+			size += sdsSize(len(value))
+		}
+	case [][]byte:
+		switch e.Encoding {
+		case "hashtable":
+			for _, member := range value {
+				size += elementOverhead + sdsSize(len(member))
+			}
+		case "linkedlist":
+			// Loaded into listpacks much like a quicklist.
+			size += quicklistNode + e.SerializedBytes
+		default:
+			size += e.SerializedBytes
+		}
+	case []ZsetMember:
+		if e.Encoding != "skiplist" {
+			size += e.SerializedBytes
+			break
+		}
+		for _, member := range value {
+			size += elementOverhead + skiplistOverhead + sdsSize(len(member.Member))
+		}
+	case []HashField:
+		if e.Encoding != "hashtable" {
+			size += e.SerializedBytes
+			break
+		}
+		for _, field := range value {
+			size += elementOverhead + sdsSize(len(field.Field)) + sdsSize(len(field.Value))
+		}
+	case *Stream:
+		size += streamOverhead + e.SerializedBytes
+		for _, group := range value.Groups {
+			size += groupOverhead * int64(1+len(group.Consumers))
+		}
+	default:
+		size += e.SerializedBytes
+	}
+	return size
+}
+
+// sdsSize returns the size of a string with its sds header and terminator.
+func sdsSize(n int) int64 {
+	switch {
+	case n < 1<<8:
+		return int64(n) + 3
+	case n < 1<<16:
+		return int64(n) + 5
+	case n < 1<<32:
+		return int64(n) + 9
+	default:
+		return int64(n) + 17
+	}
+}
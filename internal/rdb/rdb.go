@@ -0,0 +1,626 @@
+// Package rdb reads Valkey and Redis RDB snapshot files without a server.
+// It decodes every value encoding up to RDB version 12, and version 80 as
+// written by Valkey 9: strings, linked lists, quicklists, ziplists,
+// listpacks, intsets, zipmaps, hash tables, skiplists and streams. Module
+// values are skipped. The trailing checksum is not verified.
+package rdb
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Versions accepted by NewParser. Versions between MaxVersion and
+// ValkeyVersion are reserved by Valkey for foreign formats.
+const (
+	MaxVersion    = 12
+	ValkeyVersion = 80
+)
+
+// Opcodes preceding the records of an RDB file.
+const (
+	opSlotInfo      = 0xf4
+	opFunction2     = 0xf5
+	opFunctionPreGA = 0xf6
+	opModuleAux     = 0xf7
+	opIdle          = 0xf8
+	opFreq          = 0xf9
+	opAux           = 0xfa
+	opResizeDB      = 0xfb
+	opExpireMillis  = 0xfc
+	opExpireSeconds = 0xfd
+	opSelectDB      = 0xfe
+	opEOF           = 0xff
+)
+
+// Value types.
+const (
+	typeString           = 0
+	typeList             = 1
+	typeSet              = 2
+	typeZset             = 3
+	typeHash             = 4
+	typeZset2            = 5
+	typeModule           = 6
+	typeModule2          = 7
+	typeHashZipmap       = 9
+	typeListZiplist      = 10
+	typeSetIntset        = 11
+	typeZsetZiplist      = 12
+	typeHashZiplist      = 13
+	typeListQuicklist    = 14
+	typeStreamListpacks  = 15
+	typeHashListpack     = 16
+	typeZsetListpack     = 17
+	typeListQuicklist2   = 18
+	typeStreamListpacks2 = 19
+	typeSetListpack      = 20
+	typeStreamListpacks3 = 21
+	typeHashMetadata     = 24
+	typeHashListpackEx   = 25
+)
+
+// Quicklist node containers.
+const (
+	containerPlain  = 1
+	containerPacked = 2
+)
+
+// Module value opcodes.
+const (
+	moduleOpEOF    = 0
+	moduleOpSInt   = 1
+	moduleOpUInt   = 2
+	moduleOpFloat  = 3
+	moduleOpDouble = 4
+	moduleOpString = 5
+)
+
+// Entry is one key of an RDB file.
+type Entry struct {
+	DB  int
+	Key string
+	// Type is the name TYPE would report: string, list, set, zset, hash,
+	// stream or module.
+	Type string
+	// Encoding is the encoding the value was saved in, such as listpack,
+	// quicklist, intset, hashtable or skiplist. Strings are int, embstr or
+	// raw as OBJECT ENCODING would report them.
+	Encoding string
+	// ExpireAt is the expiry time in Unix milliseconds, or 0 for none.
+	ExpireAt int64
+	// Length is the byte length of a string, or the element count of a
+	// collection or stream.
+	Length int64
+	// SerializedBytes is the size of the value in the file.
+	SerializedBytes int64
+	// Value holds the decoded value: []byte for a string, [][]byte for a
+	// list or set, []ZsetMember, []HashField, *Stream, or nil for a module.
+	Value any
+}
+
+// ZsetMember is a sorted set member.
+type ZsetMember struct {
+	Member []byte
+	Score  float64
+}
+
+// HashField is a hash field. ExpireAt is the field's expiry time in Unix
+// milliseconds, or 0 for none.
+type HashField struct {
+	Field    []byte
+	Value    []byte
+	ExpireAt int64
+}
+
+// Parser reads the entries of an RDB file in order.
+type Parser struct {
+	r       *reader
+	version int
+	aux     map[string]string
+	db      int
+	done    bool
+}
+
+// NewParser reads the header of an RDB file.
+func NewParser(r io.Reader) (*Parser, error) {
+	p := &Parser{r: newReader(r), aux: make(map[string]string)}
+	magic, err := p.r.readBytes(5)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read RDB header: %w", err)
+	}
+	var digits []byte
+	switch string(magic) {
+	case "REDIS":
+		digits, err = p.r.readBytes(4)
+	case "VALKE":
+		digits, err = p.r.readBytes(4)
+		if err == nil && digits[0] != 'Y' {
+			err = fmt.Errorf("not an RDB file")
+		}
+		digits = digits[1:]
+	default:
+		err = fmt.Errorf("not an RDB file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read RDB header: %w", err)
+	}
+	if p.version, err = strconv.Atoi(string(digits)); err != nil {
+		return nil, fmt.Errorf("invalid RDB version %q", digits)
+	}
+	if p.version < 1 || p.version > MaxVersion && p.version != ValkeyVersion {
+		return nil, fmt.Errorf("unsupported RDB version %d", p.version)
+	}
+	return p, nil
+}
+
+// Version returns the RDB format version.
+func (p *Parser) Version() int {
+	return p.version
+}
+
+// Aux returns the auxiliary fields read so far, such as redis-ver and ctime.
+// They are written before the first key.
+func (p *Parser) Aux() map[string]string {
+	return p.aux
+}
+
+// Next returns the next entry, or io.EOF after the last one.
+func (p *Parser) Next() (*Entry, error) {
+	if p.done {
+		return nil, io.EOF
+	}
+	var expireAt int64
+	for {
+		start := p.r.offset
+		op, err := p.r.readByte()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case opEOF:
+			p.done = true
+			return nil, io.EOF
+		case opAux:
+			key, _, err := p.r.readString()
+			if err != nil {
+				return nil, err
+			}
+			value, _, err := p.r.readString()
+			if err != nil {
+				return nil, err
+			}
+			p.aux[string(key)] = string(value)
+		case opSelectDB:
+			db, err := p.r.readLen()
+			if err != nil {
+				return nil, err
+			}
+			p.db = int(db)
+		case opResizeDB:
+			if err := p.skipLengths(2); err != nil {
+				return nil, err
+			}
+		case opSlotInfo:
+			if err := p.skipLengths(3); err != nil {
+				return nil, err
+			}
+		case opExpireMillis:
+			if expireAt, err = p.r.readMillis(); err != nil {
+				return nil, err
+			}
+		case opExpireSeconds:
+			seconds, err := p.r.readUint32LE()
+			if err != nil {
+				return nil, err
+			}
+			expireAt = int64(seconds) * 1000
+		case opIdle:
+			if _, err := p.r.readLen(); err != nil {
+				return nil, err
+			}
+		case opFreq:
+			if _, err := p.r.readByte(); err != nil {
+				return nil, err
+			}
+		case opModuleAux:
+			// Module ID, then when the module wants the data loaded.
+			if err := p.skipLengths(3); err != nil {
+				return nil, err
+			}
+			if err := p.skipModuleValue(); err != nil {
+				return nil, err
+			}
+		case opFunction2:
+			if _, _, err := p.r.readString(); err != nil {
+				return nil, err
+			}
+		case opFunctionPreGA:
+			return nil, fmt.Errorf("unsupported pre-release function record at offset %d", start)
+		default:
+			key, _, err := p.r.readString()
+			if err != nil {
+				return nil, err
+			}
+			entry := &Entry{DB: p.db, Key: string(key), ExpireAt: expireAt}
+			valueStart := p.r.offset
+			if err := p.readValue(op, entry); err != nil {
+				return nil, fmt.Errorf("failed to read key %q: %w", key, err)
+			}
+			entry.SerializedBytes = p.r.offset - valueStart
+			return entry, nil
+		}
+	}
+}
+
+func (p *Parser) skipLengths(n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := p.r.readLen(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readValue decodes a value of the given type into entry.
+func (p *Parser) readValue(valueType byte, entry *Entry) error {
+	switch valueType {
+	case typeString:
+		s, isInt, err := p.r.readString()
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding, entry.Value = "string", stringEncoding(s, isInt), s
+		entry.Length = int64(len(s))
+		return nil
+
+	case typeList, typeSet:
+		elements, err := p.readStrings(1)
+		if err != nil {
+			return err
+		}
+		if valueType == typeList {
+			entry.Type, entry.Encoding = "list", "linkedlist"
+		} else {
+			entry.Type, entry.Encoding = "set", "hashtable"
+		}
+		entry.setElements(elements)
+		return nil
+
+	case typeZset, typeZset2:
+		count, err := p.r.readLen()
+		if err != nil {
+			return err
+		}
+		members := make([]ZsetMember, 0, min(count, maxPrealloc))
+		for i := uint64(0); i < count; i++ {
+			member, _, err := p.r.readString()
+			if err != nil {
+				return err
+			}
+			var score float64
+			if valueType == typeZset {
+				score, err = p.r.readStringDouble()
+			} else {
+				score, err = p.r.readBinaryDouble()
+			}
+			if err != nil {
+				return err
+			}
+			members = append(members, ZsetMember{Member: member, Score: score})
+		}
+		entry.Type, entry.Encoding, entry.Value = "zset", "skiplist", members
+		entry.Length = int64(len(members))
+		return nil
+
+	case typeHash:
+		elements, err := p.readStrings(2)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "hash", "hashtable"
+		return entry.setHash(elements, nil)
+
+	case typeHashMetadata:
+		return p.readHashMetadata(entry)
+
+	case typeHashListpackEx:
+		return p.readHashListpackEx(entry)
+
+	case typeModule2:
+		if _, err := p.r.readLen(); err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "module", "module"
+		return p.skipModuleValue()
+
+	case typeModule:
+		return fmt.Errorf("unsupported pre-release module value")
+
+	case typeListQuicklist, typeListQuicklist2:
+		return p.readQuicklist(valueType, entry)
+
+	case typeStreamListpacks, typeStreamListpacks2, typeStreamListpacks3:
+		return p.readStream(valueType, entry)
+	}
+
+	// The remaining types are a single string holding a compact structure.
+	blob, err := p.r.readBlob()
+	if err != nil {
+		return err
+	}
+	switch valueType {
+	case typeHashZipmap:
+		elements, err := decodeZipmap(blob)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "hash", "zipmap"
+		return entry.setHash(elements, nil)
+	case typeListZiplist:
+		elements, err := decodeZiplist(blob)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "list", "ziplist"
+		entry.setElements(elements)
+		return nil
+	case typeSetIntset:
+		members, err := decodeIntset(blob)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "set", "intset"
+		entry.setElements(members)
+		return nil
+	case typeSetListpack:
+		members, err := decodeListpack(blob)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "set", "listpack"
+		entry.setElements(members)
+		return nil
+	case typeZsetZiplist, typeZsetListpack:
+		decode, encoding := decodeListpack, "listpack"
+		if valueType == typeZsetZiplist {
+			decode, encoding = decodeZiplist, "ziplist"
+		}
+		elements, err := decode(blob)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "zset", encoding
+		return entry.setZset(elements)
+	case typeHashZiplist, typeHashListpack:
+		decode, encoding := decodeListpack, "listpack"
+		if valueType == typeHashZiplist {
+			decode, encoding = decodeZiplist, "ziplist"
+		}
+		elements, err := decode(blob)
+		if err != nil {
+			return err
+		}
+		entry.Type, entry.Encoding = "hash", encoding
+		return entry.setHash(elements, nil)
+	default:
+		return fmt.Errorf("unsupported value type %d", valueType)
+	}
+}
+
+// readStrings reads a count followed by count*per strings.
+func (p *Parser) readStrings(per uint64) ([][]byte, error) {
+	count, err := p.r.readLen()
+	if err != nil {
+		return nil, err
+	}
+	if count > math.MaxUint64/per {
+		return nil, fmt.Errorf("element count %d is too large", count)
+	}
+	count *= per
+	elements := make([][]byte, 0, min(count, maxPrealloc))
+	for i := uint64(0); i < count; i++ {
+		s, _, err := p.r.readString()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, s)
+	}
+	return elements, nil
+}
+
+// readQuicklist reads a list saved as a quicklist of ziplists, or of
+// listpacks and plain elements.
+func (p *Parser) readQuicklist(valueType byte, entry *Entry) error {
+	nodes, err := p.r.readLen()
+	if err != nil {
+		return err
+	}
+	var elements [][]byte
+	for i := uint64(0); i < nodes; i++ {
+		container := uint64(containerPacked)
+		if valueType == typeListQuicklist2 {
+			if container, err = p.r.readLen(); err != nil {
+				return err
+			}
+		}
+		blob, err := p.r.readBlob()
+		if err != nil {
+			return err
+		}
+
+		var node [][]byte
+		switch {
+		case container == containerPlain:
+			node = [][]byte{blob}
+		case container != containerPacked:
+			return fmt.Errorf("unknown quicklist container %d", container)
+		case valueType == typeListQuicklist:
+			node, err = decodeZiplist(blob)
+		default:
+			node, err = decodeListpack(blob)
+		}
+		if err != nil {
+			return err
+		}
+		elements = append(elements, node...)
+	}
+	entry.Type, entry.Encoding = "list", "quicklist"
+	entry.setElements(elements)
+	return nil
+}
+
+// readHashMetadata reads a hash table whose fields may expire. Field expiry
+// times are stored relative to the earliest one.
+func (p *Parser) readHashMetadata(entry *Entry) error {
+	minExpire, err := p.r.readMillis()
+	if err != nil {
+		return err
+	}
+	count, err := p.r.readLen()
+	if err != nil {
+		return err
+	}
+	elements := make([][]byte, 0, min(2*count, maxPrealloc))
+	expires := make([]int64, 0, min(count, maxPrealloc))
+	for i := uint64(0); i < count; i++ {
+		ttl, err := p.r.readLen()
+		if err != nil {
+			return err
+		}
+		var expireAt int64
+		if ttl != 0 {
+			expireAt = int64(ttl) + minExpire - 1
+		}
+		field, _, err := p.r.readString()
+		if err != nil {
+			return err
+		}
+		value, _, err := p.r.readString()
+		if err != nil {
+			return err
+		}
+		elements = append(elements, field, value)
+		expires = append(expires, expireAt)
+	}
+	entry.Type, entry.Encoding = "hash", "hashtable"
+	return entry.setHash(elements, expires)
+}
+
+// readHashListpackEx reads a listpack hash whose fields may expire, stored
+// as field, value, expiry time triplets.
+func (p *Parser) readHashListpackEx(entry *Entry) error {
+	if _, err := p.r.readMillis(); err != nil {
+		return err
+	}
+	blob, err := p.r.readBlob()
+	if err != nil {
+		return err
+	}
+	triplets, err := decodeListpack(blob)
+	if err != nil {
+		return err
+	}
+	if len(triplets)%3 != 0 {
+		return errCorrupt("hash listpack")
+	}
+	elements := make([][]byte, 0, len(triplets)/3*2)
+	expires := make([]int64, 0, len(triplets)/3)
+	for i := 0; i < len(triplets); i += 3 {
+		expireAt, err := strconv.ParseInt(string(triplets[i+2]), 10, 64)
+		if err != nil {
+			return errCorrupt("hash listpack")
+		}
+		elements = append(elements, triplets[i], triplets[i+1])
+		expires = append(expires, expireAt)
+	}
+	entry.Type, entry.Encoding = "hash", "listpack"
+	return entry.setHash(elements, expires)
+}
+
+// skipModuleValue skips a module value, which is a sequence of typed
+// fields ended by moduleOpEOF.
+func (p *Parser) skipModuleValue() error {
+	for {
+		op, err := p.r.readLen()
+		if err != nil {
+			return err
+		}
+		switch op {
+		case moduleOpEOF:
+			return nil
+		case moduleOpSInt, moduleOpUInt:
+			_, err = p.r.readLen()
+		case moduleOpFloat:
+			_, err = p.r.readBytes(4)
+		case moduleOpDouble:
+			_, err = p.r.readBytes(8)
+		case moduleOpString:
+			_, _, err = p.r.readString()
+		default:
+			return fmt.Errorf("unknown module opcode %d", op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (e *Entry) setElements(elements [][]byte) {
+	e.Value = elements
+	e.Length = int64(len(elements))
+}
+
+// setHash sets alternating fields and values, with optional field expiry
+// times.
+func (e *Entry) setHash(elements [][]byte, expires []int64) error {
+	if len(elements)%2 != 0 {
+		return errCorrupt("hash")
+	}
+	fields := make([]HashField, 0, len(elements)/2)
+	for i := 0; i < len(elements); i += 2 {
+		field := HashField{Field: elements[i], Value: elements[i+1]}
+		if expires != nil {
+			field.ExpireAt = expires[i/2]
+		}
+		fields = append(fields, field)
+	}
+	e.Value = fields
+	e.Length = int64(len(fields))
+	return nil
+}
+
+// setZset sets alternating members and scores.
+func (e *Entry) setZset(elements [][]byte) error {
+	if len(elements)%2 != 0 {
+		return errCorrupt("sorted set")
+	}
+	members := make([]ZsetMember, 0, len(elements)/2)
+	for i := 0; i < len(elements); i += 2 {
+		score, err := strconv.ParseFloat(string(elements[i+1]), 64)
+		if err != nil {
+			return fmt.Errorf("invalid score %q", elements[i+1])
+		}
+		members = append(members, ZsetMember{Member: elements[i], Score: score})
+	}
+	e.Value = members
+	e.Length = int64(len(members))
+	return nil
+}
+
+// embstrLimit is the longest string stored with the embstr encoding.
+const embstrLimit = 44
+
+// stringEncoding returns the OBJECT ENCODING a string would load with.
+func stringEncoding(s []byte, isInt bool) string {
+	switch {
+	case isInt:
+		return "int"
+	case len(s) <= embstrLimit:
+		return "embstr"
+	default:
+		return "raw"
+	}
+}
@@ -0,0 +1,520 @@
+package rdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// builder writes RDB files for tests.
+type builder struct {
+	bytes.Buffer
+}
+
+func newBuilder(version int) *builder {
+	b := &builder{}
+	b.WriteString("REDIS" + strings.Repeat("0", 4-len(strconv.Itoa(version))) + strconv.Itoa(version))
+	return b
+}
+
+func (b *builder) length(n uint64) *builder {
+	switch {
+	case n < 1<<6:
+		b.WriteByte(byte(n))
+	case n < 1<<14:
+		b.WriteByte(byte(n>>8) | 0x40)
+		b.WriteByte(byte(n))
+	case n <= math.MaxUint32:
+		b.WriteByte(0x80)
+		binary.Write(b, binary.BigEndian, uint32(n))
+	default:
+		b.WriteByte(0x81)
+		binary.Write(b, binary.BigEndian, n)
+	}
+	return b
+}
+
+func (b *builder) str(s string) *builder {
+	b.length(uint64(len(s)))
+	b.WriteString(s)
+	return b
+}
+
+func (b *builder) op(op byte) *builder {
+	b.WriteByte(op)
+	return b
+}
+
+func (b *builder) millis(ms int64) *builder {
+	binary.Write(b, binary.LittleEndian, ms)
+	return b
+}
+
+func (b *builder) end() []byte {
+	b.WriteByte(opEOF)
+	b.Write(make([]byte, 8))
+	return b.Bytes()
+}
+
+// listpack encodes strings, using the 7-bit integer encoding for small
+// non-negative numbers.
+func listpack(elements ...string) string {
+	var body bytes.Buffer
+	for _, e := range elements {
+		var entry []byte
+		if n, err := strconv.Atoi(e); err == nil && n >= 0 && n < 128 {
+			entry = []byte{byte(n)}
+		} else if len(e) < 64 {
+			entry = append([]byte{0x80 | byte(len(e))}, e...)
+		} else {
+			entry = append([]byte{0xe0 | byte(len(e)>>8), byte(len(e))}, e...)
+		}
+		body.Write(entry)
+		body.WriteByte(byte(len(entry))) // back-length, for entries under 128 bytes
+	}
+	out := make([]byte, 6, 6+body.Len()+1)
+	binary.LittleEndian.PutUint32(out, uint32(6+body.Len()+1))
+	binary.LittleEndian.PutUint16(out[4:], uint16(len(elements)))
+	out = append(out, body.Bytes()...)
+	return string(append(out, 0xff))
+}
+
+// ziplist encodes short strings and the integers 0 to 12.
+func ziplist(elements ...string) string {
+	var body bytes.Buffer
+	for _, e := range elements {
+		body.WriteByte(0) // previous entry length, unused by the decoder
+		if n, err := strconv.Atoi(e); err == nil && n >= 0 && n <= 12 {
+			body.WriteByte(0xf1 + byte(n))
+			continue
+		}
+		body.WriteByte(byte(len(e)))
+		body.WriteString(e)
+	}
+	out := make([]byte, 10, 10+body.Len()+1)
+	binary.LittleEndian.PutUint16(out[8:], uint16(len(elements)))
+	out = append(out, body.Bytes()...)
+	return string(append(out, 0xff))
+}
+
+func intset(values ...int16) string {
+	out := make([]byte, 8)
+	binary.LittleEndian.PutUint32(out, 2)
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(values)))
+	for _, v := range values {
+		out = binary.LittleEndian.AppendUint16(out, uint16(v))
+	}
+	return string(out)
+}
+
+func parseAll(t *testing.T, data []byte) (*Parser, []*Entry) {
+	t.Helper()
+	p, err := NewParser(bytes.NewReader(data))
+	require.NoError(t, err)
+	var entries []*Entry
+	for {
+		entry, err := p.Next()
+		if err == io.EOF {
+			return p, entries
+		}
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+}
+
+func TestParser_Strings(t *testing.T) {
+	b := newBuilder(12)
+	b.op(opAux).str("redis-ver").str("7.2.4")
+	b.op(opSelectDB).length(2)
+	b.op(opResizeDB).length(3).length(1)
+	b.op(typeString).str("plain").str("hello")
+	b.op(opExpireMillis).millis(1700000000000)
+	b.op(typeString).str("number")
+	b.WriteByte(0xc1) // 16-bit integer
+	binary.Write(b, binary.LittleEndian, int16(-1234))
+	b.op(typeString).str("long").str(strings.Repeat("x", 50))
+	// LZF: literal "abc" then a 6-byte back reference, giving "abcabcabc".
+	b.op(typeString).str("compressed")
+	b.WriteByte(0xc3)
+	b.length(6).length(9)
+	b.Write([]byte{0x02, 'a', 'b', 'c', 0x80, 0x02})
+
+	p, entries := parseAll(t, b.end())
+	assert.Equal(t, 12, p.Version())
+	assert.Equal(t, "7.2.4", p.Aux()["redis-ver"])
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, &Entry{DB: 2, Key: "plain", Type: "string", Encoding: "embstr", Length: 5, SerializedBytes: 6, Value: []byte("hello")}, entries[0])
+	assert.Equal(t, []byte("-1234"), entries[1].Value)
+	assert.Equal(t, "int", entries[1].Encoding)
+	assert.Equal(t, int64(1700000000000), entries[1].ExpireAt)
+	assert.Equal(t, "raw", entries[2].Encoding)
+	assert.Equal(t, []byte("abcabcabc"), entries[3].Value)
+	assert.Zero(t, entries[0].ExpireAt)
+}
+
+func TestParser_CompactEncodings(t *testing.T) {
+	b := newBuilder(11)
+	b.op(typeListQuicklist2).str("list").length(2)
+	b.length(containerPacked).str(listpack("a", "7", "bb"))
+	b.length(containerPlain).str("big")
+	b.op(typeSetIntset).str("ints").str(intset(-5, 3, 300))
+	b.op(typeSetListpack).str("tags").str(listpack("red", "blue"))
+	b.op(typeZsetListpack).str("board").str(listpack("alice", "10", "bob", "2.5"))
+	b.op(typeHashListpack).str("user").str(listpack("name", "ann", "age", "30"))
+	b.op(typeHashZiplist).str("old").str(ziplist("f", "12"))
+	b.op(typeListQuicklist).str("oldlist").length(1).str(ziplist("x", "0"))
+
+	_, entries := parseAll(t, b.end())
+	require.Len(t, entries, 7)
+
+	assert.Equal(t, "quicklist", entries[0].Encoding)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("7"), []byte("bb"), []byte("big")}, entries[0].Value)
+	assert.Equal(t, int64(4), entries[0].Length)
+
+	assert.Equal(t, "intset", entries[1].Encoding)
+	assert.Equal(t, [][]byte{[]byte("-5"), []byte("3"), []byte("300")}, entries[1].Value)
+
+	assert.Equal(t, "set", entries[2].Type)
+	assert.Equal(t, "listpack", entries[2].Encoding)
+
+	assert.Equal(t, []ZsetMember{{Member: []byte("alice"), Score: 10}, {Member: []byte("bob"), Score: 2.5}}, entries[3].Value)
+
+	assert.Equal(t, []HashField{{Field: []byte("name"), Value: []byte("ann")}, {Field: []byte("age"), Value: []byte("30")}}, entries[4].Value)
+	assert.Equal(t, "ziplist", entries[5].Encoding)
+	assert.Equal(t, []HashField{{Field: []byte("f"), Value: []byte("12")}}, entries[5].Value)
+	assert.Equal(t, [][]byte{[]byte("x"), []byte("0")}, entries[6].Value)
+}
+
+// TestDecode_FormatVectors decodes ziplists and listpacks written byte by
+// byte from the format descriptions, independently of the listpack and
+// ziplist helpers above, covering the wide forms those helpers never emit.
+func TestDecode_FormatVectors(t *testing.T) {
+	long := bytes.Repeat([]byte("a"), 300)
+	huge := bytes.Repeat([]byte("b"), 16384)
+
+	var zl []byte
+	zl = append(zl, 0x00, 0x41, 0x2c) // 14-bit length 300
+	zl = append(zl, long...)
+	zl = append(zl, 0xfe, 0x2f, 0x01, 0x00, 0x00, 0xc0, 0xfe, 0xff) // 5-byte prevlen 303, int16 -2
+	zl = append(zl, 0x08, 0xf0, 0x56, 0x34, 0x12)                   // int24 0x123456
+	zl = append(zl, 0x05, 0xd0, 0x60, 0x79, 0xfe, 0xff)             // int32 -100000
+	zl = append(zl, 0x06, 0xe0, 0, 0, 0, 0, 0, 0x01, 0, 0)          // int64 1<<40
+	zl = append(zl, 0x0a, 0xfe, 0xf9)                               // int8 -7
+	zl = append(zl, 0x03, 0xfd)                                     // immediate 12
+	zl = append(zl, 0x02, 0x80, 0x00, 0x00, 0x40, 0x00)             // 32-bit length 16384
+	zl = append(zl, huge...)
+	zl = append(zl, 0xff)
+	header := make([]byte, 10)
+	binary.LittleEndian.PutUint32(header, uint32(10+len(zl)))
+	binary.LittleEndian.PutUint32(header[4:], uint32(10+len(zl)-1-6-len(huge)))
+	binary.LittleEndian.PutUint16(header[8:], 8)
+
+	elements, err := decodeZiplist(append(header, zl...))
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{long, []byte("-2"), []byte("1193046"), []byte("-100000"), []byte("1099511627776"), []byte("-7"), []byte("12"), huge}, elements)
+
+	medium := bytes.Repeat([]byte("c"), 200)
+	large := bytes.Repeat([]byte("d"), 20000)
+	var lp []byte
+	lp = append(lp, 0xd0, 0x00, 0x02) // 13-bit int -4096
+	lp = append(lp, 0xcf, 0xff, 0x02) // 13-bit int 4095
+	lp = append(lp, 0xe0, 0xc8)       // 12-bit length 200
+	lp = append(lp, medium...)
+	lp = append(lp, 0x01, 0xca)                            // 2-byte backlen 202
+	lp = append(lp, 0xf1, 0xd4, 0xfe, 0x03)                // int16 -300
+	lp = append(lp, 0xf2, 0x00, 0x00, 0x10, 0x04)          // int24 1<<20
+	lp = append(lp, 0xf3, 0x00, 0x00, 0x00, 0xc0, 0x05)    // int32 -(1<<30)
+	lp = append(lp, 0xf4, 0, 0, 0, 0, 0, 0x01, 0, 0, 0x09) // int64 1<<40
+	lp = append(lp, 0xf0, 0x20, 0x4e, 0x00, 0x00)          // 32-bit length 20000
+	lp = append(lp, large...)
+	lp = append(lp, 0x01, 0x9c, 0xa5) // 3-byte backlen 20005
+	lp = append(lp, 0xff)
+	header = make([]byte, 6)
+	binary.LittleEndian.PutUint32(header, uint32(6+len(lp)))
+	binary.LittleEndian.PutUint16(header[4:], 8)
+
+	elements, err = decodeListpack(append(header, lp...))
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("-4096"), []byte("4095"), medium, []byte("-300"), []byte("1048576"), []byte("-1073741824"), []byte("1099511627776"), large}, elements)
+}
+
+func TestParser_ExpandedEncodings(t *testing.T) {
+	b := newBuilder(9)
+	b.op(typeSet).str("s").length(2).str("a").str("b")
+	b.op(typeHash).str("h").length(1).str("f").str("v")
+	b.op(typeZset2).str("z").length(1).str("m")
+	binary.Write(b, binary.LittleEndian, math.Float64bits(1.5))
+	b.op(typeZset).str("oldz").length(2)
+	b.str("inf").op(254)
+	b.str("m").op(3)
+	b.WriteString("2.5")
+	b.op(typeModule2).str("mod").length(12345)
+	b.length(moduleOpUInt).length(7).length(moduleOpString).str("blob").length(moduleOpEOF)
+
+	_, entries := parseAll(t, b.end())
+	require.Len(t, entries, 5)
+	assert.Equal(t, "hashtable", entries[0].Encoding)
+	assert.Equal(t, []HashField{{Field: []byte("f"), Value: []byte("v")}}, entries[1].Value)
+	assert.Equal(t, "skiplist", entries[2].Encoding)
+	assert.Equal(t, []ZsetMember{{Member: []byte("m"), Score: 1.5}}, entries[2].Value)
+	members := entries[3].Value.([]ZsetMember)
+	require.Len(t, members, 2)
+	assert.True(t, math.IsInf(members[0].Score, 1))
+	assert.Equal(t, "module", entries[4].Type)
+	assert.Nil(t, entries[4].Value)
+}
+
+func TestParser_HashFieldExpiry(t *testing.T) {
+	b := newBuilder(12)
+	b.op(typeHashMetadata).str("h").millis(1000).length(2)
+	b.length(0).str("keep").str("1")
+	b.length(501).str("temp").str("2")
+	b.op(typeHashListpackEx).str("lp").millis(2000).str(listpack("a", "1", "0", "b", "2", "2500"))
+
+	_, entries := parseAll(t, b.end())
+	require.Len(t, entries, 2)
+	assert.Equal(t, []HashField{
+		{Field: []byte("keep"), Value: []byte("1")},
+		{Field: []byte("temp"), Value: []byte("2"), ExpireAt: 1500},
+	}, entries[0].Value)
+	assert.Equal(t, []HashField{
+		{Field: []byte("a"), Value: []byte("1")},
+		{Field: []byte("b"), Value: []byte("2"), ExpireAt: 2500},
+	}, entries[1].Value)
+}
+
+func TestParser_Stream(t *testing.T) {
+	master := make([]byte, 16)
+	binary.BigEndian.PutUint64(master, 1000)
+	node := listpack(
+		// Master entry: 2 live, 1 deleted, fields "f", terminator.
+		"2", "1", "1", "f", "0",
+		// Same fields as the master, ID 1000-0.
+		"2", "0", "0", "v1", "4",
+		// Deleted entry.
+		"3", "1", "0", "gone", "4",
+		// Own fields, ID 1005-1.
+		"0", "5", "1", "2", "a", "x", "b", "y", "7",
+	)
+
+	b := newBuilder(11)
+	b.op(typeStreamListpacks3).str("events").length(1).str(string(master)).str(node)
+	b.length(2)              // length
+	b.length(1005).length(1) // last ID
+	b.length(1000).length(0) // first ID
+	b.length(1001).length(0) // max deleted ID
+	b.length(3)              // entries added
+	b.length(1)              // groups
+	b.str("workers").length(1005).length(1).length(2)
+	b.length(1) // group PEL
+	b.Write(master)
+	b.millis(5000).length(1)
+	b.length(1) // consumers
+	b.str("w1").millis(6000).millis(7000).length(1)
+	b.Write(master)
+
+	_, entries := parseAll(t, b.end())
+	require.Len(t, entries, 1)
+	assert.Equal(t, "stream", entries[0].Type)
+	assert.Equal(t, int64(2), entries[0].Length)
+
+	stream := entries[0].Value.(*Stream)
+	assert.Equal(t, "1005-1", stream.LastID)
+	assert.Equal(t, "1000-0", stream.FirstID)
+	assert.Equal(t, int64(3), stream.EntriesAdded)
+	assert.Equal(t, []StreamEntry{
+		{ID: "1000-0", Fields: []StreamField{{Name: []byte("f"), Value: []byte("v1")}}},
+		{ID: "1005-1", Fields: []StreamField{{Name: []byte("a"), Value: []byte("x")}, {Name: []byte("b"), Value: []byte("y")}}},
+	}, stream.Entries)
+	assert.Equal(t, []StreamGroup{{
+		Name: "workers", LastID: "1005-1", EntriesRead: 2, Pending: 1,
+		Consumers: []StreamConsumer{{Name: "w1", SeenTime: 6000, ActiveTime: 7000, Pending: 1}},
+	}}, stream.Groups)
+}
+
+func TestNewParser_Header(t *testing.T) {
+	_, err := NewParser(strings.NewReader("REDIS0013"))
+	assert.EqualError(t, err, "unsupported RDB version 13")
+
+	_, err = NewParser(strings.NewReader("HELLO0009"))
+	assert.Error(t, err)
+
+	p, err := NewParser(strings.NewReader("VALKEY080"))
+	require.NoError(t, err)
+	assert.Equal(t, 80, p.Version())
+}
+
+// fixture is the server's own view of the keys of a testdata dump, written
+// next to it by testdata/generate.sh.
+type fixture struct {
+	Version int `json:"rdb_version"`
+	Keys    []struct {
+		DB       int    `json:"db"`
+		Key      string `json:"key"`
+		Type     string `json:"type"`
+		Encoding string `json:"encoding"`
+		Length   int64  `json:"length"`
+		ExpireAt int64  `json:"expire_at"`
+	} `json:"keys"`
+}
+
+func TestParser_ServerFixtures(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "*.rdb"))
+	require.NoError(t, err)
+	if len(paths) == 0 {
+		t.Skip("no server-saved fixtures in testdata; run testdata/generate.sh")
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := os.ReadFile(strings.TrimSuffix(path, ".rdb") + ".json")
+			require.NoError(t, err)
+			var want fixture
+			require.NoError(t, json.Unmarshal(data, &want))
+
+			f, err := os.Open(path)
+			require.NoError(t, err)
+			defer f.Close()
+			p, err := NewParser(f)
+			require.NoError(t, err)
+			assert.Equal(t, want.Version, p.Version())
+
+			got := make(map[string]*Entry)
+			for {
+				entry, err := p.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				got[strconv.Itoa(entry.DB)+"/"+entry.Key] = entry
+			}
+			require.Len(t, got, len(want.Keys))
+			for _, key := range want.Keys {
+				entry := got[strconv.Itoa(key.DB)+"/"+key.Key]
+				require.NotNil(t, entry, key.Key)
+				assert.Equal(t, key.Type, entry.Type, key.Key)
+				assert.Equal(t, key.Encoding, entry.Encoding, key.Key)
+				assert.Equal(t, key.Length, entry.Length, key.Key)
+				assert.Equal(t, key.ExpireAt, entry.ExpireAt, key.Key)
+			}
+		})
+	}
+}
+
+func TestParser_Truncated(t *testing.T) {
+	data := newBuilder(9).op(typeString).str("key").str("value").Bytes()
+	p, err := NewParser(bytes.NewReader(data[:len(data)-2]))
+	require.NoError(t, err)
+
+	_, err = p.Next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestAnalyze(t *testing.T) {
+	b := newBuilder(11)
+	b.op(opAux).str("ctime").str("1700000000")
+	b.op(typeString).str("user:1").str("alice")
+	b.op(opExpireMillis).millis(1700000000000 + 30*60*1000)
+	b.op(typeSetListpack).str("user:2").str(listpack("a", "b"))
+	b.op(opExpireMillis).millis(1600000000000)
+	b.op(typeString).str("user:expired").str("x")
+	b.op(opSelectDB).length(1)
+	b.op(typeString).str("other").str("y")
+
+	report, err := Analyze(context.Background(), bytes.NewReader(b.end()), AnalyzeOptions{DB: -1})
+	require.NoError(t, err)
+	assert.Equal(t, 11, report.Version)
+	assert.Equal(t, time.Unix(1700000000, 0), report.SnapshotTime)
+	assert.Equal(t, int64(3), report.Keys)
+	assert.Equal(t, int64(1), report.ExpiredKeys)
+	assert.Equal(t, map[int]int64{0: 2, 1: 1}, report.Databases)
+	require.Len(t, report.Groups, 2)
+	assert.Equal(t, "user:", report.Groups[0].Prefix)
+	assert.Equal(t, map[string]int64{"string": 1, "set": 1}, report.Groups[0].Types)
+	assert.Equal(t, int64(1), report.Groups[0].TTL.UnderHour)
+	assert.Positive(t, report.MemoryBytes)
+
+	report, err = Analyze(context.Background(), bytes.NewReader(b.end()), AnalyzeOptions{DB: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.Keys)
+}
+
+func TestAnalyze_ReportsProgress(t *testing.T) {
+	b := newBuilder(11)
+	for i := 0; i < 2500; i++ {
+		b.op(typeString).str("key:" + strconv.Itoa(i)).str("value")
+	}
+	data := b.end()
+	path := filepath.Join(t.TempDir(), "dump.rdb")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var reports [][2]float64
+	ctx := progress.WithReporter(context.Background(), func(ctx context.Context, processed, total float64, message string) {
+		reports = append(reports, [2]float64{processed, total})
+	})
+	report, err := Analyze(ctx, f, AnalyzeOptions{DB: -1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2500), report.Keys)
+	assert.False(t, report.Partial)
+
+	size := float64(len(data))
+	require.Len(t, reports, 4)
+	for i, r := range reports {
+		assert.Equal(t, size, r[1])
+		if i > 0 {
+			assert.Greater(t, r[0], reports[i-1][0])
+		}
+	}
+	assert.Equal(t, size, reports[3][0])
+}
+
+func TestAnalyze_CancelledReturnsPartial(t *testing.T) {
+	b := newBuilder(11)
+	b.op(typeString).str("user:1").str("alice")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err := Analyze(ctx, bytes.NewReader(b.end()), AnalyzeOptions{DB: -1})
+	require.NoError(t, err)
+	assert.True(t, report.Partial)
+	assert.Equal(t, int64(0), report.Keys)
+}
+
+func TestMemoryEstimate(t *testing.T) {
+	small := &Entry{Key: "k", Encoding: "embstr", Value: []byte("v")}
+	big := &Entry{Key: "k", Encoding: "raw", Value: []byte(strings.Repeat("v", 1000))}
+	assert.Greater(t, big.MemoryEstimate(), small.MemoryEstimate()+1000)
+
+	table := &Entry{Key: "k", Encoding: "hashtable", Value: [][]byte{[]byte("a"), []byte("b")}, SerializedBytes: 5}
+	packed := &Entry{Key: "k", Encoding: "listpack", Value: [][]byte{[]byte("a"), []byte("b")}, SerializedBytes: 5}
+	assert.Greater(t, table.MemoryEstimate(), packed.MemoryEstimate())
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dump.rdb"), newBuilder(9).end(), 0o600))
+
+	f, err := Open(dir, "dump.rdb")
+	require.NoError(t, err)
+	f.Close()
+
+	_, err = Open(dir, "../dump.rdb")
+	assert.Error(t, err)
+}
@@ -0,0 +1,346 @@
+package rdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// Stream entry flags in a listpack node.
+const (
+	streamItemDeleted    = 1 << 0
+	streamItemSameFields = 1 << 1
+)
+
+// Stream is a decoded stream value.
+type Stream struct {
+	Length int64
+	LastID string
+	// FirstID, MaxDeletedID and EntriesAdded are saved from RDB version 10.
+	FirstID      string
+	MaxDeletedID string
+	EntriesAdded int64
+	Entries      []StreamEntry
+	Groups       []StreamGroup
+}
+
+// StreamEntry is a stream entry with its fields in order.
+type StreamEntry struct {
+	ID     string
+	Fields []StreamField
+}
+
+// StreamField is a field of a stream entry.
+type StreamField struct {
+	Name  []byte
+	Value []byte
+}
+
+// StreamGroup summarises a consumer group.
+type StreamGroup struct {
+	Name   string
+	LastID string
+	// EntriesRead is saved from RDB version 10.
+	EntriesRead int64
+	Pending     int64
+	Consumers   []StreamConsumer
+}
+
+// StreamConsumer summarises a consumer of a group.
+type StreamConsumer struct {
+	Name string
+	// SeenTime and ActiveTime are Unix milliseconds; ActiveTime is saved
+	// from RDB version 11.
+	SeenTime   int64
+	ActiveTime int64
+	Pending    int64
+}
+
+// streamID is a stream entry ID.
+type streamID struct {
+	ms, seq uint64
+}
+
+func (id streamID) String() string {
+	return strconv.FormatUint(id.ms, 10) + "-" + strconv.FormatUint(id.seq, 10)
+}
+
+// readStreamID reads an ID saved as two lengths.
+func (p *Parser) readStreamID() (streamID, error) {
+	ms, err := p.r.readLen()
+	if err != nil {
+		return streamID{}, err
+	}
+	seq, err := p.r.readLen()
+	if err != nil {
+		return streamID{}, err
+	}
+	return streamID{ms, seq}, nil
+}
+
+// readRawStreamID reads an ID saved as 16 big-endian bytes.
+func (p *Parser) readRawStreamID() (streamID, error) {
+	b, err := p.r.readBytes(16)
+	if err != nil {
+		return streamID{}, err
+	}
+	return streamID{binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:])}, nil
+}
+
+// readStream reads a stream saved as a radix tree of listpacks followed by
+// its metadata and consumer groups.
+func (p *Parser) readStream(valueType byte, entry *Entry) error {
+	stream := &Stream{}
+	nodes, err := p.r.readLen()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < nodes; i++ {
+		key, _, err := p.r.readString()
+		if err != nil {
+			return err
+		}
+		if len(key) != 16 {
+			return fmt.Errorf("invalid stream node key of %d bytes", len(key))
+		}
+		master := streamID{binary.BigEndian.Uint64(key[:8]), binary.BigEndian.Uint64(key[8:])}
+		blob, err := p.r.readBlob()
+		if err != nil {
+			return err
+		}
+		elements, err := decodeListpack(blob)
+		if err != nil {
+			return err
+		}
+		if len(elements) == 0 {
+			continue
+		}
+		entries, err := decodeStreamNode(master, elements)
+		if err != nil {
+			return err
+		}
+		stream.Entries = append(stream.Entries, entries...)
+	}
+
+	length, err := p.r.readLen()
+	if err != nil {
+		return err
+	}
+	stream.Length = int64(length)
+	lastID, err := p.readStreamID()
+	if err != nil {
+		return err
+	}
+	stream.LastID = lastID.String()
+	stream.EntriesAdded = stream.Length
+
+	v2 := valueType >= typeStreamListpacks2
+	if v2 {
+		firstID, err := p.readStreamID()
+		if err != nil {
+			return err
+		}
+		maxDeleted, err := p.readStreamID()
+		if err != nil {
+			return err
+		}
+		added, err := p.r.readLen()
+		if err != nil {
+			return err
+		}
+		stream.FirstID, stream.MaxDeletedID, stream.EntriesAdded = firstID.String(), maxDeleted.String(), int64(added)
+	}
+
+	groups, err := p.r.readLen()
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < groups; i++ {
+		group, err := p.readStreamGroup(valueType)
+		if err != nil {
+			return err
+		}
+		stream.Groups = append(stream.Groups, group)
+	}
+
+	entry.Type, entry.Encoding, entry.Value = "stream", "stream", stream
+	entry.Length = stream.Length
+	return nil
+}
+
+// readStreamGroup reads a consumer group with its pending entries list and
+// consumers.
+func (p *Parser) readStreamGroup(valueType byte) (StreamGroup, error) {
+	var group StreamGroup
+	name, _, err := p.r.readString()
+	if err != nil {
+		return group, err
+	}
+	group.Name = string(name)
+	lastID, err := p.readStreamID()
+	if err != nil {
+		return group, err
+	}
+	group.LastID = lastID.String()
+	if valueType >= typeStreamListpacks2 {
+		read, err := p.r.readLen()
+		if err != nil {
+			return group, err
+		}
+		group.EntriesRead = int64(read)
+	}
+
+	pending, err := p.r.readLen()
+	if err != nil {
+		return group, err
+	}
+	group.Pending = int64(pending)
+	for i := uint64(0); i < pending; i++ {
+		// Entry ID, delivery time and delivery count.
+		if _, err := p.readRawStreamID(); err != nil {
+			return group, err
+		}
+		if _, err := p.r.readMillis(); err != nil {
+			return group, err
+		}
+		if _, err := p.r.readLen(); err != nil {
+			return group, err
+		}
+	}
+
+	consumers, err := p.r.readLen()
+	if err != nil {
+		return group, err
+	}
+	for i := uint64(0); i < consumers; i++ {
+		var consumer StreamConsumer
+		name, _, err := p.r.readString()
+		if err != nil {
+			return group, err
+		}
+		consumer.Name = string(name)
+		if consumer.SeenTime, err = p.r.readMillis(); err != nil {
+			return group, err
+		}
+		if valueType >= typeStreamListpacks3 {
+			if consumer.ActiveTime, err = p.r.readMillis(); err != nil {
+				return group, err
+			}
+		}
+		pending, err := p.r.readLen()
+		if err != nil {
+			return group, err
+		}
+		consumer.Pending = int64(pending)
+		for j := uint64(0); j < pending; j++ {
+			if _, err := p.readRawStreamID(); err != nil {
+				return group, err
+			}
+		}
+		group.Consumers = append(group.Consumers, consumer)
+	}
+	return group, nil
+}
+
+// decodeStreamNode returns the live entries of a listpack node. The node
+// starts with a master entry naming the fields that entries flagged
+// streamItemSameFields share; entry IDs are stored relative to master.
+func decodeStreamNode(master streamID, elements [][]byte) ([]StreamEntry, error) {
+	pos := 0
+	next := func() ([]byte, error) {
+		if pos >= len(elements) {
+			return nil, errCorrupt("stream node")
+		}
+		pos++
+		return elements[pos-1], nil
+	}
+	nextInt := func() (int64, error) {
+		b, err := next()
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.ParseInt(string(b), 10, 64)
+		if err != nil {
+			return 0, errCorrupt("stream node")
+		}
+		return n, nil
+	}
+
+	// Master entry: live count, deleted count, fields, then a 0 terminator.
+	if _, err := nextInt(); err != nil {
+		return nil, err
+	}
+	if _, err := nextInt(); err != nil {
+		return nil, err
+	}
+	fieldCount, err := nextInt()
+	if err != nil {
+		return nil, err
+	}
+	if fieldCount < 0 {
+		return nil, errCorrupt("stream node")
+	}
+	masterFields := make([][]byte, 0, min(fieldCount, maxPrealloc))
+	for i := int64(0); i < fieldCount; i++ {
+		field, err := next()
+		if err != nil {
+			return nil, err
+		}
+		masterFields = append(masterFields, field)
+	}
+	if _, err := nextInt(); err != nil {
+		return nil, err
+	}
+
+	var entries []StreamEntry
+	for pos < len(elements) {
+		flags, err := nextInt()
+		if err != nil {
+			return nil, err
+		}
+		msDiff, err := nextInt()
+		if err != nil {
+			return nil, err
+		}
+		seqDiff, err := nextInt()
+		if err != nil {
+			return nil, err
+		}
+		id := streamID{master.ms + uint64(msDiff), master.seq + uint64(seqDiff)}
+
+		var fields []StreamField
+		if flags&streamItemSameFields != 0 {
+			for _, name := range masterFields {
+				value, err := next()
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, StreamField{Name: name, Value: value})
+			}
+		} else {
+			count, err := nextInt()
+			if err != nil {
+				return nil, err
+			}
+			for i := int64(0); i < count; i++ {
+				name, err := next()
+				if err != nil {
+					return nil, err
+				}
+				value, err := next()
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, StreamField{Name: name, Value: value})
+			}
+		}
+		// Each entry ends with the number of listpack elements it used.
+		if _, err := nextInt(); err != nil {
+			return nil, err
+		}
+		if flags&streamItemDeleted == 0 {
+			entries = append(entries, StreamEntry{ID: id.String(), Fields: fields})
+		}
+	}
+	return entries, nil
+}
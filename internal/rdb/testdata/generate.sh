@@ -0,0 +1,76 @@
+#!/bin/sh
+# generate.sh writes RDB fixtures saved by real servers for rdb_test.go. For
+# each image below it loads the same keys, saves NAME.rdb and writes NAME.json
+# with the RDB version and every key's type, OBJECT ENCODING, length and
+# expiry as the server reports them. It needs docker.
+#
+# Usage: ./generate.sh
+set -eu
+cd "$(dirname "$0")"
+
+# expireAt is the expiry given to str:short, in Unix milliseconds.
+expireAt=4102444800000
+
+generate() {
+	name=$1 image=$2 cli=$3
+	container=$(docker run -d --rm "$image")
+	trap 'docker rm -f "$container" >/dev/null' EXIT
+	c() { docker exec "$container" "$cli" "$@"; }
+	until c PING >/dev/null 2>&1; do sleep 0.2; done
+
+	c SET str:int 12345 >/dev/null
+	c SET str:short hello >/dev/null
+	c PEXPIREAT str:short "$expireAt" >/dev/null
+	c SET str:long "$(printf '%0100d' 0)" >/dev/null
+	# Elements over 8 KB in total keep the list a quicklist in memory.
+	c RPUSH list:big $(seq -f 'item-%050g' 1 200) >/dev/null
+	c SADD set:ints 1 2 3 >/dev/null
+	c SADD set:small a b c >/dev/null
+	c SADD set:big $(seq -f 'm%g' 1 600) >/dev/null
+	c ZADD zset:small 1 a 2 b 3.5 c >/dev/null
+	c ZADD zset:big $(seq 1 200 | sed 's/.*/& m&/') >/dev/null
+	c HSET hash:small f1 v1 f2 v2 >/dev/null
+	c HSET hash:big $(seq 1 600 | sed 's/.*/f& v&/') >/dev/null
+	c XADD stream:events 1-1 a 1 >/dev/null
+	c XADD stream:events 1-2 b 2 >/dev/null
+	c XADD stream:events 1-3 c 3 >/dev/null
+	c XGROUP CREATE stream:events workers 0 >/dev/null
+	c -n 1 SET db1:key x >/dev/null
+	c SAVE >/dev/null
+	docker cp "$container:/data/dump.rdb" "$name.rdb" >/dev/null
+
+	version=$(head -c 9 "$name.rdb" | sed 's/^REDIS0*//; s/^VALKEY0*//')
+	{
+		printf '{\n  "rdb_version": %s,\n  "keys": [' "$version"
+		sep=
+		for db in 0 1; do
+			for key in $(c -n "$db" --raw KEYS '*' | sort); do
+				type=$(c -n "$db" --raw TYPE "$key")
+				case $type in
+				string) length=$(c -n "$db" --raw STRLEN "$key") ;;
+				list) length=$(c -n "$db" --raw LLEN "$key") ;;
+				set) length=$(c -n "$db" --raw SCARD "$key") ;;
+				zset) length=$(c -n "$db" --raw ZCARD "$key") ;;
+				hash) length=$(c -n "$db" --raw HLEN "$key") ;;
+				stream) length=$(c -n "$db" --raw XLEN "$key") ;;
+				esac
+				expire=0
+				if [ "$(c -n "$db" --raw PTTL "$key")" -gt 0 ]; then
+					expire=$expireAt
+				fi
+				printf '%s\n    {"db": %s, "key": "%s", "type": "%s", "encoding": "%s", "length": %s, "expire_at": %s}' \
+					"$sep" "$db" "$key" "$type" "$(c -n "$db" --raw OBJECT ENCODING "$key")" "$length" "$expire"
+				sep=,
+			done
+		done
+		printf '\n  ]\n}\n'
+	} >"$name.json"
+
+	docker rm -f "$container" >/dev/null
+	trap - EXIT
+}
+
+generate redis-6.2 redis:6.2 redis-cli
+generate redis-7.0 redis:7.0 redis-cli
+generate redis-7.2 redis:7.2 redis-cli
+generate valkey-8.0 valkey/valkey:8.0 valkey-cli
@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// DryRunArgument is the argument through which clients ask for a preview of
+// a mutating call instead of running it.
+const DryRunArgument = "dry_run"
+
+// Previewer is implemented by tools that write data. Preview reports what a
+// call would change without changing anything.
+type Previewer interface {
+	Preview(ctx context.Context, input json.RawMessage) (interface{}, error)
+}
+
+// DryRunResult is returned instead of running a previewed call.
+type DryRunResult struct {
+	DryRun  bool        `json:"dry_run"`
+	Tool    string      `json:"tool"`
+	Preview interface{} `json:"preview"`
+}
+
+// SetDryRun makes every call to a tool that implements Previewer return a
+// preview instead of running, whatever its dry_run argument says.
+func (r *ToolRegistry) SetDryRun(enabled bool) {
+	r.dryRun = enabled
+}
+
+// DryRun reports whether server-wide dry-run mode is enabled.
+func (r *ToolRegistry) DryRun() bool {
+	return r.dryRun
+}
+
+// preview runs tool's Preview and wraps the result.
+func preview(ctx context.Context, tool Tool, input json.RawMessage) (interface{}, error) {
+	result, err := tool.(Previewer).Preview(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return &DryRunResult{DryRun: true, Tool: tool.Name(), Preview: result}, nil
+}
@@ -0,0 +1,104 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// previewTool records whether it ran or was previewed.
+type previewTool struct {
+	mockTool
+	ran          bool
+	previewInput json.RawMessage
+}
+
+func (p *previewTool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	p.ran = true
+	return map[string]interface{}{"written": true}, nil
+}
+
+func (p *previewTool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	p.previewInput = input
+	return map[string]interface{}{"summary": "would write"}, nil
+}
+
+func connectRegistry(t *testing.T, reg *ToolRegistry) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	require.NoError(t, reg.RegisterWithMCP(server))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { serverSession.Close() })
+
+	c := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, nil)
+	session, err := c.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func TestDryRun_Argument(t *testing.T) {
+	tool := &previewTool{mockTool: mockTool{name: "write"}}
+	reg := NewToolRegistry()
+	reg.MustRegister(tool)
+	session := connectRegistry(t, reg)
+	ctx := context.Background()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "write", Arguments: map[string]any{"key": "a", DryRunArgument: true}})
+	require.NoError(t, err)
+	assert.False(t, tool.ran)
+	assert.JSONEq(t, `{"key":"a"}`, string(tool.previewInput))
+	structured := res.StructuredContent.(map[string]any)
+	assert.Equal(t, true, structured["dry_run"])
+	assert.Equal(t, "write", structured["tool"])
+	assert.Equal(t, map[string]any{"summary": "would write"}, structured["preview"])
+
+	_, err = session.CallTool(ctx, &mcp.CallToolParams{Name: "write", Arguments: map[string]any{"key": "a", DryRunArgument: false}})
+	require.NoError(t, err)
+	assert.True(t, tool.ran)
+}
+
+func TestDryRun_ServerWide(t *testing.T) {
+	tool := &previewTool{mockTool: mockTool{name: "write"}}
+	reader := &mockTool{name: "read"}
+	reg := NewToolRegistry()
+	reg.MustRegister(tool)
+	reg.MustRegister(reader)
+	reg.SetDryRun(true)
+	session := connectRegistry(t, reg)
+	ctx := context.Background()
+
+	// The argument cannot turn server-wide dry-run off.
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "write", Arguments: map[string]any{DryRunArgument: false}})
+	require.NoError(t, err)
+	assert.False(t, tool.ran)
+	assert.Equal(t, true, res.StructuredContent.(map[string]any)["dry_run"])
+
+	// Tools that do not write run normally.
+	res, err = session.CallTool(ctx, &mcp.CallToolParams{Name: "read", Arguments: map[string]any{}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"result": "success"}, res.StructuredContent)
+}
+
+func TestDryRun_Schema(t *testing.T) {
+	reg := NewToolRegistry()
+	reg.MustRegister(&previewTool{mockTool: mockTool{name: "write"}})
+	reg.MustRegister(&mockTool{name: "read"})
+	session := connectRegistry(t, reg)
+
+	tools, err := session.ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	for _, tool := range tools.Tools {
+		properties := tool.InputSchema.(map[string]any)["properties"].(map[string]any)
+		_, ok := properties[DryRunArgument]
+		assert.Equal(t, tool.Name == "write", ok, tool.Name)
+	}
+}
@@ -0,0 +1,201 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats for the human-readable text content of tool results.
+const (
+	FormatJSON  = "json"
+	FormatTable = "table"
+)
+
+// formatArgument is the per-call argument that overrides the default output format.
+const formatArgument = "format"
+
+// ValidateFormat checks that format is a supported output format.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatJSON, FormatTable:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format %q: must be %s or %s", format, FormatJSON, FormatTable)
+	}
+}
+
+// normalizeResult marshals a tool result and returns its compact JSON encoding
+// together with a generic decoded value (map, slice or scalar).
+func normalizeResult(result interface{}) ([]byte, interface{}, error) {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(resultJSON, &decoded); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode result: %w", err)
+	}
+	return resultJSON, decoded, nil
+}
+
+// structuredContent returns the value sent as structuredContent.
+// MCP requires an object there, so non-object results are wrapped as {"result": value}.
+func structuredContent(decoded interface{}) map[string]interface{} {
+	if m, ok := decoded.(map[string]interface{}); ok {
+		return m
+	}
+	return map[string]interface{}{"result": decoded}
+}
+
+// renderText renders a decoded result as text in the requested format.
+func renderText(resultJSON []byte, decoded interface{}, format string) string {
+	if format != FormatTable {
+		return string(resultJSON)
+	}
+
+	var buf bytes.Buffer
+	switch v := decoded.(type) {
+	case map[string]interface{}:
+		renderObject(&buf, v)
+	case []interface{}:
+		renderArray(&buf, v)
+	default:
+		buf.WriteString(scalarText(v))
+		buf.WriteString("\n")
+	}
+	return strings.TrimRight(buf.String(), "\n")
+}
+
+// renderObject writes scalar fields as aligned "key  value" rows, followed by
+// one section per nested array or object.
+func renderObject(buf *bytes.Buffer, obj map[string]interface{}) {
+	keys := sortedKeys(obj)
+
+	tw := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	var nested []string
+	for _, k := range keys {
+		switch obj[k].(type) {
+		case map[string]interface{}, []interface{}:
+			nested = append(nested, k)
+		default:
+			fmt.Fprintf(tw, "%s\t%s\n", k, scalarText(obj[k]))
+		}
+	}
+	tw.Flush()
+
+	for _, k := range nested {
+		buf.WriteString("\n")
+		buf.WriteString(k)
+		buf.WriteString(":\n")
+		switch v := obj[k].(type) {
+		case map[string]interface{}:
+			renderFlatObject(buf, v)
+		case []interface{}:
+			renderArray(buf, v)
+		}
+	}
+}
+
+// renderFlatObject writes every field of obj as a "key  value" row.
+func renderFlatObject(buf *bytes.Buffer, obj map[string]interface{}) {
+	if len(obj) == 0 {
+		buf.WriteString("(empty)\n")
+		return
+	}
+	tw := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	for _, k := range sortedKeys(obj) {
+		fmt.Fprintf(tw, "%s\t%s\n", k, cellText(obj[k]))
+	}
+	tw.Flush()
+}
+
+// renderArray writes an array of objects as a table with one column per field,
+// and any other array as one element per row.
+func renderArray(buf *bytes.Buffer, arr []interface{}) {
+	if len(arr) == 0 {
+		buf.WriteString("(empty)\n")
+		return
+	}
+
+	columns, ok := objectColumns(arr)
+	tw := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	if !ok {
+		for _, elem := range arr {
+			fmt.Fprintf(tw, "%s\n", cellText(elem))
+		}
+		tw.Flush()
+		return
+	}
+
+	fmt.Fprintln(tw, strings.Join(columns, "\t"))
+	for _, elem := range arr {
+		row := elem.(map[string]interface{})
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			if v, exists := row[col]; exists {
+				cells[i] = cellText(v)
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	tw.Flush()
+}
+
+// objectColumns returns the sorted union of field names when every element
+// of arr is an object.
+func objectColumns(arr []interface{}) ([]string, bool) {
+	seen := make(map[string]bool)
+	for _, elem := range arr {
+		row, ok := elem.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		for k := range row {
+			seen[k] = true
+		}
+	}
+	columns := make([]string, 0, len(seen))
+	for k := range seen {
+		columns = append(columns, k)
+	}
+	sort.Strings(columns)
+	return columns, true
+}
+
+// cellText renders a value for a single table cell; nested values stay compact JSON.
+func cellText(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	default:
+		return scalarText(v)
+	}
+}
+
+// scalarText renders a JSON scalar without quoting strings.
+func scalarText(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return "(nil)"
+	case string:
+		return strings.ReplaceAll(s, "\n", `\n`)
+	default:
+		b, _ := json.Marshal(s)
+		return string(b)
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
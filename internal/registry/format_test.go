@@ -0,0 +1,143 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connect registers reg with a fresh MCP server and returns a connected client session.
+func connect(t *testing.T, reg *ToolRegistry) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	require.NoError(t, reg.RegisterWithMCP(server))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { serverSession.Close() })
+
+	c := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, nil)
+	session, err := c.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { session.Close() })
+	return session
+}
+
+func textOf(t *testing.T, res *mcp.CallToolResult) string {
+	t.Helper()
+	require.Len(t, res.Content, 1)
+	text, ok := res.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	return text.Text
+}
+
+func TestRegisterWithMCP_ObjectResult(t *testing.T) {
+	reg := NewToolRegistry()
+	reg.MustRegister(&mockTool{
+		name: "obj",
+		execFunc: func(ctx context.Context, input json.RawMessage) (interface{}, error) {
+			return map[string]interface{}{"key": "k", "count": 2}, nil
+		},
+	})
+	session := connect(t, reg)
+
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "obj"})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.JSONEq(t, `{"key":"k","count":2}`, textOf(t, res))
+
+	structured, ok := res.StructuredContent.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "k", structured["key"])
+}
+
+func TestRegisterWithMCP_NonObjectResult(t *testing.T) {
+	reg := NewToolRegistry()
+	reg.MustRegister(&mockTool{
+		name: "list",
+		execFunc: func(ctx context.Context, input json.RawMessage) (interface{}, error) {
+			return []string{"a", "b"}, nil
+		},
+	})
+	session := connect(t, reg)
+
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: "list"})
+	require.NoError(t, err)
+	assert.False(t, res.IsError)
+	assert.Equal(t, `["a","b"]`, textOf(t, res))
+
+	structured, ok := res.StructuredContent.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{"a", "b"}, structured["result"])
+}
+
+func TestRegisterWithMCP_FormatArgument(t *testing.T) {
+	reg := NewToolRegistry()
+	var received json.RawMessage
+	reg.MustRegister(&mockTool{
+		name: "rows",
+		execFunc: func(ctx context.Context, input json.RawMessage) (interface{}, error) {
+			received = input
+			return map[string]interface{}{
+				"count": 2,
+				"items": []map[string]interface{}{
+					{"key": "a", "ttl": -1},
+					{"key": "b", "ttl": 30},
+				},
+			}, nil
+		},
+	})
+	session := connect(t, reg)
+
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "rows",
+		Arguments: map[string]interface{}{"format": "table", "pattern": "*"},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pattern":"*"}`, string(received))
+	assert.Equal(t, "count  2\n\nitems:\nkey  ttl\na    -1\nb    30", textOf(t, res))
+}
+
+func TestRegisterWithMCP_InvalidFormat(t *testing.T) {
+	reg := NewToolRegistry()
+	reg.MustRegister(&mockTool{name: "obj"})
+	session := connect(t, reg)
+
+	// The advertised enum rejects unknown formats before the tool runs.
+	_, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "obj",
+		Arguments: map[string]interface{}{"format": "xml"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "format")
+}
+
+func TestToolRegistry_SetOutputFormat(t *testing.T) {
+	reg := NewToolRegistry()
+	assert.Equal(t, FormatJSON, reg.OutputFormat())
+
+	require.NoError(t, reg.SetOutputFormat(FormatTable))
+	assert.Equal(t, FormatTable, reg.OutputFormat())
+
+	assert.Error(t, reg.SetOutputFormat("yaml"))
+	assert.Equal(t, FormatTable, reg.OutputFormat())
+}
+
+func TestWithFormatProperty_KeepsToolOwnFormat(t *testing.T) {
+	schema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"format": map[string]interface{}{"type": "string"},
+		},
+	}
+	out, handled := withFormatProperty(schema)
+	assert.False(t, handled)
+	assert.Equal(t, schema, out)
+}
@@ -0,0 +1,77 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ConfirmTokenArgument is the argument through which clients without
+// elicitation support confirm a destructive call.
+const ConfirmTokenArgument = "confirm_token"
+
+// Assessor is implemented by tools whose calls can destroy or overwrite
+// data. Assess describes what a call would do without doing it.
+type Assessor interface {
+	Assess(ctx context.Context, input json.RawMessage) (*Impact, error)
+}
+
+// Impact describes the effect of a potentially destructive call.
+type Impact struct {
+	// Summary is a short human-readable description of the change.
+	Summary string
+	// Keys are the keys the call deletes or modifies.
+	Keys []string
+	// Overwrites are existing keys the call replaces.
+	Overwrites []string
+	// ConfigParameters are the configuration parameters the call changes.
+	ConfigParameters []string
+}
+
+// Guard decides whether calls to assessed tools may run.
+type Guard interface {
+	// Check returns (nil, nil) to let the call run. Otherwise it returns
+	// either a result to send instead of running the tool, such as a
+	// confirmation request, or an error wrapping ErrPolicyDenied.
+	Check(ctx context.Context, call *GuardedCall) (interface{}, error)
+}
+
+// GuardedCall is a call to an assessed tool awaiting a Guard decision.
+type GuardedCall struct {
+	Session *mcp.ServerSession
+	Tool    string
+	// Input holds the call arguments without the confirm token.
+	Input        json.RawMessage
+	ConfirmToken string
+	Impact       *Impact
+}
+
+// SetGuard installs a guard consulted before every call to a tool that
+// implements Assessor. It must be called before RegisterWithMCP.
+func (r *ToolRegistry) SetGuard(g Guard) {
+	r.guard = g
+}
+
+// checkGuard runs the registry guard for tool. It returns a non-nil result or
+// error when the call must not run.
+func (r *ToolRegistry) checkGuard(ctx context.Context, session *mcp.ServerSession, tool Tool, input json.RawMessage, token string) (interface{}, error) {
+	assessor, ok := tool.(Assessor)
+	if !ok || r.guard == nil {
+		return nil, nil
+	}
+	impact, err := assessor.Assess(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if impact == nil {
+		return nil, nil
+	}
+	return r.guard.Check(ctx, &GuardedCall{
+		Session:      session,
+		Tool:         tool.Name(),
+		Input:        input,
+		ConfirmToken: token,
+		Impact:       impact,
+	})
+}
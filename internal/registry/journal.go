@@ -0,0 +1,35 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Journal records the state of keys before write tools change them, so that
+// the changes can be undone.
+type Journal interface {
+	// Record snapshots the keys a call to tool is about to change. The
+	// returned function must be called with the outcome of the call.
+	Record(ctx context.Context, tool string, input json.RawMessage) (func(err error), error)
+}
+
+// SetJournal installs a journal consulted before every call to a tool that
+// implements Previewer. Previewed calls are not recorded.
+func (r *ToolRegistry) SetJournal(j Journal) {
+	r.journal = j
+}
+
+// execute runs tool, recording the call in the registry journal when tool
+// writes data.
+func (r *ToolRegistry) execute(ctx context.Context, tool Tool, input json.RawMessage) (interface{}, error) {
+	if _, ok := tool.(Previewer); !ok || r.journal == nil {
+		return tool.Execute(ctx, input)
+	}
+	done, err := r.journal.Record(ctx, tool.Name(), input)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tool.Execute(ctx, input)
+	done(err)
+	return result, err
+}
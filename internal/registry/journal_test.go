@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingJournal records the calls it is asked to journal.
+type recordingJournal struct {
+	tools []string
+	done  int
+}
+
+func (j *recordingJournal) Record(ctx context.Context, tool string, input json.RawMessage) (func(error), error) {
+	j.tools = append(j.tools, tool)
+	return func(error) { j.done++ }, nil
+}
+
+func TestJournal_RecordsWrites(t *testing.T) {
+	journal := &recordingJournal{}
+	reg := NewToolRegistry()
+	reg.MustRegister(&previewTool{mockTool: mockTool{name: "write"}})
+	reg.MustRegister(&mockTool{name: "read"})
+	reg.SetJournal(journal)
+	session := connectRegistry(t, reg)
+	ctx := context.Background()
+
+	for _, params := range []*mcp.CallToolParams{
+		{Name: "write", Arguments: map[string]any{"key": "a"}},
+		{Name: "write", Arguments: map[string]any{"key": "a", DryRunArgument: true}},
+		{Name: "read", Arguments: map[string]any{}},
+	} {
+		_, err := session.CallTool(ctx, params)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"write"}, journal.tools)
+	assert.Equal(t, 1, journal.done)
+}
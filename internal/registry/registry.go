@@ -4,11 +4,19 @@ package registry
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/logging"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
 )
 
+// ErrPolicyDenied is wrapped by tool errors that refuse a call because a
+// server policy forbids it. Denials are logged as warnings rather than errors.
+var ErrPolicyDenied = errors.New("denied by policy")
+
 // Tool represents a single MCP tool.
 type Tool interface {
 	Name() string
@@ -19,16 +27,36 @@ type Tool interface {
 
 // ToolRegistry manages tool registration and lifecycle.
 type ToolRegistry struct {
-	tools map[string]Tool
+	tools        map[string]Tool
+	outputFormat string
+	guard        Guard
+	dryRun       bool
+	journal      Journal
 }
 
 // NewToolRegistry creates a new tool registry.
 func NewToolRegistry() *ToolRegistry {
 	return &ToolRegistry{
-		tools: make(map[string]Tool),
+		tools:        make(map[string]Tool),
+		outputFormat: FormatJSON,
 	}
 }
 
+// SetOutputFormat sets the default format of the text content returned with
+// every tool result. Callers can override it per call with the "format" argument.
+func (r *ToolRegistry) SetOutputFormat(format string) error {
+	if err := ValidateFormat(format); err != nil {
+		return err
+	}
+	r.outputFormat = format
+	return nil
+}
+
+// OutputFormat returns the default output format.
+func (r *ToolRegistry) OutputFormat() string {
+	return r.outputFormat
+}
+
 // Register adds a tool to the registry.
 func (r *ToolRegistry) Register(tool Tool) error {
 	name := tool.Name()
@@ -113,43 +141,179 @@ func (r *ToolRegistry) RegisterWithMCP(server *mcp.Server) error {
 
 // registerSingleTool handles MCP registration for a single tool.
 func (r *ToolRegistry) registerSingleTool(server *mcp.Server, tool Tool) error {
+	inputSchema, handlesFormat := withFormatProperty(tool.InputSchema())
+	handlesConfirm := false
+	if _, ok := tool.(Assessor); ok && r.guard != nil {
+		inputSchema, handlesConfirm = withProperty(inputSchema, ConfirmTokenArgument, map[string]interface{}{
+			"type":        "string",
+			"description": "Token returned by a previous call that required confirmation; repeat the call with identical arguments and this token to confirm it",
+		})
+	}
+	_, previewable := tool.(Previewer)
+	handlesDryRun := false
+	if previewable {
+		inputSchema, handlesDryRun = withProperty(inputSchema, DryRunArgument, map[string]interface{}{
+			"type":        "boolean",
+			"description": "Report what the call would change without changing anything",
+		})
+	}
 	mcpTool := &mcp.Tool{
 		Name:        tool.Name(),
 		Description: tool.Description(),
-		InputSchema: tool.InputSchema(),
+		InputSchema: inputSchema,
 	}
 
-	mcp.AddTool(server, mcpTool, func(ctx context.Context, request *mcp.CallToolRequest, args map[string]interface{}) (*mcp.CallToolResult, map[string]interface{}, error) {
+	mcp.AddTool(server, mcpTool, func(ctx context.Context, request *mcp.CallToolRequest, args map[string]interface{}) (*mcp.CallToolResult, any, error) {
+		format := r.outputFormat
+		if raw, ok := args[formatArgument]; ok && handlesFormat {
+			requested, _ := raw.(string)
+			if err := ValidateFormat(requested); err != nil {
+				return errorResult(err.Error()), nil, nil
+			}
+			format = requested
+			delete(args, formatArgument)
+		}
+		var confirmToken string
+		if raw, ok := args[ConfirmTokenArgument]; ok && handlesConfirm {
+			confirmToken, _ = raw.(string)
+			delete(args, ConfirmTokenArgument)
+		}
+		dryRun := r.dryRun && previewable
+		if raw, ok := args[DryRunArgument]; ok && handlesDryRun {
+			requested, _ := raw.(bool)
+			dryRun = dryRun || requested
+			delete(args, DryRunArgument)
+		}
+
 		var argsJSON json.RawMessage
-		if args != nil && len(args) > 0 {
+		if len(args) > 0 {
 			argsBytes, err := json.Marshal(args)
 			if err != nil {
-				return &mcp.CallToolResult{
-					Content: []mcp.Content{
-						&mcp.TextContent{Text: fmt.Sprintf("failed to marshal arguments: %v", err)},
-					},
-					IsError: true,
-				}, nil, nil
+				return errorResult(fmt.Sprintf("failed to marshal arguments: %v", err)), nil, nil
 			}
 			argsJSON = argsBytes
 		}
 
-		result, err := tool.Execute(ctx, argsJSON)
+		if token := request.Params.GetProgressToken(); token != nil {
+			ctx = progress.WithReporter(ctx, progress.ForSession(request.Session, token))
+		}
+
+		if dryRun {
+			result, err := preview(ctx, tool, argsJSON)
+			if err != nil {
+				logFailure(ctx, tool.Name(), err)
+				return nil, nil, err
+			}
+			return buildResult(result, format)
+		}
+
+		blocked, err := r.checkGuard(ctx, request.Session, tool, argsJSON, confirmToken)
 		if err != nil {
+			logFailure(ctx, tool.Name(), err)
 			return nil, nil, err
 		}
-		resultJSON, err := json.Marshal(result)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to marshal result: %w", err)
+		if blocked != nil {
+			return buildResult(blocked, format)
 		}
 
-		var resultMap map[string]interface{}
-		if err := json.Unmarshal(resultJSON, &resultMap); err != nil {
-			return nil, nil, fmt.Errorf("failed to unmarshal result to map: %w", err)
+		result, err := r.execute(ctx, tool, argsJSON)
+		if err != nil {
+			logFailure(ctx, tool.Name(), err)
+			return nil, nil, err
 		}
 
-		return nil, resultMap, nil
+		return buildResult(result, format)
 	})
 
 	return nil
 }
+
+// logFailure logs a failed or refused tool call.
+func logFailure(ctx context.Context, tool string, err error) {
+	logger := logging.FromContext(ctx)
+	if errors.Is(err, ErrPolicyDenied) {
+		logger.WarnContext(ctx, "tool call denied", "tool", tool, "reason", err)
+	} else {
+		logger.ErrorContext(ctx, "tool failed", "tool", tool, "error", err)
+	}
+}
+
+// ResourceResult is implemented by tool results that carry documents, such
+// as exported data, too large or too raw for the structured content. Each
+// is added to the call result as an embedded resource.
+type ResourceResult interface {
+	Resources() []*mcp.ResourceContents
+}
+
+// buildResult converts a tool result into a CallToolResult carrying both a
+// text rendering, for clients that ignore structured content, and the
+// structured content itself, followed by any embedded resources.
+func buildResult(result interface{}, format string) (*mcp.CallToolResult, any, error) {
+	resultJSON, decoded, err := normalizeResult(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := []mcp.Content{
+		&mcp.TextContent{Text: renderText(resultJSON, decoded, format)},
+	}
+	if r, ok := result.(ResourceResult); ok {
+		for _, resource := range r.Resources() {
+			content = append(content, &mcp.EmbeddedResource{Resource: resource})
+		}
+	}
+	return &mcp.CallToolResult{Content: content}, structuredContent(decoded), nil
+}
+
+// errorResult returns a tool error result with the given message.
+func errorResult(message string) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: message},
+		},
+		IsError: true,
+	}
+}
+
+// withFormatProperty returns a copy of schema that also advertises the
+// "format" argument, and reports whether the registry owns that argument.
+// A nil schema is treated as an empty object; tools that declare their own
+// "format" property keep it.
+func withFormatProperty(schema interface{}) (interface{}, bool) {
+	return withProperty(schema, formatArgument, map[string]interface{}{
+		"type":        "string",
+		"enum":        []string{FormatJSON, FormatTable},
+		"description": "Format of the text content: json (compact JSON) or table (aligned rows)",
+	})
+}
+
+// withProperty returns a copy of schema with an additional property, and
+// reports whether it was added. Schemas that already declare the property
+// are returned unchanged.
+func withProperty(schema interface{}, name string, property map[string]interface{}) (interface{}, bool) {
+	if schema == nil {
+		schema = map[string]interface{}{"type": "object"}
+	}
+	m, ok := schema.(map[string]interface{})
+	if !ok {
+		return schema, false
+	}
+
+	properties := map[string]interface{}{}
+	if existing, ok := m["properties"].(map[string]interface{}); ok {
+		for k, v := range existing {
+			properties[k] = v
+		}
+	}
+	if _, taken := properties[name]; taken {
+		return schema, false
+	}
+	properties[name] = property
+
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	out["properties"] = properties
+	return out, true
+}
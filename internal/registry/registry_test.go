@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
 )
 
 // mockTool is a simple mock tool for testing
@@ -211,3 +215,69 @@ func TestToolRegistry_ExecuteTool_ExecutionError(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "failed to execute")
 }
+
+func TestRegisterWithMCP_ReportsProgress(t *testing.T) {
+	ctx := context.Background()
+	reg := NewToolRegistry()
+	reg.MustRegister(&mockTool{
+		name: "slow",
+		execFunc: func(ctx context.Context, input json.RawMessage) (interface{}, error) {
+			progress.Report(ctx, 10, 10, "done")
+			return map[string]interface{}{}, nil
+		},
+	})
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	require.NoError(t, reg.RegisterWithMCP(server))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	notifications := make(chan *mcp.ProgressNotificationParams, 1)
+	c := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: func(ctx context.Context, req *mcp.ProgressNotificationClientRequest) {
+			notifications <- req.Params
+		},
+	})
+	session, err := c.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	_, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Meta: mcp.Meta{"progressToken": "token-1"},
+		Name: "slow",
+	})
+	require.NoError(t, err)
+
+	select {
+	case n := <-notifications:
+		assert.Equal(t, "token-1", n.ProgressToken)
+		assert.Equal(t, float64(10), n.Progress)
+		assert.Equal(t, float64(10), n.Total)
+		assert.Equal(t, "done", n.Message)
+	case <-time.After(2 * time.Second):
+		t.Fatal("no progress notification received")
+	}
+}
+
+// exportResult is a tool result carrying an embedded resource.
+type exportResult struct {
+	Keys int `json:"keys"`
+}
+
+func (exportResult) Resources() []*mcp.ResourceContents {
+	return []*mcp.ResourceContents{{URI: "export:data.jsonl", MIMEType: "application/jsonl", Text: "{}\n"}}
+}
+
+func TestBuildResult_EmbedsResources(t *testing.T) {
+	result, structured, err := buildResult(exportResult{Keys: 1}, FormatJSON)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"keys": float64(1)}, structured)
+	require.Len(t, result.Content, 2)
+	embedded, ok := result.Content[1].(*mcp.EmbeddedResource)
+	require.True(t, ok)
+	assert.Equal(t, "export:data.jsonl", embedded.Resource.URI)
+	assert.Equal(t, "{}\n", embedded.Resource.Text)
+}
@@ -0,0 +1,341 @@
+// Package resources exposes Valkey keys as MCP resources.
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/yosida95/uritemplate/v3"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/logging"
+	"github.com/ItsJooL/valkey-mcp-server/internal/notifications"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/ItsJooL/valkey-mcp-server/internal/types"
+)
+
+// URITemplate is the resource template under which every key is exposed.
+const URITemplate = "valkey://{connection}/{db}/{key}"
+
+// DefaultConnection is the connection name used in resource URIs when none is configured.
+const DefaultConnection = "default"
+
+const (
+	// releaseTimeout bounds restoring notify-keyspace-events when the last
+	// user of notifications is a subscription that ends.
+	releaseTimeout = 5 * time.Second
+	// listPageSize is the number of keys returned per resources/list page.
+	listPageSize = 100
+	// valueLimit caps the number of collection elements rendered per resource.
+	valueLimit = 1000
+)
+
+var uriTemplate = uritemplate.MustNew(URITemplate)
+
+// KeyResources serves keys of one connection and database as MCP resources.
+type KeyResources struct {
+	client     client.ValkeyClient
+	connection string
+	db         types.DBIndex
+	leases     *notifications.Leases
+
+	mu            sync.Mutex
+	server        *mcp.Server
+	subscriptions map[string]*subscription
+	// sessions holds the sessions with subscriptions whose end is awaited.
+	sessions map[*mcp.ServerSession]bool
+}
+
+// subscription tracks the sessions subscribed to one resource URI and the
+// keyspace watcher that feeds them, which holds a notifications lease.
+type subscription struct {
+	sessions map[*mcp.ServerSession]bool
+	cancel   context.CancelFunc
+	release  func(context.Context) error
+}
+
+// stop cancels the watcher of s and releases its lease.
+func (s *subscription) stop(ctx context.Context) {
+	s.cancel()
+	releaseLease(ctx, s.release)
+}
+
+// releaseLease releases a notifications lease, logging a failure to restore
+// notify-keyspace-events.
+func releaseLease(ctx context.Context, release func(context.Context) error) {
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+	if err := release(releaseCtx); err != nil {
+		logging.FromContext(ctx).WarnContext(ctx, "failed to release keyspace notifications", "error", err)
+	}
+}
+
+// New creates key resources for the given client. connection is the name that
+// appears in resource URIs. Subscriptions hold leases so that keyspace
+// notifications enabled by a watch stay on while they need them.
+func New(c client.ValkeyClient, connection string, db types.DBIndex, leases *notifications.Leases) *KeyResources {
+	if connection == "" {
+		connection = DefaultConnection
+	}
+	return &KeyResources{
+		client:        c,
+		connection:    connection,
+		db:            db,
+		leases:        leases,
+		subscriptions: make(map[string]*subscription),
+		sessions:      make(map[*mcp.ServerSession]bool),
+	}
+}
+
+// URI returns the resource URI of key.
+func (r *KeyResources) URI(key string) string {
+	uri, _ := uriTemplate.Expand(uritemplate.Values{
+		"connection": uritemplate.String(r.connection),
+		"db":         uritemplate.String(strconv.Itoa(r.db.Int())),
+		"key":        uritemplate.String(key),
+	})
+	return uri
+}
+
+// parseURI returns the key addressed by uri, or false if uri does not belong
+// to this connection and database.
+func (r *KeyResources) parseURI(uri string) (string, bool) {
+	values := uriTemplate.Match(uri)
+	if values == nil {
+		return "", false
+	}
+	if values.Get("connection").String() != r.connection {
+		return "", false
+	}
+	if values.Get("db").String() != strconv.Itoa(r.db.Int()) {
+		return "", false
+	}
+	key := values.Get("key").String()
+	return key, key != ""
+}
+
+// RegisterWithMCP adds the key resource template to server and serves
+// resources/list from SCAN. Subscriptions additionally require Subscribe and
+// Unsubscribe to be set as the server's SubscribeHandler and UnsubscribeHandler.
+func (r *KeyResources) RegisterWithMCP(server *mcp.Server) {
+	r.mu.Lock()
+	r.server = server
+	r.mu.Unlock()
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		Name:        "valkey-key",
+		Title:       "Valkey key",
+		Description: "Any key rendered as JSON according to its type (string, hash, list, set, zset, stream)",
+		URITemplate: URITemplate,
+		MIMEType:    "application/json",
+	}, r.read)
+
+	server.AddReceivingMiddleware(r.listMiddleware)
+}
+
+// read renders a single key resource.
+func (r *KeyResources) read(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+	uri := req.Params.URI
+	key, ok := r.parseURI(uri)
+	if !ok {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	kv, err := base.ReadKeyValue(ctx, r.client, key, valueLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key %q: %w", key, err)
+	}
+	if kv.Type == "none" {
+		return nil, mcp.ResourceNotFoundError(uri)
+	}
+
+	data, err := json.Marshal(kv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal key %q: %w", key, err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: uri, MIMEType: "application/json", Text: string(data)},
+		},
+	}, nil
+}
+
+// listMiddleware answers resources/list with one SCAN-backed page of keys.
+// The MCP cursor is the SCAN cursor.
+func (r *KeyResources) listMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != "resources/list" {
+			return next(ctx, method, req)
+		}
+		var cursor string
+		if params, ok := req.GetParams().(*mcp.ListResourcesParams); ok && params != nil {
+			cursor = params.Cursor
+		}
+		return r.list(ctx, cursor)
+	}
+}
+
+// list returns up to listPageSize keys starting at the given SCAN cursor.
+func (r *KeyResources) list(ctx context.Context, cursor string) (*mcp.ListResourcesResult, error) {
+	var scanCursor uint64
+	if cursor != "" {
+		var err error
+		if scanCursor, err = strconv.ParseUint(cursor, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid cursor %q", cursor)
+		}
+	}
+
+	resources := make([]*mcp.Resource, 0, listPageSize)
+	for {
+		keys, next, err := r.client.ScanKeys(ctx, scanCursor, "*", listPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list keys: %w", err)
+		}
+		for _, key := range keys {
+			resources = append(resources, &mcp.Resource{
+				URI:      r.URI(key),
+				Name:     key,
+				MIMEType: "application/json",
+			})
+		}
+		scanCursor = next
+		if scanCursor == 0 || len(resources) >= listPageSize {
+			break
+		}
+	}
+
+	result := &mcp.ListResourcesResult{Resources: resources}
+	if scanCursor != 0 {
+		result.NextCursor = strconv.FormatUint(scanCursor, 10)
+	}
+	return result, nil
+}
+
+// Subscribe starts watching the key behind req's URI and sends
+// notifications/resources/updated to subscribed sessions whenever it changes.
+// Use it as mcp.ServerOptions.SubscribeHandler.
+func (r *KeyResources) Subscribe(ctx context.Context, req *mcp.SubscribeRequest) error {
+	uri := req.Params.URI
+	key, ok := r.parseURI(uri)
+	if !ok {
+		return mcp.ResourceNotFoundError(uri)
+	}
+	// The lease is taken before the check, so notifications cannot be
+	// restored between the check and the watch.
+	release := r.leases.Acquire()
+	if err := r.checkNotifications(ctx); err != nil {
+		releaseLease(ctx, release)
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sub, exists := r.subscriptions[uri]
+	if exists {
+		// The existing watcher holds a lease, so this release restores
+		// nothing.
+		release(ctx)
+	} else {
+		watchCtx, cancel := context.WithCancel(context.Background())
+		sub = &subscription{sessions: make(map[*mcp.ServerSession]bool), cancel: cancel, release: release}
+		r.subscriptions[uri] = sub
+		go r.watch(watchCtx, uri, key)
+	}
+	sub.sessions[req.Session] = true
+	// A client may disconnect without unsubscribing.
+	if req.Session != nil && !r.sessions[req.Session] {
+		r.sessions[req.Session] = true
+		go r.awaitClose(req.Session)
+	}
+	return nil
+}
+
+// awaitClose drops the subscriptions of session once it ends.
+func (r *KeyResources) awaitClose(session *mcp.ServerSession) {
+	session.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, session)
+	for uri := range r.subscriptions {
+		r.unsubscribe(context.Background(), uri, session)
+	}
+}
+
+// Unsubscribe stops watching a key once its last subscriber is gone.
+// Use it as mcp.ServerOptions.UnsubscribeHandler.
+func (r *KeyResources) Unsubscribe(ctx context.Context, req *mcp.UnsubscribeRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.unsubscribe(ctx, req.Params.URI, req.Session)
+	return nil
+}
+
+// unsubscribe removes session from the subscription to uri, stopping its
+// watcher once no sessions remain. The caller must hold r.mu.
+func (r *KeyResources) unsubscribe(ctx context.Context, uri string, session *mcp.ServerSession) {
+	sub, exists := r.subscriptions[uri]
+	if !exists {
+		return
+	}
+	delete(sub.sessions, session)
+	if len(sub.sessions) == 0 {
+		sub.stop(ctx)
+		delete(r.subscriptions, uri)
+	}
+}
+
+// Close stops all keyspace watchers.
+func (r *KeyResources) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for uri, sub := range r.subscriptions {
+		sub.stop(context.Background())
+		delete(r.subscriptions, uri)
+	}
+}
+
+// watch forwards keyspace notifications for key as resource updates until ctx is cancelled.
+func (r *KeyResources) watch(ctx context.Context, uri, key string) {
+	err := r.client.WatchKeyspace(ctx, base.EscapeGlob(key), func(event client.KeyspaceEvent) {
+		r.mu.Lock()
+		server := r.server
+		r.mu.Unlock()
+		if server == nil {
+			return
+		}
+		if err := server.ResourceUpdated(ctx, &mcp.ResourceUpdatedNotificationParams{URI: uri}); err != nil {
+			slog.Warn("failed to send resource update", "uri", uri, "error", err)
+		}
+	})
+	if err != nil {
+		slog.Error("keyspace watch stopped", "uri", uri, "error", err)
+	}
+}
+
+// checkNotifications verifies that the server publishes keyspace events,
+// which subscriptions depend on. Servers that refuse CONFIG GET are assumed
+// to be configured correctly.
+func (r *KeyResources) checkNotifications(ctx context.Context) error {
+	config, err := r.client.ConfigGet(ctx, "notify-keyspace-events")
+	if err != nil {
+		logging.FromContext(ctx).WarnContext(ctx, "cannot verify notify-keyspace-events, assuming enabled", "error", err)
+		return nil
+	}
+	flags := config["notify-keyspace-events"]
+	if !strings.Contains(flags, "K") || !strings.ContainsAny(flags, "Ag$lshzxetmdn") {
+		return fmt.Errorf("resource subscriptions require keyspace notifications: set notify-keyspace-events to include K and an event class (for example \"KA\"), currently %q", flags)
+	}
+	return nil
+}
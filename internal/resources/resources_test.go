@@ -0,0 +1,199 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/notifications"
+	"github.com/ItsJooL/valkey-mcp-server/internal/types"
+)
+
+// connect serves r over an in-memory transport and returns a client session.
+// updates receives the URI of every resources/updated notification.
+func connect(t *testing.T, r *KeyResources) (*mcp.ClientSession, chan string) {
+	t.Helper()
+	ctx := context.Background()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, &mcp.ServerOptions{
+		SubscribeHandler:   r.Subscribe,
+		UnsubscribeHandler: r.Unsubscribe,
+	})
+	r.RegisterWithMCP(server)
+	t.Cleanup(r.Close)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { serverSession.Close() })
+
+	updates := make(chan string, 10)
+	c := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "1.0.0"}, &mcp.ClientOptions{
+		ResourceUpdatedHandler: func(ctx context.Context, req *mcp.ResourceUpdatedNotificationRequest) {
+			updates <- req.Params.URI
+		},
+	})
+	session, err := c.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { session.Close() })
+	return session, updates
+}
+
+func TestKeyResources_URIRoundTrip(t *testing.T) {
+	mockClient := client.NewMockClient()
+	r := New(mockClient, "", types.DBIndex(2), notifications.New(mockClient))
+
+	uri := r.URI("user:1/profile")
+	assert.Equal(t, "valkey://default/2/user%3A1%2Fprofile", uri)
+
+	key, ok := r.parseURI(uri)
+	require.True(t, ok)
+	assert.Equal(t, "user:1/profile", key)
+
+	_, ok = r.parseURI("valkey://other/2/user")
+	assert.False(t, ok)
+	_, ok = r.parseURI("valkey://default/3/user")
+	assert.False(t, ok)
+}
+
+func TestKeyResources_Read(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawHashBytes("user:1", map[string][]byte{"name": []byte("Ada")})
+	r := New(mockClient, "main", types.DBIndex(0), notifications.New(mockClient))
+	session, _ := connect(t, r)
+
+	res, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: r.URI("user:1")})
+	require.NoError(t, err)
+	require.Len(t, res.Contents, 1)
+	assert.Equal(t, "application/json", res.Contents[0].MIMEType)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(res.Contents[0].Text), &decoded))
+	assert.Equal(t, "hash", decoded["type"])
+	assert.Equal(t, map[string]interface{}{"name": "Ada"}, decoded["value"])
+}
+
+func TestKeyResources_ReadMissingKey(t *testing.T) {
+	mockClient := client.NewMockClient()
+	r := New(mockClient, "main", types.DBIndex(0), notifications.New(mockClient))
+	session, _ := connect(t, r)
+
+	_, err := session.ReadResource(context.Background(), &mcp.ReadResourceParams{URI: r.URI("missing")})
+	assert.Error(t, err)
+}
+
+func TestKeyResources_ListPaginates(t *testing.T) {
+	mockClient := client.NewMockClient()
+	for i := 0; i < listPageSize+5; i++ {
+		mockClient.SetRawBytes("key:"+string(rune('a'+i%26))+string(rune('a'+i/26)), []byte("v"))
+	}
+	r := New(mockClient, "main", types.DBIndex(0), notifications.New(mockClient))
+	session, _ := connect(t, r)
+
+	ctx := context.Background()
+	first, err := session.ListResources(ctx, &mcp.ListResourcesParams{})
+	require.NoError(t, err)
+	assert.Len(t, first.Resources, listPageSize)
+	require.NotEmpty(t, first.NextCursor)
+
+	second, err := session.ListResources(ctx, &mcp.ListResourcesParams{Cursor: first.NextCursor})
+	require.NoError(t, err)
+	assert.Len(t, second.Resources, 5)
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestKeyResources_SubscribeSendsUpdates(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("counter", []byte("1"))
+	_, err := mockClient.ConfigSet(context.Background(), "notify-keyspace-events", "KA")
+	require.NoError(t, err)
+
+	r := New(mockClient, "main", types.DBIndex(0), notifications.New(mockClient))
+	session, updates := connect(t, r)
+	uri := r.URI("counter")
+
+	require.NoError(t, session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: uri}))
+
+	// The watcher goroutine registers asynchronously; retry until it receives the event.
+	require.Eventually(t, func() bool {
+		mockClient.EmitKeyspaceEvent("counter", "incrby")
+		select {
+		case got := <-updates:
+			return got == uri
+		default:
+			return false
+		}
+	}, 2*time.Second, 20*time.Millisecond)
+
+	require.NoError(t, session.Unsubscribe(context.Background(), &mcp.UnsubscribeParams{URI: uri}))
+	r.mu.Lock()
+	assert.Empty(t, r.subscriptions)
+	r.mu.Unlock()
+}
+
+func TestKeyResources_SubscribeHoldsNotifications(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.ConfigSet(ctx, "notify-keyspace-events", "")
+	require.NoError(t, err)
+	leases := notifications.New(mockClient)
+	r := New(mockClient, "main", types.DBIndex(0), leases)
+	session, _ := connect(t, r)
+
+	// A watch turns notifications on, then ends while subscribed.
+	release := leases.Acquire()
+	require.NoError(t, leases.Enable(ctx, "", "KA"))
+	require.NoError(t, session.Subscribe(ctx, &mcp.SubscribeParams{URI: r.URI("counter")}))
+	require.NoError(t, release(ctx))
+	config, _ := mockClient.ConfigGet(ctx, "notify-keyspace-events")
+	assert.Equal(t, "KA", config["notify-keyspace-events"])
+
+	require.NoError(t, session.Unsubscribe(ctx, &mcp.UnsubscribeParams{URI: r.URI("counter")}))
+	config, _ = mockClient.ConfigGet(ctx, "notify-keyspace-events")
+	assert.Equal(t, "", config["notify-keyspace-events"])
+}
+
+func TestKeyResources_DisconnectEndsSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.ConfigSet(ctx, "notify-keyspace-events", "")
+	require.NoError(t, err)
+	leases := notifications.New(mockClient)
+	r := New(mockClient, "main", types.DBIndex(0), leases)
+	session, _ := connect(t, r)
+
+	release := leases.Acquire()
+	require.NoError(t, leases.Enable(ctx, "", "KA"))
+	require.NoError(t, session.Subscribe(ctx, &mcp.SubscribeParams{URI: r.URI("a")}))
+	require.NoError(t, session.Subscribe(ctx, &mcp.SubscribeParams{URI: r.URI("b")}))
+	require.NoError(t, release(ctx))
+
+	// The client goes away without unsubscribing.
+	require.NoError(t, session.Close())
+	require.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.subscriptions) == 0 && len(r.sessions) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	config, _ := mockClient.ConfigGet(ctx, "notify-keyspace-events")
+	assert.Equal(t, "", config["notify-keyspace-events"])
+}
+
+func TestKeyResources_SubscribeRequiresNotifications(t *testing.T) {
+	mockClient := client.NewMockClient()
+	_, err := mockClient.ConfigSet(context.Background(), "notify-keyspace-events", "")
+	require.NoError(t, err)
+
+	r := New(mockClient, "main", types.DBIndex(0), notifications.New(mockClient))
+	session, _ := connect(t, r)
+
+	err = session.Subscribe(context.Background(), &mcp.SubscribeParams{URI: r.URI("counter")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notify-keyspace-events")
+}
@@ -68,6 +68,41 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	added := 0
+	seen := make(map[string]bool, len(params.Members))
+	for _, member := range params.Members {
+		if seen[member] {
+			continue
+		}
+		seen[member] = true
+		exists, err := t.client.CheckSetMember(ctx, params.Key, member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check member of set %q: %w", params.Key, err)
+		}
+		if !exists {
+			added++
+		}
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Add %d new members to set %q (%d already present), growing it from %d to %d members", added, params.Key, len(seen)-added, state.Size, state.Size+int64(added)),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
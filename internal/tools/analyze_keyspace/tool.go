@@ -0,0 +1,117 @@
+// Package analyze_keyspace implements the analyze_keyspace tool.
+package analyze_keyspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/keyspace"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// defaultGroups is the number of groups returned when limit is omitted.
+const defaultGroups = 50
+
+// Tool implements the analyze_keyspace functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for analyze_keyspace tool.
+type Input struct {
+	Pattern   string `json:"pattern,omitempty" jsonschema:"description=Glob pattern of the keys to analyze (default: *)"`
+	Delimiter string `json:"delimiter,omitempty" jsonschema:"description=Separator between key name segments (default: :)"`
+	Depth     int    `json:"depth,omitempty" jsonschema:"minimum=1,description=Number of leading segments that form the group prefix (default: 1)"`
+	MaxKeys   int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Number of keys to sample; larger keyspaces are extrapolated from the sample (default: 10000)"`
+	BatchSize int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+	Limit     int    `json:"limit,omitempty" jsonschema:"minimum=1,description=Maximum number of groups returned (default: 50)"`
+}
+
+// Output represents the output of analyze_keyspace tool.
+type Output struct {
+	Pattern     string `json:"pattern"`
+	ScannedKeys int64  `json:"scanned_keys"`
+	TotalKeys   int64  `json:"total_keys"`
+	MemoryBytes int64  `json:"memory_bytes"`
+	// Sampled is set when only part of the keyspace was scanned.
+	Sampled bool `json:"sampled"`
+	// ScaleFactor is TotalKeys divided by ScannedKeys. It is set, along with
+	// the estimates of each group, when a sample of the whole keyspace was
+	// scanned.
+	ScaleFactor          float64           `json:"scale_factor,omitempty"`
+	EstimatedMemoryBytes int64             `json:"estimated_memory_bytes,omitempty"`
+	Groups               []*keyspace.Group `json:"groups"`
+	OmittedGroups        int               `json:"omitted_groups,omitempty"`
+	Partial              bool              `json:"partial,omitempty"`
+}
+
+// NewTool creates a new analyze_keyspace tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"analyze_keyspace",
+			"Group keys by prefix and report, per group, key count, total and average MEMORY USAGE, type and encoding breakdown and TTL buckets, sorted by memory. Scans every node, stopping after max_keys; a sample of the whole keyspace is extrapolated to DBSIZE. Keys without the delimiter are grouped under an empty prefix",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Pattern == "" {
+		params.Pattern = "*"
+	}
+	if params.Limit <= 0 {
+		params.Limit = defaultGroups
+	}
+
+	output := &Output{Pattern: params.Pattern}
+	analyzer := keyspace.NewAnalyzer(params.Delimiter, params.Depth)
+	opts := base.BulkOptions{Pattern: params.Pattern, MaxKeys: params.MaxKeys, BatchSize: params.BatchSize}
+	result, err := base.ScanEach(ctx, t.client, opts, func(ctx context.Context, keys []string) error {
+		infos, err := t.client.InspectKeys(ctx, keys)
+		if err != nil {
+			return fmt.Errorf("failed to inspect keys: %w", err)
+		}
+		for _, info := range infos {
+			// Keys deleted since the scan found them are left out.
+			if info.Type == "none" {
+				continue
+			}
+			analyzer.Add(info.Key, info.Type, info.Encoding, info.MemoryBytes, info.PTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	output.ScannedKeys, output.MemoryBytes = analyzer.Keys(), analyzer.MemoryBytes()
+	output.Sampled = result.LimitReached || result.Partial
+	output.Partial = result.Partial
+
+	if output.TotalKeys, err = base.KeyspaceSize(ctx, t.client); err != nil && !output.Partial {
+		return nil, err
+	}
+	// DBSIZE counts every key, so only a sample of all keys can be scaled up.
+	if output.Sampled && params.Pattern == "*" && output.ScannedKeys > 0 && output.TotalKeys > output.ScannedKeys {
+		output.ScaleFactor = float64(output.TotalKeys) / float64(output.ScannedKeys)
+		output.EstimatedMemoryBytes = keyspace.Scale(output.MemoryBytes, output.ScaleFactor)
+	}
+
+	output.Groups, output.OmittedGroups = analyzer.Groups(output.ScaleFactor, params.Limit)
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
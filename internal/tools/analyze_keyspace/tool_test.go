@@ -0,0 +1,93 @@
+package analyze_keyspace
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/keyspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_GroupsByPrefix(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	hour := int64(3600)
+	mockClient.SetString(ctx, "user:1:name", "alice", nil, false, false)
+	mockClient.SetString(ctx, "user:2:name", "bob", &hour, false, false)
+	mockClient.SetString(ctx, "session:abc", "x", nil, false, false)
+	mockClient.SetString(ctx, "counter", "1", nil, false, false)
+	mockClient.ExpireKey(ctx, "session:abc", 60)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, int64(4), output.ScannedKeys)
+	assert.Equal(t, int64(4), output.TotalKeys)
+	assert.False(t, output.Sampled)
+	assert.Zero(t, output.ScaleFactor)
+	require.Len(t, output.Groups, 3)
+
+	user := output.Groups[0]
+	assert.Equal(t, "user:", user.Prefix)
+	assert.Equal(t, int64(2), user.Keys)
+	assert.Equal(t, int64(200), user.MemoryBytes)
+	assert.Equal(t, int64(100), user.AvgMemoryBytes)
+	assert.Equal(t, map[string]int64{"string": 2}, user.Types)
+	assert.Equal(t, map[string]int64{"raw": 2}, user.Encodings)
+	assert.Equal(t, keyspace.TTLBuckets{None: 1, UnderDay: 1}, user.TTL)
+
+	// Equal memory sorts by prefix.
+	assert.Equal(t, "", output.Groups[1].Prefix)
+	assert.Equal(t, "session:", output.Groups[2].Prefix)
+	assert.Equal(t, keyspace.TTLBuckets{UnderHour: 1}, output.Groups[2].TTL)
+}
+
+func TestTool_Execute_ExtrapolatesSample(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+	for _, key := range []string{"a:1", "a:2", "b:1", "b:2"} {
+		mockClient.SetString(ctx, key, "v", nil, false, false)
+	}
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"max_keys": 2, "batch_size": 10}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.True(t, output.Sampled)
+	assert.Equal(t, int64(2), output.ScannedKeys)
+	assert.Equal(t, 2.0, output.ScaleFactor)
+	assert.Equal(t, int64(400), output.EstimatedMemoryBytes)
+	require.Len(t, output.Groups, 1)
+	assert.Equal(t, int64(4), output.Groups[0].EstimatedKeys)
+}
+
+func TestTool_Execute_Limit(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+	for _, key := range []string{"a:1:x", "a:2:x", "b:1:x"} {
+		mockClient.SetString(ctx, key, "v", nil, false, false)
+	}
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"depth": 2, "limit": 2}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	require.Len(t, output.Groups, 2)
+	assert.Equal(t, "a:1:", output.Groups[0].Prefix)
+	assert.Equal(t, 1, output.OmittedGroups)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "analyze_keyspace", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -0,0 +1,77 @@
+// Package analyze_rdb implements the analyze_rdb tool.
+package analyze_rdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/rdb"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// defaultGroups is the number of groups returned when limit is omitted.
+const defaultGroups = 50
+
+// Tool implements the analyze_rdb functionality.
+type Tool struct {
+	base.BaseTool
+	dir string
+}
+
+// Input represents the input for analyze_rdb tool.
+type Input struct {
+	Path      string `json:"path" jsonschema:"required,description=RDB file path relative to the RDB directory"`
+	Delimiter string `json:"delimiter,omitempty" jsonschema:"description=Separator between key name segments (default: :)"`
+	Depth     int    `json:"depth,omitempty" jsonschema:"minimum=1,description=Number of leading segments that form the group prefix (default: 1)"`
+	Limit     int    `json:"limit,omitempty" jsonschema:"minimum=1,description=Maximum number of groups returned (default: 50)"`
+	DB        *int   `json:"db,omitempty" jsonschema:"minimum=0,description=Only analyze keys of this database (default: all databases)"`
+}
+
+// NewTool creates a new analyze_rdb tool reading files inside dir.
+func NewTool(dir string) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"analyze_rdb",
+			"Parse an RDB snapshot file offline and group its keys by prefix with the same key count, estimated memory, type, encoding and TTL breakdown as analyze_keyspace. TTLs are relative to when the snapshot was written. No server is contacted. Cancelling returns the keys read so far with partial set",
+			Input{},
+		),
+		dir: dir,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Path == "" {
+		return nil, fmt.Errorf("path cannot be empty")
+	}
+	opts := rdb.AnalyzeOptions{Delimiter: params.Delimiter, Depth: params.Depth, Limit: params.Limit, DB: -1}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultGroups
+	}
+	if params.DB != nil {
+		opts.DB = *params.DB
+	}
+
+	f, err := rdb.Open(t.dir, params.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	report, err := rdb.Analyze(ctx, f, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze RDB file %q: %w", params.Path, err)
+	}
+	return report, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, dir string) {
+	reg.MustRegister(NewTool(dir))
+}
@@ -0,0 +1,92 @@
+package analyze_rdb
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/rdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRDB writes a version 11 RDB file of short string keys: two in
+// database 0 and one in database 1.
+func writeRDB(t *testing.T, dir string) {
+	t.Helper()
+	data := []byte("REDIS0011")
+	str := func(s string) {
+		data = append(data, byte(len(s)))
+		data = append(data, s...)
+	}
+	data = append(data, 0xfa)
+	str("ctime")
+	str("1700000000")
+	for _, kv := range [][2]string{{"user:1", "alice"}, {"user:2", "bob"}} {
+		data = append(data, 0x00)
+		str(kv[0])
+		str(kv[1])
+	}
+	data = append(data, 0xfe, 1, 0x00)
+	str("session:a")
+	str("x")
+	data = append(data, 0xff, 0, 0, 0, 0, 0, 0, 0, 0)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dump.rdb"), data, 0o600))
+}
+
+func TestTool_Execute(t *testing.T) {
+	dir := t.TempDir()
+	writeRDB(t, dir)
+	tool := NewTool(dir)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb"}`))
+	require.NoError(t, err)
+
+	report := result.(*rdb.Report)
+	assert.Equal(t, 11, report.Version)
+	assert.Equal(t, int64(3), report.Keys)
+	assert.Equal(t, map[int]int64{0: 2, 1: 1}, report.Databases)
+	require.Len(t, report.Groups, 2)
+	assert.Equal(t, "user:", report.Groups[0].Prefix)
+	assert.Equal(t, int64(2), report.Groups[0].Keys)
+}
+
+func TestTool_Execute_DB(t *testing.T) {
+	dir := t.TempDir()
+	writeRDB(t, dir)
+	tool := NewTool(dir)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb", "db": 1}`))
+	require.NoError(t, err)
+
+	report := result.(*rdb.Report)
+	assert.Equal(t, int64(1), report.Keys)
+	require.Len(t, report.Groups, 1)
+	assert.Equal(t, "session:", report.Groups[0].Prefix)
+}
+
+func TestTool_Execute_Cancelled(t *testing.T) {
+	dir := t.TempDir()
+	writeRDB(t, dir)
+	tool := NewTool(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := tool.Execute(ctx, json.RawMessage(`{"path": "dump.rdb"}`))
+	require.NoError(t, err)
+	report := result.(*rdb.Report)
+	assert.True(t, report.Partial)
+	assert.Equal(t, 11, report.Version)
+}
+
+func TestTool_Execute_OutsideDirectory(t *testing.T) {
+	tool := NewTool(t.TempDir())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "../dump.rdb"}`))
+	assert.Error(t, err)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{}`))
+	assert.EqualError(t, err, "path cannot be empty")
+}
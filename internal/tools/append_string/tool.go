@@ -67,6 +67,30 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	current, found, err := t.client.GetString(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %q: %w", params.Key, err)
+	}
+	change := base.Change{Target: params.Key, New: base.SafeValue(append(append([]byte(nil), current...), params.Value...))}
+	if found {
+		change.Current = base.SafeValue(current)
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Append %d bytes to key %q, growing it from %d to %d bytes", len(params.Value), params.Key, len(current), len(current)+len(params.Value)),
+		Changes: []base.Change{change},
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -0,0 +1,74 @@
+package base
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// BitRange converts the start, end and unit inputs of a bitmap tool to a
+// client.BitRange, or nil when no range is given. unit is "byte" (the
+// default) or "bit".
+func BitRange(start, end *int64, unit string) (*client.BitRange, error) {
+	var bit bool
+	switch strings.ToLower(unit) {
+	case "", "byte":
+	case "bit":
+		bit = true
+	default:
+		return nil, fmt.Errorf("unit must be byte or bit")
+	}
+	if start == nil {
+		if end != nil {
+			return nil, fmt.Errorf("end requires start")
+		}
+		if bit {
+			return nil, fmt.Errorf("unit requires start")
+		}
+		return nil, nil
+	}
+	return &client.BitRange{Start: *start, End: end, Bit: bit}, nil
+}
+
+// RenderBits returns data as a string of 0s and 1s, most significant bit of
+// each byte first, as SETBIT and GETBIT number them.
+func RenderBits(data []byte) string {
+	var b strings.Builder
+	b.Grow(len(data) * 8)
+	for _, c := range data {
+		fmt.Fprintf(&b, "%08b", c)
+	}
+	return b.String()
+}
+
+// SetBits returns the offsets of the set bits of data, data starting at bit
+// offset firstBit of the bitmap.
+func SetBits(data []byte, firstBit int64) []int64 {
+	offsets := []int64{}
+	for i, c := range data {
+		for j := 0; j < 8; j++ {
+			if c&(0x80>>j) != 0 {
+				offsets = append(offsets, firstBit+int64(i*8+j))
+			}
+		}
+	}
+	return offsets
+}
+
+// BitFieldField validates the encoding and offset of a BITFIELD subcommand
+// and returns the encoding's signedness and width. encoding is i1 to i64 or
+// u1 to u63; offset is a bit offset or "#n" for the n-th field of the
+// encoding's width.
+func BitFieldField(encoding, offset string) (bool, int64, error) {
+	width, err := strconv.ParseInt(encoding[min(1, len(encoding)):], 10, 64)
+	signed := strings.HasPrefix(encoding, "i")
+	if err != nil || (!signed && !strings.HasPrefix(encoding, "u")) || width < 1 || width > 64 || (!signed && width == 64) {
+		return false, 0, fmt.Errorf("encoding %q must be i1 to i64 or u1 to u63", encoding)
+	}
+	if n, err := strconv.ParseInt(strings.TrimPrefix(offset, "#"), 10, 64); err != nil || n < 0 {
+		return false, 0, fmt.Errorf("offset %q must be a non-negative bit offset or #n", offset)
+	}
+	return signed, width, nil
+}
@@ -0,0 +1,63 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitRange(t *testing.T) {
+	r, err := BitRange(nil, nil, "")
+	require.NoError(t, err)
+	assert.Nil(t, r)
+
+	start, end := int64(1), int64(-1)
+	r, err = BitRange(&start, &end, "BIT")
+	require.NoError(t, err)
+	assert.Equal(t, &client.BitRange{Start: 1, End: &end, Bit: true}, r)
+
+	r, err = BitRange(&start, nil, "byte")
+	require.NoError(t, err)
+	assert.Equal(t, &client.BitRange{Start: 1}, r)
+
+	_, err = BitRange(nil, &end, "")
+	assert.EqualError(t, err, "end requires start")
+	_, err = BitRange(nil, nil, "bit")
+	assert.EqualError(t, err, "unit requires start")
+	_, err = BitRange(&start, nil, "word")
+	assert.EqualError(t, err, "unit must be byte or bit")
+}
+
+func TestRenderBits(t *testing.T) {
+	assert.Equal(t, "", RenderBits(nil))
+	assert.Equal(t, "1000000000000101", RenderBits([]byte{0x80, 0x05}))
+}
+
+func TestSetBits(t *testing.T) {
+	assert.Equal(t, []int64{}, SetBits(nil, 0))
+	assert.Equal(t, []int64{0, 13, 15}, SetBits([]byte{0x80, 0x05}, 0))
+	assert.Equal(t, []int64{16, 29, 31}, SetBits([]byte{0x80, 0x05}, 16))
+}
+
+func TestBitFieldField(t *testing.T) {
+	signed, width, err := BitFieldField("i64", "#2")
+	require.NoError(t, err)
+	assert.True(t, signed)
+	assert.Equal(t, int64(64), width)
+
+	signed, width, err = BitFieldField("u8", "100")
+	require.NoError(t, err)
+	assert.False(t, signed)
+	assert.Equal(t, int64(8), width)
+
+	for _, encoding := range []string{"", "u", "u64", "i0", "x8", "i65"} {
+		_, _, err = BitFieldField(encoding, "0")
+		assert.EqualError(t, err, `encoding "`+encoding+`" must be i1 to i64 or u1 to u63`)
+	}
+	for _, offset := range []string{"", "-1", "#", "#-2", "abc"} {
+		_, _, err = BitFieldField("u8", offset)
+		assert.EqualError(t, err, `offset "`+offset+`" must be a non-negative bit offset or #n`)
+	}
+}
@@ -0,0 +1,28 @@
+package base
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultMaxBlockTimeout caps how long blocking tools wait unless the
+	// server is configured otherwise.
+	DefaultMaxBlockTimeout = 30 * time.Second
+	// DefaultBlockTimeout is how long blocking tools wait when called
+	// without timeout_ms.
+	DefaultBlockTimeout = 10 * time.Second
+)
+
+// BlockTimeout returns how long a blocking tool waits given its timeout_ms
+// input: DefaultBlockTimeout when nil, capped at max either way.
+func BlockTimeout(timeoutMs *int64, max time.Duration) (time.Duration, error) {
+	timeout := DefaultBlockTimeout
+	if timeoutMs != nil {
+		if *timeoutMs <= 0 {
+			return 0, fmt.Errorf("timeout_ms must be positive")
+		}
+		timeout = time.Duration(*timeoutMs) * time.Millisecond
+	}
+	return min(timeout, max), nil
+}
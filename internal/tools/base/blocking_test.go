@@ -0,0 +1,33 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockTimeout(t *testing.T) {
+	timeout, err := BlockTimeout(nil, DefaultMaxBlockTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBlockTimeout, timeout)
+
+	timeout, err = BlockTimeout(nil, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, timeout)
+
+	ms := int64(1500)
+	timeout, err = BlockTimeout(&ms, DefaultMaxBlockTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, timeout)
+
+	ms = int64(time.Hour / time.Millisecond)
+	timeout, err = BlockTimeout(&ms, DefaultMaxBlockTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMaxBlockTimeout, timeout)
+
+	ms = 0
+	_, err = BlockTimeout(&ms, DefaultMaxBlockTimeout)
+	assert.EqualError(t, err, "timeout_ms must be positive")
+}
@@ -0,0 +1,221 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
+)
+
+// Defaults for BulkOptions fields left at zero.
+const (
+	DefaultBulkMaxKeys       = 10000
+	DefaultBulkKeysPerSecond = 1000
+	DefaultBulkBatchSize     = 100
+)
+
+// maxBulkErrors is the number of per-key failures listed in a BulkResult.
+const maxBulkErrors = 10
+
+// BulkOptions controls a bulk operation over the keys matching a pattern.
+type BulkOptions struct {
+	Pattern       string
+	MaxKeys       int64
+	KeysPerSecond int64
+	BatchSize     int64
+}
+
+func (o *BulkOptions) setDefaults() {
+	if o.MaxKeys <= 0 {
+		o.MaxKeys = DefaultBulkMaxKeys
+	}
+	if o.KeysPerSecond <= 0 {
+		o.KeysPerSecond = DefaultBulkKeysPerSecond
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultBulkBatchSize
+	}
+}
+
+// BulkApply changes one batch of keys and returns how many it changed.
+// failures describe keys that could not be changed without stopping the
+// operation; err stops it.
+type BulkApply func(ctx context.Context, keys []string) (changed int64, failures []string, err error)
+
+// BulkResult reports the outcome of a bulk operation.
+type BulkResult struct {
+	Pattern string   `json:"pattern"`
+	Nodes   int      `json:"nodes"`
+	Matched int64    `json:"matched"`
+	Changed int64    `json:"changed"`
+	Failed  int64    `json:"failed,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+	// LimitReached is set when more keys matched than MaxKeys allowed.
+	LimitReached bool `json:"limit_reached,omitempty"`
+	// Partial is set when the operation was cancelled or timed out.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// RunBulk SCANs every node for keys matching opts.Pattern and passes them to
+// apply in batches, changing at most opts.KeysPerSecond keys per second and
+// opts.MaxKeys keys in total. Progress is reported after each batch. When
+// ctx is cancelled the counts gathered so far are returned with Partial set.
+func RunBulk(ctx context.Context, c client.ValkeyClient, opts BulkOptions, apply BulkApply) (*BulkResult, error) {
+	opts.setDefaults()
+	start := time.Now()
+
+	result, err := scanBatches(ctx, c, opts, func(keys []string, result *BulkResult, total int64) error {
+		changed, failures, err := apply(ctx, keys)
+		if err != nil {
+			return err
+		}
+		result.Changed += changed
+		result.Failed += int64(len(failures))
+		for _, failure := range failures {
+			if len(result.Errors) < maxBulkErrors {
+				result.Errors = append(result.Errors, failure)
+			}
+		}
+		progress.Report(ctx, float64(result.Matched), float64(total), fmt.Sprintf("changed %d of %d matching keys", result.Changed, result.Matched))
+		return throttle(ctx, start, result.Matched, opts.KeysPerSecond)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CollectBulkKeys returns the keys RunBulk would pass to apply with the same
+// options, without changing them or waiting for the rate limit.
+func CollectBulkKeys(ctx context.Context, c client.ValkeyClient, opts BulkOptions) ([]string, *BulkResult, error) {
+	opts.setDefaults()
+	var keys []string
+	result, err := scanBatches(ctx, c, opts, func(batch []string, _ *BulkResult, total int64) error {
+		keys = append(keys, batch...)
+		progress.Report(ctx, float64(len(keys)), float64(total), fmt.Sprintf("found %d matching keys", len(keys)))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, result, nil
+}
+
+// ScanEach SCANs every node like RunBulk and calls fn with each batch of
+// matching keys, without rate limiting. It stops after opts.MaxKeys keys and
+// returns the number of keys passed to fn as Matched.
+func ScanEach(ctx context.Context, c client.ValkeyClient, opts BulkOptions, fn func(ctx context.Context, keys []string) error) (*BulkResult, error) {
+	opts.setDefaults()
+	return scanBatches(ctx, c, opts, func(keys []string, result *BulkResult, total int64) error {
+		if err := fn(ctx, keys); err != nil {
+			return err
+		}
+		progress.Report(ctx, float64(result.Matched), float64(total), fmt.Sprintf("scanned %d keys", result.Matched))
+		return nil
+	})
+}
+
+// KeyspaceSize returns the number of keys in the database, summing DBSIZE
+// over the primaries of a cluster.
+func KeyspaceSize(ctx context.Context, c client.ValkeyClient) (int64, error) {
+	nodes, err := c.ScanNodes(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	var total int64
+	for _, node := range nodes {
+		size, err := c.NodeDatabaseSize(ctx, node)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get database size: %w", err)
+		}
+		total += size
+	}
+	return total, nil
+}
+
+// scanBatches SCANs each node and calls fn with every new batch of matching
+// keys until opts.MaxKeys keys have been passed. fn may update the counts in
+// result; total is the expected number of keys for progress reporting.
+func scanBatches(ctx context.Context, c client.ValkeyClient, opts BulkOptions, fn func(keys []string, result *BulkResult, total int64) error) (*BulkResult, error) {
+	nodes, err := c.ScanNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes to scan: %w", err)
+	}
+	result := &BulkResult{Pattern: opts.Pattern, Nodes: len(nodes)}
+
+	total := opts.MaxKeys
+	if size, err := KeyspaceSize(ctx, c); err == nil && size > 0 && size < total {
+		total = size
+	}
+
+	// SCAN may return a key more than once.
+	seen := make(map[string]bool)
+	for _, node := range nodes {
+		var cursor uint64
+		for {
+			if ctx.Err() != nil {
+				result.Partial = true
+				return result, nil
+			}
+			keys, next, err := c.ScanNodeKeys(ctx, node, cursor, opts.Pattern, opts.BatchSize)
+			if err != nil {
+				if Interrupted(ctx, err) {
+					result.Partial = true
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to scan keys matching %q: %w", opts.Pattern, err)
+			}
+
+			batch := make([]string, 0, len(keys))
+			for _, key := range keys {
+				if seen[key] {
+					continue
+				}
+				if result.Matched+int64(len(batch)) >= opts.MaxKeys {
+					result.LimitReached = true
+					break
+				}
+				seen[key] = true
+				batch = append(batch, key)
+			}
+			if len(batch) > 0 {
+				result.Matched += int64(len(batch))
+				if err := fn(batch, result, total); err != nil {
+					if Interrupted(ctx, err) {
+						result.Partial = true
+						return result, nil
+					}
+					return nil, err
+				}
+			}
+			if result.LimitReached {
+				return result, nil
+			}
+
+			cursor = next
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// throttle waits until processed keys fit within keysPerSecond since start.
+func throttle(ctx context.Context, start time.Time, processed, keysPerSecond int64) error {
+	due := time.Duration(float64(processed) / float64(keysPerSecond) * float64(time.Second))
+	wait := due - time.Since(start)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clusterClient serves two nodes holding the given keys, two keys per SCAN
+// call, and repeats one key as SCAN may.
+func clusterClient(keys map[string][]string) *client.MockValkeyClient {
+	return &client.MockValkeyClient{
+		ScanNodesFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"node-a", "node-b"}, nil
+		},
+		ScanNodeKeysFunc: func(ctx context.Context, node string, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			all := keys[node]
+			end := min(int(cursor)+2, len(all))
+			batch := append([]string(nil), all[cursor:end]...)
+			if cursor > 0 {
+				batch = append(batch, all[0])
+			}
+			if end == len(all) {
+				return batch, 0, nil
+			}
+			return batch, uint64(end), nil
+		},
+	}
+}
+
+func TestRunBulk_ScansEveryNode(t *testing.T) {
+	c := clusterClient(map[string][]string{"node-a": {"a1", "a2", "a3"}, "node-b": {"b1"}})
+	var applied []string
+
+	result, err := RunBulk(context.Background(), c, BulkOptions{Pattern: "*"}, func(ctx context.Context, keys []string) (int64, []string, error) {
+		applied = append(applied, keys...)
+		return int64(len(keys)) - 1, []string{keys[0] + ": failed"}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a1", "a2", "a3", "b1"}, applied)
+	assert.Equal(t, 2, result.Nodes)
+	assert.Equal(t, int64(4), result.Matched)
+	assert.Equal(t, int64(1), result.Changed)
+	assert.Equal(t, int64(3), result.Failed)
+	assert.Equal(t, []string{"a1: failed", "a3: failed", "b1: failed"}, result.Errors)
+	assert.False(t, result.LimitReached)
+}
+
+func TestRunBulk_MaxKeys(t *testing.T) {
+	c := clusterClient(map[string][]string{"node-a": {"a1", "a2", "a3"}, "node-b": {"b1"}})
+
+	keys, result, err := CollectBulkKeys(context.Background(), c, BulkOptions{Pattern: "*", MaxKeys: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a1", "a2", "a3"}, keys)
+	assert.True(t, result.LimitReached)
+}
+
+func TestRunBulk_RateLimit(t *testing.T) {
+	c := clusterClient(map[string][]string{"node-a": {"a1", "a2", "a3", "a4"}, "node-b": {}})
+
+	start := time.Now()
+	_, err := RunBulk(context.Background(), c, BulkOptions{Pattern: "*", KeysPerSecond: 40}, func(ctx context.Context, keys []string) (int64, []string, error) {
+		return int64(len(keys)), nil, nil
+	})
+	require.NoError(t, err)
+	// Four keys at 40 keys per second take at least 100ms.
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRunBulk_CancelledReturnsPartial(t *testing.T) {
+	c := clusterClient(map[string][]string{"node-a": {"a1", "a2", "a3"}, "node-b": {"b1"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	result, err := RunBulk(ctx, c, BulkOptions{Pattern: "*"}, func(ctx context.Context, keys []string) (int64, []string, error) {
+		cancel()
+		return int64(len(keys)), nil, nil
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Partial)
+	assert.Equal(t, int64(2), result.Changed)
+}
+
+func TestRunBulk_ApplyError(t *testing.T) {
+	c := clusterClient(map[string][]string{"node-a": {"a1"}, "node-b": {}})
+
+	_, err := RunBulk(context.Background(), c, BulkOptions{Pattern: "*"}, func(ctx context.Context, keys []string) (int64, []string, error) {
+		return 0, nil, fmt.Errorf("boom")
+	})
+	assert.EqualError(t, err, "boom")
+}
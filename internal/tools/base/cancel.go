@@ -0,0 +1,16 @@
+package base
+
+import (
+	"context"
+	"errors"
+)
+
+// Interrupted reports whether err was caused by ctx being cancelled or timing
+// out. Iterative tools use it to return the results gathered so far instead
+// of failing.
+func Interrupted(ctx context.Context, err error) bool {
+	if ctx.Err() == nil {
+		return false
+	}
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -56,3 +56,16 @@ func SafeStreamEntries(entries []client.StreamEntry) []map[string]any {
 	}
 	return result
 }
+
+// SafeSortedSet converts sorted set members to a slice of {"member", "score"} maps
+// with JSON-safe members.
+func SafeSortedSet(members []client.SortedSetMember) []map[string]any {
+	result := make([]map[string]any, len(members))
+	for i, m := range members {
+		result[i] = map[string]any{
+			"member": SafeValue(m.Member),
+			"score":  m.Score,
+		}
+	}
+	return result
+}
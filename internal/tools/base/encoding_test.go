@@ -98,3 +98,9 @@ func TestSafeStreamEntries_MixedFieldValues(t *testing.T) {
 	_, isBytes := result[0]["data"].([]byte)
 	assert.True(t, isBytes)
 }
+
+func TestEscapeGlob(t *testing.T) {
+	assert.Equal(t, `user:\*:\[1\]`, EscapeGlob("user:*:[1]"))
+	assert.Equal(t, `a\?b\\c`, EscapeGlob(`a?b\c`))
+	assert.Equal(t, "plain", EscapeGlob("plain"))
+}
@@ -0,0 +1,95 @@
+package base
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// MaxGeoLatitude is the largest latitude geo sets can index; the poles are
+// out of reach of their Web Mercator encoding.
+const MaxGeoLatitude = 85.05112878
+
+// Coordinates is a longitude and latitude in the output of a geo tool.
+type Coordinates struct {
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+}
+
+// NewCoordinates returns p as Coordinates, or nil when p is nil.
+func NewCoordinates(p *client.GeoPosition) *Coordinates {
+	if p == nil {
+		return nil
+	}
+	return &Coordinates{Longitude: p.Longitude, Latitude: p.Latitude}
+}
+
+// ValidatePosition checks that a position can be stored in a geo set.
+func ValidatePosition(longitude, latitude float64) error {
+	if longitude < -180 || longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+	if latitude < -MaxGeoLatitude || latitude > MaxGeoLatitude {
+		return fmt.Errorf("latitude must be between -%v and %v", MaxGeoLatitude, MaxGeoLatitude)
+	}
+	return nil
+}
+
+// GeoUnit returns unit in lower case, or m when it is empty.
+func GeoUnit(unit string) (string, error) {
+	switch unit = strings.ToLower(unit); unit {
+	case "":
+		return "m", nil
+	case "m", "km", "ft", "mi":
+		return unit, nil
+	default:
+		return "", fmt.Errorf("unit must be m, km, ft or mi")
+	}
+}
+
+// GeoSearch checks q, built from the inputs of a geo search tool, and
+// completes it: it is centred on longitude and latitude when they are given,
+// and its unit and order are normalised.
+func GeoSearch(q *client.GeoSearchQuery, longitude, latitude *float64) error {
+	var err error
+	if q.Unit, err = GeoUnit(q.Unit); err != nil {
+		return err
+	}
+	switch q.Order = strings.ToUpper(q.Order); q.Order {
+	case "", "ASC", "DESC":
+	default:
+		return fmt.Errorf("order must be ASC or DESC")
+	}
+
+	switch {
+	case (longitude == nil) != (latitude == nil):
+		return fmt.Errorf("longitude and latitude must be given together")
+	case longitude != nil && q.FromMember != "":
+		return fmt.Errorf("from_member cannot be used with longitude and latitude")
+	case longitude != nil:
+		if err := ValidatePosition(*longitude, *latitude); err != nil {
+			return err
+		}
+		q.FromLonLat = &client.GeoPosition{Longitude: *longitude, Latitude: *latitude}
+	case q.FromMember == "":
+		return fmt.Errorf("from_member or longitude and latitude are required")
+	}
+
+	switch {
+	case q.Radius < 0 || q.Width < 0 || q.Height < 0:
+		return fmt.Errorf("radius, width and height must be positive")
+	case q.Radius > 0 && (q.Width > 0 || q.Height > 0):
+		return fmt.Errorf("radius cannot be used with width and height")
+	case q.Radius == 0 && (q.Width == 0 || q.Height == 0):
+		return fmt.Errorf("radius or width and height are required")
+	}
+
+	if q.Count < 0 {
+		return fmt.Errorf("count cannot be negative")
+	}
+	if q.Any && q.Count == 0 {
+		return fmt.Errorf("any requires count")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCoordinates(t *testing.T) {
+	assert.Nil(t, NewCoordinates(nil))
+	assert.Equal(t, &Coordinates{Longitude: 13.4, Latitude: 38.1}, NewCoordinates(&client.GeoPosition{Longitude: 13.4, Latitude: 38.1}))
+}
+
+func TestValidatePosition(t *testing.T) {
+	assert.NoError(t, ValidatePosition(-180, MaxGeoLatitude))
+	assert.EqualError(t, ValidatePosition(180.5, 0), "longitude must be between -180 and 180")
+	assert.EqualError(t, ValidatePosition(0, -90), "latitude must be between -85.05112878 and 85.05112878")
+}
+
+func TestGeoUnit(t *testing.T) {
+	unit, err := GeoUnit("")
+	require.NoError(t, err)
+	assert.Equal(t, "m", unit)
+	unit, err = GeoUnit("KM")
+	require.NoError(t, err)
+	assert.Equal(t, "km", unit)
+	_, err = GeoUnit("yd")
+	assert.EqualError(t, err, "unit must be m, km, ft or mi")
+}
+
+func TestGeoSearch(t *testing.T) {
+	lon, lat := 15.0, 37.0
+	q := client.GeoSearchQuery{Radius: 200, Unit: "KM", Order: "asc"}
+	require.NoError(t, GeoSearch(&q, &lon, &lat))
+	assert.Equal(t, client.GeoSearchQuery{FromLonLat: &client.GeoPosition{Longitude: 15, Latitude: 37}, Radius: 200, Unit: "km", Order: "ASC"}, q)
+
+	q = client.GeoSearchQuery{FromMember: "Palermo", Width: 10, Height: 5}
+	require.NoError(t, GeoSearch(&q, nil, nil))
+	assert.Equal(t, "m", q.Unit)
+
+	tests := []struct {
+		q    client.GeoSearchQuery
+		lon  *float64
+		lat  *float64
+		want string
+	}{
+		{client.GeoSearchQuery{FromMember: "a", Radius: 1, Order: "up"}, nil, nil, "order must be ASC or DESC"},
+		{client.GeoSearchQuery{Radius: 1}, &lon, nil, "longitude and latitude must be given together"},
+		{client.GeoSearchQuery{FromMember: "a", Radius: 1}, &lon, &lat, "from_member cannot be used with longitude and latitude"},
+		{client.GeoSearchQuery{Radius: 1}, nil, nil, "from_member or longitude and latitude are required"},
+		{client.GeoSearchQuery{FromMember: "a", Radius: -1}, nil, nil, "radius, width and height must be positive"},
+		{client.GeoSearchQuery{FromMember: "a", Radius: 1, Width: 2}, nil, nil, "radius cannot be used with width and height"},
+		{client.GeoSearchQuery{FromMember: "a", Width: 2}, nil, nil, "radius or width and height are required"},
+		{client.GeoSearchQuery{FromMember: "a", Radius: 1, Count: -1}, nil, nil, "count cannot be negative"},
+		{client.GeoSearchQuery{FromMember: "a", Radius: 1, Any: true}, nil, nil, "any requires count"},
+	}
+	for _, tt := range tests {
+		assert.EqualError(t, GeoSearch(&tt.q, tt.lon, tt.lat), tt.want)
+	}
+}
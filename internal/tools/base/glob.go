@@ -0,0 +1,17 @@
+package base
+
+import "strings"
+
+// EscapeGlob escapes glob metacharacters so s is matched literally by
+// SCAN MATCH, KEYS and PSUBSCRIBE patterns.
+func EscapeGlob(s string) string {
+	var b strings.Builder
+	for _, ch := range s {
+		switch ch {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(ch)
+	}
+	return b.String()
+}
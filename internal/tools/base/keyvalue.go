@@ -0,0 +1,128 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// KeyValue is a type-aware, JSON-safe snapshot of a single key.
+type KeyValue struct {
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	TTL       int64  `json:"ttl"`
+	Value     any    `json:"value"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// ReadKeyValue reads key with the command appropriate for its type. Collections
+// are capped at limit elements (limit <= 0 means no cap) and Truncated is set
+// when elements were left out. A missing key is returned with Type "none".
+func ReadKeyValue(ctx context.Context, c client.ValkeyClient, key string, limit int64) (*KeyValue, error) {
+	keyType, err := c.GetKeyType(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	kv := &KeyValue{Key: key, Type: keyType, TTL: -2}
+	if keyType == "none" {
+		return kv, nil
+	}
+
+	if kv.TTL, err = c.GetTTL(ctx, key); err != nil {
+		return nil, err
+	}
+
+	stop := int64(-1)
+	if limit > 0 {
+		stop = limit
+	}
+
+	switch keyType {
+	case "string":
+		value, _, err := c.GetString(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		kv.Value = SafeValue(value)
+
+	case "hash":
+		fields, err := c.GetMap(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && int64(len(fields)) > limit {
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names[limit:] {
+				delete(fields, name)
+			}
+			kv.Truncated = true
+		}
+		kv.Value = SafeMap(fields)
+
+	case "list":
+		values, err := c.GetListRange(ctx, key, 0, stop)
+		if err != nil {
+			return nil, err
+		}
+		kv.Value, kv.Truncated = SafeSlice(capSlice(values, limit)), overLimit(len(values), limit)
+
+	case "set":
+		members, err := c.ListSetMembers(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(members, func(i, j int) bool { return string(members[i]) < string(members[j]) })
+		kv.Value, kv.Truncated = SafeSlice(capSlice(members, limit)), overLimit(len(members), limit)
+
+	case "zset":
+		members, err := c.GetSortedSetRange(ctx, key, 0, stop)
+		if err != nil {
+			return nil, err
+		}
+		truncated := overLimit(len(members), limit)
+		if truncated {
+			members = members[:limit]
+		}
+		kv.Value, kv.Truncated = SafeSortedSet(members), truncated
+
+	case "stream":
+		// Fetch one extra entry to detect truncation.
+		count := int64(0)
+		if limit > 0 {
+			count = limit + 1
+		}
+		entries, err := c.GetStreamRange(ctx, key, "-", "+", count)
+		if err != nil {
+			return nil, err
+		}
+		truncated := overLimit(len(entries), limit)
+		if truncated {
+			entries = entries[:limit]
+		}
+		kv.Value, kv.Truncated = SafeStreamEntries(entries), truncated
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
+	}
+
+	return kv, nil
+}
+
+// capSlice returns at most limit elements of values (all of them if limit <= 0).
+func capSlice(values [][]byte, limit int64) [][]byte {
+	if overLimit(len(values), limit) {
+		return values[:limit]
+	}
+	return values
+}
+
+// overLimit reports whether n elements exceed a positive limit.
+func overLimit(n int, limit int64) bool {
+	return limit > 0 && int64(n) > limit
+}
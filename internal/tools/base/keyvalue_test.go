@@ -0,0 +1,56 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadKeyValue_MissingKey(t *testing.T) {
+	kv, err := ReadKeyValue(context.Background(), client.NewMockClient(), "missing", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "none", kv.Type)
+	assert.Nil(t, kv.Value)
+}
+
+func TestReadKeyValue_String(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("greeting", []byte("hello"))
+
+	kv, err := ReadKeyValue(context.Background(), mockClient, "greeting", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "string", kv.Type)
+	assert.Equal(t, "hello", kv.Value)
+	assert.Equal(t, int64(-1), kv.TTL)
+	assert.False(t, kv.Truncated)
+}
+
+func TestReadKeyValue_ListTruncated(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawListBytes("queue", [][]byte{[]byte("a"), []byte("b"), []byte("c")})
+
+	kv, err := ReadKeyValue(context.Background(), mockClient, "queue", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "list", kv.Type)
+	assert.Equal(t, []any{"a", "b"}, kv.Value)
+	assert.True(t, kv.Truncated)
+}
+
+func TestReadKeyValue_SortedSet(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawSortedSet("board", []client.SortedSetMember{
+		{Member: []byte("ada"), Score: 1},
+		{Member: []byte("bob"), Score: 2.5},
+	})
+
+	kv, err := ReadKeyValue(context.Background(), mockClient, "board", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "zset", kv.Type)
+	assert.Equal(t, []map[string]any{
+		{"member": "ada", "score": 1.0},
+		{"member": "bob", "score": 2.5},
+	}, kv.Value)
+}
@@ -0,0 +1,126 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// MaxPreviewElements caps the elements listed in a Preview. Counts in the
+// summary always cover every element.
+const MaxPreviewElements = 100
+
+// Preview describes what a mutating call would do. Tools return it from
+// registry.Previewer.Preview.
+type Preview struct {
+	Summary string `json:"summary"`
+	// Keys is the state of the affected keys before the call.
+	Keys []*KeyState `json:"keys,omitempty"`
+	// Changes are the values the call would replace.
+	Changes []Change `json:"changes,omitempty"`
+	// Removed are the elements, members or fields the call would remove.
+	Removed []any `json:"removed,omitempty"`
+	// Overwrites are existing keys the call would replace.
+	Overwrites []string `json:"overwrites,omitempty"`
+	Truncated  bool     `json:"truncated,omitempty"`
+}
+
+// Change is a value before and after a call. A nil Current means there is no
+// value yet; a nil New means the value would be removed.
+type Change struct {
+	Target  string `json:"target,omitempty"`
+	Current any    `json:"current"`
+	New     any    `json:"new"`
+}
+
+// KeyState summarises a key without reading its whole value.
+type KeyState struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+	// Size is the length in bytes of a string, or the element count of a
+	// collection.
+	Size        int64 `json:"size"`
+	MemoryBytes int64 `json:"memory_bytes,omitempty"`
+	TTL         int64 `json:"ttl"`
+}
+
+// Exists reports whether the key exists.
+func (s *KeyState) Exists() bool {
+	return s.Type != "none"
+}
+
+// DescribeKey returns the type, size and TTL of key. A missing key is
+// returned with Type "none".
+func DescribeKey(ctx context.Context, c client.ValkeyClient, key string) (*KeyState, error) {
+	keyType, err := c.GetKeyType(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get type of key %q: %w", key, err)
+	}
+	state := &KeyState{Key: key, Type: keyType, TTL: -2}
+	if keyType == "none" {
+		return state, nil
+	}
+
+	if state.TTL, err = c.GetTTL(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to get TTL of key %q: %w", key, err)
+	}
+	if state.Size, err = keySize(ctx, c, key, keyType); err != nil {
+		return nil, fmt.Errorf("failed to get size of key %q: %w", key, err)
+	}
+	// MEMORY USAGE may be disabled by ACLs; the size above is enough.
+	state.MemoryBytes, _ = c.MemoryUsage(ctx, key)
+	return state, nil
+}
+
+// DescribeKeys calls DescribeKey for each key.
+func DescribeKeys(ctx context.Context, c client.ValkeyClient, keys []string) ([]*KeyState, error) {
+	states := make([]*KeyState, 0, len(keys))
+	for _, key := range keys {
+		state, err := DescribeKey(ctx, c, key)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// keySize returns the byte length of a string or the element count of a
+// collection.
+func keySize(ctx context.Context, c client.ValkeyClient, key, keyType string) (int64, error) {
+	switch keyType {
+	case "string":
+		value, _, err := c.GetString(ctx, key)
+		return int64(len(value)), err
+	case "hash":
+		return c.GetMapLength(ctx, key)
+	case "list":
+		return c.GetListLength(ctx, key)
+	case "set":
+		return c.GetSetSize(ctx, key)
+	case "zset":
+		lengths, err := c.KeyLengths(ctx, []string{key}, []string{keyType})
+		if err != nil {
+			return 0, err
+		}
+		return lengths[0], nil
+	case "stream":
+		return c.GetStreamLength(ctx, key)
+	default:
+		return 0, nil
+	}
+}
+
+// CurrentString returns the current value of a string key as a JSON-safe
+// value, or nil when the key does not exist.
+func CurrentString(ctx context.Context, c client.ValkeyClient, key string) (any, error) {
+	value, found, err := c.GetString(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %q: %w", key, err)
+	}
+	if !found {
+		return nil, nil
+	}
+	return SafeValue(value), nil
+}
@@ -0,0 +1,51 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeKey(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("greeting", []byte("hello"))
+	mockClient.SetRawListBytes("queue", [][]byte{[]byte("a"), []byte("b"), []byte("c")})
+
+	states, err := DescribeKeys(ctx, mockClient, []string{"greeting", "queue", "missing"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "string", states[0].Type)
+	assert.Equal(t, int64(5), states[0].Size)
+	assert.Equal(t, int64(-1), states[0].TTL)
+	assert.True(t, states[0].Exists())
+
+	assert.Equal(t, "list", states[1].Type)
+	assert.Equal(t, int64(3), states[1].Size)
+
+	assert.Equal(t, &KeyState{Key: "missing", Type: "none", TTL: -2}, states[2])
+	assert.False(t, states[2].Exists())
+}
+
+func TestDescribeKey_SortedSetUsesZcard(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		GetKeyTypeFunc: func(ctx context.Context, key string) (string, error) {
+			return "zset", nil
+		},
+		KeyLengthsFunc: func(ctx context.Context, keys, types []string) ([]int64, error) {
+			assert.Equal(t, []string{"zset"}, types)
+			return []int64{1_000_000}, nil
+		},
+		GetSortedSetRangeFunc: func(ctx context.Context, key string, start, stop int64) ([]client.SortedSetMember, error) {
+			t.Fatalf("members of %q were read", key)
+			return nil, nil
+		},
+	}
+
+	state, err := DescribeKey(context.Background(), mockClient, "board")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_000_000), state.Size)
+}
@@ -0,0 +1,116 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// StreamTrim returns the trim requested by the maxlen, minid, approximate
+// and limit inputs of a stream tool, or nil when neither maxlen nor minid is
+// set.
+func StreamTrim(maxLen *int64, minID string, approximate bool, limit int64) (*client.StreamTrim, error) {
+	if maxLen != nil && minID != "" {
+		return nil, fmt.Errorf("maxlen and minid cannot be combined")
+	}
+	if maxLen == nil && minID == "" {
+		if approximate || limit != 0 {
+			return nil, fmt.Errorf("approximate and limit require maxlen or minid")
+		}
+		return nil, nil
+	}
+	if limit != 0 && !approximate {
+		return nil, fmt.Errorf("limit requires approximate")
+	}
+	trim := &client.StreamTrim{Strategy: "MINID", Threshold: minID, Approximate: approximate, Limit: limit}
+	if maxLen != nil {
+		if *maxLen < 0 {
+			return nil, fmt.Errorf("maxlen cannot be negative")
+		}
+		trim.Strategy, trim.Threshold = "MAXLEN", strconv.FormatInt(*maxLen, 10)
+	}
+	return trim, nil
+}
+
+// maxTrimPages bounds the XRANGE pages StreamTrimmed reads to count the
+// entries below a MINID threshold.
+const maxTrimPages = 100
+
+// StreamEviction is the outcome of a stream trim as found by StreamTrimmed.
+type StreamEviction struct {
+	// Count is the number of entries evicted. When AtLeast is set counting
+	// stopped early and more entries are evicted.
+	Count   int64
+	AtLeast bool
+	// Entries are the first MaxPreviewElements evicted entries as
+	// JSON-safe maps.
+	Entries []map[string]any
+}
+
+// Quantity words Count for a summary, as in "evicting up to 5 entries". An
+// approximate trim may evict fewer entries.
+func (e StreamEviction) Quantity(approximate bool) string {
+	switch {
+	case e.AtLeast && approximate:
+		return fmt.Sprintf("up to %d or more", e.Count)
+	case e.AtLeast:
+		return fmt.Sprintf("at least %d", e.Count)
+	case approximate:
+		return fmt.Sprintf("up to %d", e.Count)
+	}
+	return strconv.FormatInt(e.Count, 10)
+}
+
+// StreamTrimmed returns the entries of the stream at key trim would evict
+// once added more entries are appended. A MINID trim stops counting after
+// maxTrimPages pages of entries.
+func StreamTrimmed(ctx context.Context, c client.ValkeyClient, key string, trim client.StreamTrim, added int64) (StreamEviction, error) {
+	if strings.ToUpper(trim.Strategy) == "MAXLEN" {
+		maxLen, err := strconv.ParseInt(trim.Threshold, 10, 64)
+		if err != nil || maxLen < 0 {
+			return StreamEviction{}, fmt.Errorf("invalid MAXLEN threshold %q", trim.Threshold)
+		}
+		length, err := c.GetStreamLength(ctx, key)
+		if err != nil {
+			return StreamEviction{}, fmt.Errorf("failed to get length of stream %q: %w", key, err)
+		}
+		evicted := min(max(length+added-maxLen, 0), length)
+		if evicted == 0 {
+			return StreamEviction{}, nil
+		}
+		entries, err := c.GetStreamRange(ctx, key, "-", "+", min(evicted, MaxPreviewElements))
+		if err != nil {
+			return StreamEviction{}, fmt.Errorf("failed to read stream %q: %w", key, err)
+		}
+		return StreamEviction{Count: evicted, Entries: SafeStreamEntries(entries)}, nil
+	}
+
+	// MINID evicts every entry below the threshold; count them page by page.
+	// A threshold without a sequence number means sequence 0, while an end
+	// bound without one would mean the highest sequence.
+	end := "(" + trim.Threshold
+	if !strings.Contains(trim.Threshold, "-") {
+		end += "-0"
+	}
+	const pageSize = 1000
+	var eviction StreamEviction
+	var sample []client.StreamEntry
+	start := "-"
+	for pages := 1; ; pages++ {
+		page, err := c.GetStreamRange(ctx, key, start, end, pageSize)
+		if err != nil {
+			return StreamEviction{}, fmt.Errorf("failed to read stream %q: %w", key, err)
+		}
+		eviction.Count += int64(len(page))
+		sample = append(sample, page[:min(len(page), MaxPreviewElements-len(sample))]...)
+		if len(page) < pageSize || pages == maxTrimPages {
+			eviction.AtLeast = len(page) == pageSize
+			eviction.Entries = SafeStreamEntries(sample)
+			return eviction, nil
+		}
+		start = "(" + page[len(page)-1].ID
+	}
+}
@@ -0,0 +1,85 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamTrim(t *testing.T) {
+	maxLen := int64(100)
+	trim, err := StreamTrim(&maxLen, "", true, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &client.StreamTrim{Strategy: "MAXLEN", Threshold: "100", Approximate: true, Limit: 10}, trim)
+
+	trim, err = StreamTrim(nil, "5-0", false, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &client.StreamTrim{Strategy: "MINID", Threshold: "5-0"}, trim)
+
+	trim, err = StreamTrim(nil, "", false, 0)
+	require.NoError(t, err)
+	assert.Nil(t, trim)
+
+	_, err = StreamTrim(&maxLen, "5-0", false, 0)
+	assert.EqualError(t, err, "maxlen and minid cannot be combined")
+	_, err = StreamTrim(&maxLen, "", false, 10)
+	assert.EqualError(t, err, "limit requires approximate")
+}
+
+func TestStreamTrimmed(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for i := 1; i <= 150; i++ {
+		_, err := mockClient.AddStream(ctx, "events", fmt.Sprintf("%d-0", i), map[string]string{"n": "x"}, client.StreamAddOptions{})
+		require.NoError(t, err)
+		_, err = mockClient.AddStream(ctx, "events", fmt.Sprintf("%d-1", i), map[string]string{"n": "y"}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+
+	eviction, err := StreamTrimmed(ctx, mockClient, "events", client.StreamTrim{Strategy: "MAXLEN", Threshold: "10"}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(291), eviction.Count)
+	assert.False(t, eviction.AtLeast)
+	assert.Len(t, eviction.Entries, MaxPreviewElements)
+	assert.Equal(t, "1-0", eviction.Entries[0]["_id"])
+
+	// A MINID threshold without sequence keeps every entry of that
+	// millisecond.
+	eviction, err = StreamTrimmed(ctx, mockClient, "events", client.StreamTrim{Strategy: "MINID", Threshold: "3"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), eviction.Count)
+	assert.Equal(t, "2-1", eviction.Entries[3]["_id"])
+	assert.Equal(t, "4", eviction.Quantity(false))
+	assert.Equal(t, "up to 4", eviction.Quantity(true))
+
+	eviction, err = StreamTrimmed(ctx, mockClient, "missing", client.StreamTrim{Strategy: "MAXLEN", Threshold: "0"}, 0)
+	require.NoError(t, err)
+	assert.Zero(t, eviction.Count)
+}
+
+func TestStreamTrimmed_MinIDStopsCounting(t *testing.T) {
+	pages := 0
+	mockClient := &client.MockValkeyClient{
+		GetStreamRangeFunc: func(ctx context.Context, key, start, end string, count int64) ([]client.StreamEntry, error) {
+			pages++
+			entries := make([]client.StreamEntry, count)
+			for i := range entries {
+				entries[i] = client.StreamEntry{ID: fmt.Sprintf("%d-%d", pages, i)}
+			}
+			return entries, nil
+		},
+	}
+
+	eviction, err := StreamTrimmed(context.Background(), mockClient, "events", client.StreamTrim{Strategy: "MINID", Threshold: "999999"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, maxTrimPages, pages)
+	assert.Equal(t, int64(maxTrimPages*1000), eviction.Count)
+	assert.True(t, eviction.AtLeast)
+	assert.Len(t, eviction.Entries, MaxPreviewElements)
+	assert.Equal(t, "at least 100000", eviction.Quantity(false))
+	assert.Equal(t, "up to 100000 or more", eviction.Quantity(true))
+}
@@ -0,0 +1,39 @@
+package base
+
+import "sort"
+
+// TopN keeps the n highest ranked items added to it.
+type TopN[T any] struct {
+	n     int
+	less  func(a, b T) bool
+	items []T
+}
+
+// NewTopN creates a TopN holding at most n items. less reports whether a
+// ranks below b.
+func NewTopN[T any](n int, less func(a, b T) bool) *TopN[T] {
+	return &TopN[T]{n: n, less: less}
+}
+
+// Add offers item, dropping the lowest ranked item when the TopN is full.
+func (t *TopN[T]) Add(item T) {
+	if t.n <= 0 {
+		return
+	}
+	// items is kept sorted from highest to lowest rank.
+	i := sort.Search(len(t.items), func(i int) bool { return t.less(t.items[i], item) })
+	if i >= t.n {
+		return
+	}
+	if len(t.items) < t.n {
+		var zero T
+		t.items = append(t.items, zero)
+	}
+	copy(t.items[i+1:], t.items[i:])
+	t.items[i] = item
+}
+
+// Items returns the kept items, highest ranked first.
+func (t *TopN[T]) Items() []T {
+	return append([]T(nil), t.items...)
+}
@@ -0,0 +1,21 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopN(t *testing.T) {
+	top := NewTopN(3, func(a, b int) bool { return a < b })
+	for _, n := range []int{5, 1, 9, 3, 7, 9} {
+		top.Add(n)
+	}
+	assert.Equal(t, []int{9, 9, 7}, top.Items())
+}
+
+func TestTopN_Empty(t *testing.T) {
+	top := NewTopN(0, func(a, b int) bool { return a < b })
+	top.Add(1)
+	assert.Empty(t, top.Items())
+}
@@ -0,0 +1,69 @@
+// Package bitcount_bitmap implements the bitcount_bitmap tool.
+package bitcount_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the bitcount_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for bitcount_bitmap tool.
+type Input struct {
+	Key   string `json:"key" jsonschema:"required,description=Bitmap key"`
+	Start *int64 `json:"start,omitempty" jsonschema:"description=First byte (or bit with unit bit) to count; negative counts from the end (default: whole string)"`
+	End   *int64 `json:"end,omitempty" jsonschema:"description=Last byte (or bit) to count inclusive; requires start (default: end of string)"`
+	Unit  string `json:"unit,omitempty" jsonschema:"description=Unit of start and end: byte or bit (default: byte)"`
+}
+
+// Output represents the output of bitcount_bitmap tool.
+type Output struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count" jsonschema:"description=Number of set bits in the range"`
+}
+
+// NewTool creates a new bitcount_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"bitcount_bitmap",
+			"Count the set bits of a bitmap with BITCOUNT; optionally within a byte or bit range",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	r, err := base.BitRange(params.Start, params.End, params.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := t.client.BitCount(ctx, params.Key, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count bits of key %q: %w", params.Key, err)
+	}
+	return Output{Key: params.Key, Count: count}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,48 @@
+package bitcount_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitCountBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "dau", "\xff\x0f\x01", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	tests := []struct {
+		input string
+		count int64
+	}{
+		{`{"key":"dau"}`, 13},
+		{`{"key":"dau","start":1}`, 5},
+		{`{"key":"dau","start":0,"end":0}`, 8},
+		{`{"key":"dau","start":-2,"end":-1}`, 5},
+		{`{"key":"dau","start":4,"end":11,"unit":"bit"}`, 4},
+		{`{"key":"dau","start":-1,"unit":"bit"}`, 1},
+		{`{"key":"missing"}`, 0},
+	}
+	for _, tt := range tests {
+		result, err := tool.Execute(ctx, json.RawMessage(tt.input))
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.count, result.(Output).Count, tt.input)
+	}
+}
+
+func TestBitCountBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":""}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"dau","end":3}`))
+	assert.EqualError(t, err, "end requires start")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"dau","start":0,"unit":"word"}`))
+	assert.EqualError(t, err, "unit must be byte or bit")
+}
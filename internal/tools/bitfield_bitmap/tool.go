@@ -0,0 +1,220 @@
+// Package bitfield_bitmap implements the bitfield_bitmap tool.
+package bitfield_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the bitfield_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for bitfield_bitmap tool.
+type Input struct {
+	Key        string      `json:"key" jsonschema:"required,description=Bitmap key"`
+	Operations []Operation `json:"operations" jsonschema:"required,description=Subcommands run in order in one BITFIELD call"`
+}
+
+// Operation is one BITFIELD subcommand.
+type Operation struct {
+	Op       string `json:"op" jsonschema:"required,description=GET; SET; INCRBY or OVERFLOW"`
+	Encoding string `json:"encoding,omitempty" jsonschema:"description=Integer type of the field: i1 to i64 or u1 to u63; not used by OVERFLOW"`
+	Offset   string `json:"offset,omitempty" jsonschema:"description=Bit offset of the field or #n for the n-th field of the encoding's width; not used by OVERFLOW"`
+	Value    *int64 `json:"value,omitempty" jsonschema:"description=Value of SET or increment of INCRBY"`
+	Overflow string `json:"overflow,omitempty" jsonschema:"description=Overflow behaviour of the following SET and INCRBY subcommands: WRAP; SAT or FAIL (default: WRAP)"`
+}
+
+// Output represents the output of bitfield_bitmap tool.
+type Output struct {
+	Key     string   `json:"key"`
+	Results []Result `json:"results"`
+}
+
+// Result is the reply to a GET, SET or INCRBY subcommand.
+type Result struct {
+	Op       string `json:"op"`
+	Encoding string `json:"encoding"`
+	Offset   string `json:"offset"`
+	Value    *int64 `json:"value" jsonschema:"description=Field value for GET; previous value for SET; new value for INCRBY; null when OVERFLOW FAIL skipped the write"`
+}
+
+// NewTool creates a new bitfield_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"bitfield_bitmap",
+			"Read and write integer fields of a bitmap with BITFIELD. Runs GET; SET; INCRBY and OVERFLOW subcommands in order and returns one result per GET; SET and INCRBY",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, ops, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := t.client.BitField(ctx, params.Key, ops, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run BITFIELD on key %q: %w", params.Key, err)
+	}
+	results := []Result{}
+	for _, op := range ops {
+		if op.Op == "OVERFLOW" || len(results) == len(values) {
+			continue
+		}
+		results = append(results, Result{Op: op.Op, Encoding: op.Encoding, Offset: op.Offset, Value: values[len(results)]})
+	}
+	return Output{Key: params.Key, Results: results}, nil
+}
+
+// Preview implements registry.Previewer. It reads the fields the call would
+// write and works out their new values, including overflow handling.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, ops, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	var gets []client.BitFieldOp
+	for _, op := range ops {
+		if op.Op == "SET" || op.Op == "INCRBY" {
+			gets = append(gets, client.BitFieldOp{Op: "GET", Encoding: op.Encoding, Offset: op.Offset})
+		}
+	}
+	var currents []*int64
+	if len(gets) > 0 {
+		if currents, err = t.client.BitField(ctx, params.Key, gets, true); err != nil {
+			return nil, fmt.Errorf("failed to read fields of key %q: %w", params.Key, err)
+		}
+	}
+
+	// written tracks fields set earlier in the call, by encoding and offset.
+	written := map[string]int64{}
+	overflow := "WRAP"
+	changes := []base.Change{}
+	failed := 0
+	for _, op := range ops {
+		switch op.Op {
+		case "OVERFLOW":
+			overflow = op.Overflow
+			continue
+		case "GET":
+			continue
+		}
+		field := op.Encoding + " " + op.Offset
+		current := *currents[len(changes)]
+		if v, ok := written[field]; ok {
+			current = v
+		}
+		next := big.NewInt(op.Value)
+		if op.Op == "INCRBY" {
+			next.Add(next, big.NewInt(current))
+		}
+		signed, width, _ := base.BitFieldField(op.Encoding, op.Offset)
+		value, ok := fit(next, signed, width, overflow)
+		if !ok {
+			failed++
+			value = current
+		}
+		written[field] = value
+		changes = append(changes, base.Change{Target: field, Current: current, New: value})
+	}
+
+	summary := fmt.Sprintf("Run %d BITFIELD subcommands on key %q writing %d fields", len(ops), params.Key, len(changes))
+	if failed > 0 {
+		summary += fmt.Sprintf("; %d would overflow and be left unchanged", failed)
+	}
+	return &base.Preview{
+		Summary: summary,
+		Keys:    []*base.KeyState{state},
+		Changes: changes,
+	}, nil
+}
+
+// fit applies an OVERFLOW behaviour to value for a field of the given type.
+// It returns false when FAIL would skip the write.
+func fit(value *big.Int, signed bool, width int64, overflow string) (int64, bool) {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(width))
+	lowest, highest := big.NewInt(0), new(big.Int).Sub(limit, big.NewInt(1))
+	if signed {
+		lowest = new(big.Int).Neg(new(big.Int).Rsh(limit, 1))
+		highest = new(big.Int).Sub(new(big.Int).Rsh(limit, 1), big.NewInt(1))
+	}
+	switch {
+	case value.Cmp(lowest) >= 0 && value.Cmp(highest) <= 0:
+		return value.Int64(), true
+	case overflow == "FAIL":
+		return 0, false
+	case overflow == "SAT" && value.Sign() < 0:
+		return lowest.Int64(), true
+	case overflow == "SAT":
+		return highest.Int64(), true
+	}
+	wrapped := new(big.Int).Sub(value, lowest)
+	wrapped.Mod(wrapped, limit).Add(wrapped, lowest)
+	return wrapped.Int64(), true
+}
+
+// parse validates input and converts the operations to BITFIELD
+// subcommands.
+func (t *Tool) parse(input json.RawMessage) (Input, []client.BitFieldOp, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, nil, err
+	}
+	if params.Key == "" {
+		return params, nil, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.Operations) == 0 {
+		return params, nil, fmt.Errorf("operations cannot be empty")
+	}
+
+	ops := make([]client.BitFieldOp, 0, len(params.Operations))
+	for i, operation := range params.Operations {
+		op := client.BitFieldOp{Op: strings.ToUpper(operation.Op), Encoding: operation.Encoding, Offset: operation.Offset}
+		switch op.Op {
+		case "OVERFLOW":
+			op.Overflow = strings.ToUpper(operation.Overflow)
+			if op.Overflow != "WRAP" && op.Overflow != "SAT" && op.Overflow != "FAIL" {
+				return params, nil, fmt.Errorf("operation %d: overflow must be WRAP, SAT or FAIL", i)
+			}
+			ops = append(ops, op)
+			continue
+		case "GET":
+		case "SET", "INCRBY":
+			if operation.Value == nil {
+				return params, nil, fmt.Errorf("operation %d: %s requires value", i, op.Op)
+			}
+			op.Value = *operation.Value
+		default:
+			return params, nil, fmt.Errorf("operation %d: op must be GET, SET, INCRBY or OVERFLOW", i)
+		}
+		if _, _, err := base.BitFieldField(op.Encoding, op.Offset); err != nil {
+			return params, nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		ops = append(ops, op)
+	}
+	return params, ops, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,89 @@
+package bitfield_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr(v int64) *int64 {
+	return &v
+}
+
+func TestBitFieldBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"counters","operations":[
+		{"op":"set","encoding":"u8","offset":"#1","value":200},
+		{"op":"incrby","encoding":"u8","offset":"#1","value":100},
+		{"op":"overflow","overflow":"sat"},
+		{"op":"incrby","encoding":"i8","offset":"0","value":-200},
+		{"op":"overflow","overflow":"fail"},
+		{"op":"incrby","encoding":"u8","offset":"#1","value":255},
+		{"op":"get","encoding":"u16","offset":"0"}
+	]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "counters", Results: []Result{
+		{Op: "SET", Encoding: "u8", Offset: "#1", Value: ptr(0)},
+		{Op: "INCRBY", Encoding: "u8", Offset: "#1", Value: ptr(44)},
+		{Op: "INCRBY", Encoding: "i8", Offset: "0", Value: ptr(-128)},
+		{Op: "INCRBY", Encoding: "u8", Offset: "#1", Value: nil},
+		{Op: "GET", Encoding: "u16", Offset: "0", Value: ptr(0x802c)},
+	}}, result)
+}
+
+func TestBitFieldBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	tests := map[string]string{
+		`{"key":"","operations":[{"op":"GET","encoding":"u8","offset":"0"}]}`:   "key cannot be empty",
+		`{"key":"k","operations":[]}`:                                           "operations cannot be empty",
+		`{"key":"k","operations":[{"op":"DEL"}]}`:                               "operation 0: op must be GET, SET, INCRBY or OVERFLOW",
+		`{"key":"k","operations":[{"op":"OVERFLOW","overflow":"CLAMP"}]}`:       "operation 0: overflow must be WRAP, SAT or FAIL",
+		`{"key":"k","operations":[{"op":"SET","encoding":"u8","offset":"0"}]}`:  "operation 0: SET requires value",
+		`{"key":"k","operations":[{"op":"GET","encoding":"u64","offset":"0"}]}`: `operation 0: encoding "u64" must be i1 to i64 or u1 to u63`,
+		`{"key":"k","operations":[{"op":"GET","encoding":"u8","offset":"-8"}]}`: `operation 0: offset "-8" must be a non-negative bit offset or #n`,
+	}
+	for input, want := range tests {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
+
+func TestBitFieldBitmap_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "counters", "\x05\xfa", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"key":"counters","operations":[
+		{"op":"INCRBY","encoding":"u8","offset":"#0","value":1},
+		{"op":"INCRBY","encoding":"u8","offset":"#0","value":1},
+		{"op":"OVERFLOW","overflow":"FAIL"},
+		{"op":"INCRBY","encoding":"u8","offset":"#1","value":10},
+		{"op":"OVERFLOW","overflow":"SAT"},
+		{"op":"SET","encoding":"i4","offset":"0","value":100},
+		{"op":"GET","encoding":"u8","offset":"#1"}
+	]}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Run 7 BITFIELD subcommands on key "counters" writing 4 fields; 1 would overflow and be left unchanged`, p.Summary)
+	assert.Equal(t, []base.Change{
+		{Target: "u8 #0", Current: int64(5), New: int64(6)},
+		{Target: "u8 #0", Current: int64(6), New: int64(7)},
+		{Target: "u8 #1", Current: int64(250), New: int64(250)},
+		{Target: "i4 0", Current: int64(0), New: int64(7)},
+	}, p.Changes)
+
+	value, _, err := mockClient.GetString(ctx, "counters")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\x05\xfa"), value)
+}
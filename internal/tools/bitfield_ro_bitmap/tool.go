@@ -0,0 +1,94 @@
+// Package bitfield_ro_bitmap implements the bitfield_ro_bitmap tool.
+package bitfield_ro_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the bitfield_ro_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for bitfield_ro_bitmap tool.
+type Input struct {
+	Key    string  `json:"key" jsonschema:"required,description=Bitmap key"`
+	Fields []Field `json:"fields" jsonschema:"required,description=Fields to read"`
+}
+
+// Field is an integer field of a bitmap.
+type Field struct {
+	Encoding string `json:"encoding" jsonschema:"required,description=Integer type of the field: i1 to i64 or u1 to u63"`
+	Offset   string `json:"offset" jsonschema:"required,description=Bit offset of the field or #n for the n-th field of the encoding's width"`
+}
+
+// Output represents the output of bitfield_ro_bitmap tool.
+type Output struct {
+	Key    string  `json:"key"`
+	Values []Value `json:"values"`
+}
+
+// Value is the value of a field.
+type Value struct {
+	Encoding string `json:"encoding"`
+	Offset   string `json:"offset"`
+	Value    int64  `json:"value"`
+}
+
+// NewTool creates a new bitfield_ro_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"bitfield_ro_bitmap",
+			"Read integer fields of a bitmap with BITFIELD_RO. Safe on replicas; bits past the end of the string read as 0",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.Fields) == 0 {
+		return nil, fmt.Errorf("fields cannot be empty")
+	}
+	ops := make([]client.BitFieldOp, len(params.Fields))
+	for i, field := range params.Fields {
+		if _, _, err := base.BitFieldField(field.Encoding, field.Offset); err != nil {
+			return nil, fmt.Errorf("field %d: %w", i, err)
+		}
+		ops[i] = client.BitFieldOp{Op: "GET", Encoding: field.Encoding, Offset: field.Offset}
+	}
+
+	results, err := t.client.BitField(ctx, params.Key, ops, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fields of key %q: %w", params.Key, err)
+	}
+	values := make([]Value, 0, len(results))
+	for i, result := range results {
+		value := Value{Encoding: params.Fields[i].Encoding, Offset: params.Fields[i].Offset}
+		if result != nil {
+			value.Value = *result
+		}
+		values = append(values, value)
+	}
+	return Output{Key: params.Key, Values: values}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,44 @@
+package bitfield_ro_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitFieldROBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "counters", "\x05\xfa", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"counters","fields":[
+		{"encoding":"u8","offset":"#0"},
+		{"encoding":"i8","offset":"#1"},
+		{"encoding":"u4","offset":"12"},
+		{"encoding":"u16","offset":"#5"}
+	]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "counters", Values: []Value{
+		{Encoding: "u8", Offset: "#0", Value: 5},
+		{Encoding: "i8", Offset: "#1", Value: -6},
+		{Encoding: "u4", Offset: "12", Value: 10},
+		{Encoding: "u16", Offset: "#5", Value: 0},
+	}}, result)
+}
+
+func TestBitFieldROBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","fields":[{"encoding":"u8","offset":"0"}]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"k","fields":[]}`))
+	assert.EqualError(t, err, "fields cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"k","fields":[{"encoding":"u8","offset":"0"},{"encoding":"f32","offset":"0"}]}`))
+	assert.EqualError(t, err, `field 1: encoding "f32" must be i1 to i64 or u1 to u63`)
+}
@@ -0,0 +1,139 @@
+// Package bitop_bitmap implements the bitop_bitmap tool.
+package bitop_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the bitop_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for bitop_bitmap tool.
+type Input struct {
+	Operation   string   `json:"operation" jsonschema:"required,description=AND; OR; XOR; NOT; DIFF; DIFF1; ANDOR or ONE. DIFF; DIFF1; ANDOR and ONE need a server that supports them"`
+	Destination string   `json:"destination" jsonschema:"required,description=Key to store the result in; replaced if it exists"`
+	Keys        []string `json:"keys" jsonschema:"required,description=Source bitmaps; NOT takes exactly one and DIFF; DIFF1 and ANDOR at least two"`
+}
+
+// Output represents the output of bitop_bitmap tool.
+type Output struct {
+	Operation   string `json:"operation"`
+	Destination string `json:"destination"`
+	Length      int64  `json:"length" jsonschema:"description=Length in bytes of the result; the longest source"`
+}
+
+// NewTool creates a new bitop_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"bitop_bitmap",
+			"Combine bitmaps with BITOP and store the result in destination. Shorter sources are padded with zero bytes; an empty result deletes destination",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := t.client.BitOp(ctx, params.Operation, params.Destination, params.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run BITOP %s into key %q: %w", params.Operation, params.Destination, err)
+	}
+	return Output{Operation: params.Operation, Destination: params.Destination, Length: length}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := t.client.ExistsKey(ctx, params.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check key %q: %w", params.Destination, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return &registry.Impact{
+		Summary:    fmt.Sprintf("Store BITOP %s of %d keys in %q, replacing its current value", params.Operation, len(params.Keys), params.Destination),
+		Keys:       []string{params.Destination},
+		Overwrites: []string{params.Destination},
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := base.DescribeKeys(ctx, t.client, append(append([]string(nil), params.Keys...), params.Destination))
+	if err != nil {
+		return nil, err
+	}
+	preview := &base.Preview{Keys: states}
+	destination := states[len(states)-1]
+	preview.Summary = fmt.Sprintf("Store BITOP %s of %d keys in %q", params.Operation, len(params.Keys), params.Destination)
+	if destination.Exists() {
+		preview.Summary += fmt.Sprintf(", overwriting the existing %s", destination.Type)
+		preview.Overwrites = []string{params.Destination}
+	}
+	return preview, nil
+}
+
+// parse validates input and upper-cases the operation.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	params.Operation = strings.ToUpper(params.Operation)
+	if params.Destination == "" {
+		return params, fmt.Errorf("destination cannot be empty")
+	}
+	if len(params.Keys) == 0 {
+		return params, fmt.Errorf("keys cannot be empty")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return params, fmt.Errorf("key cannot be empty")
+		}
+	}
+	switch params.Operation {
+	case "AND", "OR", "XOR", "ONE":
+	case "NOT":
+		if len(params.Keys) != 1 {
+			return params, fmt.Errorf("NOT takes exactly one key")
+		}
+	case "DIFF", "DIFF1", "ANDOR":
+		if len(params.Keys) < 2 {
+			return params, fmt.Errorf("%s takes at least two keys", params.Operation)
+		}
+	default:
+		return params, fmt.Errorf("operation must be AND, OR, XOR, NOT, DIFF, DIFF1, ANDOR or ONE")
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
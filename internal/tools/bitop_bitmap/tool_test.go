@@ -0,0 +1,98 @@
+package bitop_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for key, value := range map[string]string{"a": "\xf0\x0f", "b": "\xcc", "c": "\x81"} {
+		_, err := mockClient.SetString(ctx, key, value, nil, false, false)
+		require.NoError(t, err)
+	}
+	return mockClient
+}
+
+func TestBitOpBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+
+	tests := []struct {
+		operation string
+		keys      string
+		want      []byte
+	}{
+		{"and", `["a","b"]`, []byte{0xc0, 0x00}},
+		{"OR", `["a","b"]`, []byte{0xfc, 0x0f}},
+		{"XOR", `["a","b"]`, []byte{0x3c, 0x0f}},
+		{"NOT", `["b"]`, []byte{0x33}},
+		{"DIFF", `["a","b"]`, []byte{0x30, 0x0f}},
+		{"DIFF1", `["a","b"]`, []byte{0x0c, 0x00}},
+		{"ANDOR", `["a","b","c"]`, []byte{0xc0, 0x00}},
+		{"ONE", `["a","b","c"]`, []byte{0x3d, 0x0f}},
+	}
+	for _, tt := range tests {
+		input := `{"operation":"` + tt.operation + `","destination":"out","keys":` + tt.keys + `}`
+		result, err := tool.Execute(ctx, json.RawMessage(input))
+		require.NoError(t, err, tt.operation)
+		assert.Equal(t, int64(len(tt.want)), result.(Output).Length, tt.operation)
+		value, _, err := mockClient.GetString(ctx, "out")
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, value, tt.operation)
+	}
+}
+
+func TestBitOpBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	tests := map[string]string{
+		`{"operation":"AND","destination":"","keys":["a"]}`:        "destination cannot be empty",
+		`{"operation":"AND","destination":"out","keys":[]}`:        "keys cannot be empty",
+		`{"operation":"NOT","destination":"out","keys":["a","b"]}`: "NOT takes exactly one key",
+		`{"operation":"DIFF","destination":"out","keys":["a"]}`:    "DIFF takes at least two keys",
+		`{"operation":"NAND","destination":"out","keys":["a"]}`:    "operation must be AND, OR, XOR, NOT, DIFF, DIFF1, ANDOR or ONE",
+	}
+	for input, want := range tests {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
+
+func TestBitOpBitmap_AssessAndPreview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := setup(t)
+	tool := NewTool(mockClient).(*Tool)
+	input := json.RawMessage(`{"operation":"OR","destination":"out","keys":["a","b"]}`)
+
+	impact, err := tool.Assess(ctx, input)
+	require.NoError(t, err)
+	assert.Nil(t, impact)
+	preview, err := tool.Preview(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, `Store BITOP OR of 2 keys in "out"`, preview.(*base.Preview).Summary)
+	assert.Len(t, preview.(*base.Preview).Keys, 3)
+
+	_, err = tool.Execute(ctx, input)
+	require.NoError(t, err)
+	impact, err = tool.Assess(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, &registry.Impact{
+		Summary:    `Store BITOP OR of 2 keys in "out", replacing its current value`,
+		Keys:       []string{"out"},
+		Overwrites: []string{"out"},
+	}, impact)
+	preview, err = tool.Preview(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, `Store BITOP OR of 2 keys in "out", overwriting the existing string`, preview.(*base.Preview).Summary)
+	assert.Equal(t, []string{"out"}, preview.(*base.Preview).Overwrites)
+}
@@ -0,0 +1,75 @@
+// Package bitpos_bitmap implements the bitpos_bitmap tool.
+package bitpos_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the bitpos_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for bitpos_bitmap tool.
+type Input struct {
+	Key   string `json:"key" jsonschema:"required,description=Bitmap key"`
+	Bit   int64  `json:"bit" jsonschema:"required,minimum=0,maximum=1,description=Bit value to look for: 0 or 1"`
+	Start *int64 `json:"start,omitempty" jsonschema:"description=First byte (or bit with unit bit) to search; negative counts from the end (default: whole string)"`
+	End   *int64 `json:"end,omitempty" jsonschema:"description=Last byte (or bit) to search inclusive; requires start (default: end of string)"`
+	Unit  string `json:"unit,omitempty" jsonschema:"description=Unit of start and end: byte or bit (default: byte)"`
+}
+
+// Output represents the output of bitpos_bitmap tool.
+type Output struct {
+	Key      string `json:"key"`
+	Bit      int64  `json:"bit"`
+	Position int64  `json:"position" jsonschema:"description=Offset of the first matching bit from the start of the string; -1 when none"`
+	Found    bool   `json:"found"`
+}
+
+// NewTool creates a new bitpos_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"bitpos_bitmap",
+			"Find the first bit set to 0 or 1 in a bitmap with BITPOS; optionally within a byte or bit range. Looking for 0 without end reports the bit after the string when every bit is set",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Bit != 0 && params.Bit != 1 {
+		return nil, fmt.Errorf("bit must be 0 or 1")
+	}
+	r, err := base.BitRange(params.Start, params.End, params.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := t.client.BitPos(ctx, params.Key, params.Bit, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bit %d in key %q: %w", params.Bit, params.Key, err)
+	}
+	return Output{Key: params.Key, Bit: params.Bit, Position: position, Found: position >= 0}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
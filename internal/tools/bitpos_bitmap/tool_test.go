@@ -0,0 +1,53 @@
+package bitpos_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitPosBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "flags", "\xff\xf0\x00", nil, false, false)
+	require.NoError(t, err)
+	_, err = mockClient.SetString(ctx, "full", "\xff", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	tests := []struct {
+		input    string
+		position int64
+	}{
+		{`{"key":"flags","bit":0}`, 12},
+		{`{"key":"flags","bit":1}`, 0},
+		{`{"key":"flags","bit":1,"start":2}`, -1},
+		{`{"key":"flags","bit":1,"start":9,"unit":"bit"}`, 9},
+		{`{"key":"full","bit":0}`, 8},
+		{`{"key":"full","bit":0,"start":0,"end":0}`, -1},
+		{`{"key":"missing","bit":0}`, 0},
+		{`{"key":"missing","bit":1}`, -1},
+	}
+	for _, tt := range tests {
+		result, err := tool.Execute(ctx, json.RawMessage(tt.input))
+		require.NoError(t, err, tt.input)
+		output := result.(Output)
+		assert.Equal(t, tt.position, output.Position, tt.input)
+		assert.Equal(t, tt.position >= 0, output.Found, tt.input)
+	}
+}
+
+func TestBitPosBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","bit":1}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"flags","bit":2}`))
+	assert.EqualError(t, err, "bit must be 0 or 1")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"flags","bit":1,"end":2}`))
+	assert.EqualError(t, err, "end requires start")
+}
@@ -0,0 +1,135 @@
+// Package blmove_list implements the blmove_list tool.
+package blmove_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the blmove_list functionality.
+type Tool struct {
+	base.BaseTool
+	client     client.ValkeyClient
+	maxTimeout time.Duration
+}
+
+// Input represents the input for blmove_list tool.
+type Input struct {
+	Source      string `json:"source" jsonschema:"required,description=List to take the element from"`
+	Destination string `json:"destination" jsonschema:"required,description=List to add the element to (may equal source to rotate it)"`
+	From        string `json:"from,omitempty" jsonschema:"description=End of source to take from: left or right (default: left)"`
+	To          string `json:"to,omitempty" jsonschema:"description=End of destination to add to: left or right (default: right)"`
+	TimeoutMs   *int64 `json:"timeout_ms,omitempty" jsonschema:"description=Milliseconds to wait for an element (default: 10000; capped at the server maximum)"`
+}
+
+// Output represents the output of blmove_list tool.
+type Output struct {
+	Element   any   `json:"element,omitempty"`
+	TimedOut  bool  `json:"timed_out"`
+	TimeoutMs int64 `json:"timeout_ms"`
+}
+
+// NewTool creates a new blmove_list tool that waits at most maxTimeout.
+func NewTool(client client.ValkeyClient, maxTimeout time.Duration) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"blmove_list",
+			"Atomically move an element from one end of a list to an end of another with BLMOVE, waiting for one to be pushed when the source is empty. Moving jobs to a processing list keeps them safe until they are removed. Reports timed_out when none arrives within timeout_ms",
+			Input{},
+		),
+		client:     client,
+		maxTimeout: maxTimeout,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, timeout, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	value, moved, err := t.client.BlockingMoveList(ctx, params.Source, params.Destination, params.From, params.To, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move from list %q to list %q: %w", params.Source, params.Destination, err)
+	}
+
+	output := Output{TimedOut: !moved, TimeoutMs: timeout.Milliseconds()}
+	if moved {
+		output.Element = base.SafeValue(value)
+	}
+	return output, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, timeout, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := base.DescribeKeys(ctx, t.client, []string{params.Source, params.Destination})
+	if err != nil {
+		return nil, err
+	}
+	index := int64(0)
+	if params.From == "RIGHT" {
+		index = -1
+	}
+	value, ok, err := t.client.GetListIndex(ctx, params.Source, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read list %q: %w", params.Source, err)
+	}
+	if !ok {
+		return &base.Preview{
+			Summary: fmt.Sprintf("List %q is empty; wait up to %dms for an element to move to list %q", params.Source, timeout.Milliseconds(), params.Destination),
+			Keys:    keys,
+		}, nil
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Move the %s element of list %q to the %s of list %q",
+			strings.ToLower(params.From), params.Source, strings.ToLower(params.To), params.Destination),
+		Keys:    keys,
+		Changes: []base.Change{{Target: params.Destination, Current: nil, New: base.SafeValue(value)}},
+	}, nil
+}
+
+// parse validates input, normalizes the list ends to LEFT or RIGHT and
+// returns how long the call may wait.
+func (t *Tool) parse(input json.RawMessage) (Input, time.Duration, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, 0, err
+	}
+	if params.Source == "" {
+		return params, 0, fmt.Errorf("source cannot be empty")
+	}
+	if params.Destination == "" {
+		return params, 0, fmt.Errorf("destination cannot be empty")
+	}
+	if params.From == "" {
+		params.From = "left"
+	}
+	if params.To == "" {
+		params.To = "right"
+	}
+	params.From, params.To = strings.ToUpper(params.From), strings.ToUpper(params.To)
+	for _, end := range []string{params.From, params.To} {
+		if end != "LEFT" && end != "RIGHT" {
+			return params, 0, fmt.Errorf("invalid list end %q: must be left or right", strings.ToLower(end))
+		}
+	}
+	timeout, err := base.BlockTimeout(params.TimeoutMs, t.maxTimeout)
+	return params, timeout, err
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration) {
+	reg.MustRegister(NewTool(client, maxTimeout))
+}
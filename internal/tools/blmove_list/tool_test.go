@@ -0,0 +1,88 @@
+package blmove_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLMoveList_Execute_Moves(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a", "b"}, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"source":"jobs","destination":"processing","from":"right","to":"left"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Element: "b", TimeoutMs: 10000}, result)
+
+	jobs, err := mockClient.GetListRange(ctx, "jobs", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a")}, jobs)
+	processing, err := mockClient.GetListRange(ctx, "processing", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b")}, processing)
+}
+
+func TestBLMoveList_Execute_WaitsForPush(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_, _ = mockClient.PushList(ctx, "jobs", []string{"job-1"}, true)
+	}()
+	result, err := tool.Execute(ctx, json.RawMessage(`{"source":"jobs","destination":"processing","timeout_ms":5000}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Element: "job-1", TimeoutMs: 5000}, result)
+}
+
+func TestBLMoveList_Execute_TimedOut(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.BlockingMoveListFunc = func(ctx context.Context, source, destination, from, to string, timeout time.Duration) ([]byte, bool, error) {
+		assert.Equal(t, "LEFT", from)
+		assert.Equal(t, "RIGHT", to)
+		assert.Equal(t, 500*time.Millisecond, timeout)
+		return nil, false, nil
+	}
+	tool := NewTool(mockClient, 500*time.Millisecond)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"source":"jobs","destination":"processing"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{TimedOut: true, TimeoutMs: 500}, result)
+}
+
+func TestBLMoveList_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a", "b"}, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"source":"jobs","destination":"processing","from":"RIGHT"}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Move the right element of list "jobs" to the right of list "processing"`, p.Summary)
+	assert.Equal(t, []base.Change{{Target: "processing", New: "b"}}, p.Changes)
+	require.Len(t, p.Keys, 2)
+	assert.False(t, p.Keys[1].Exists())
+}
+
+func TestBLMoveList_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"source":"","destination":"b"}`))
+	assert.EqualError(t, err, "source cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"source":"a","destination":""}`))
+	assert.EqualError(t, err, "destination cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"source":"a","destination":"b","from":"middle"}`))
+	assert.EqualError(t, err, `invalid list end "middle": must be left or right`)
+}
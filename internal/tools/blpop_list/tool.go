@@ -0,0 +1,113 @@
+// Package blpop_list implements the blpop_list tool.
+package blpop_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the blpop_list functionality.
+type Tool struct {
+	base.BaseTool
+	client     client.ValkeyClient
+	maxTimeout time.Duration
+}
+
+// Input represents the input for blpop_list tool.
+type Input struct {
+	Keys      []string `json:"keys" jsonschema:"required,description=List keys checked in order"`
+	TimeoutMs *int64   `json:"timeout_ms,omitempty" jsonschema:"description=Milliseconds to wait for an element (default: 10000; capped at the server maximum)"`
+}
+
+// Output represents the output of blpop_list tool.
+type Output struct {
+	// Key is the list the element was popped from.
+	Key       string `json:"key,omitempty"`
+	Element   any    `json:"element,omitempty"`
+	TimedOut  bool   `json:"timed_out"`
+	TimeoutMs int64  `json:"timeout_ms"`
+}
+
+// NewTool creates a new blpop_list tool that waits at most maxTimeout.
+func NewTool(client client.ValkeyClient, maxTimeout time.Duration) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"blpop_list",
+			"Remove and return the head of the first non-empty list with BLPOP, waiting for an element to be pushed when all are empty. Reports timed_out when none arrives within timeout_ms",
+			Input{},
+		),
+		client:     client,
+		maxTimeout: maxTimeout,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, timeout, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pop, err := t.client.BlockingPopList(ctx, params.Keys, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pop from lists %q: %w", params.Keys, err)
+	}
+
+	output := Output{TimedOut: pop == nil, TimeoutMs: timeout.Milliseconds()}
+	if pop != nil {
+		output.Key, output.Element = pop.Key, base.SafeValue(pop.Value)
+	}
+	return output, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, timeout, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range params.Keys {
+		head, ok, err := t.client.GetListIndex(ctx, key, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read list %q: %w", key, err)
+		}
+		if ok {
+			return &base.Preview{
+				Summary: fmt.Sprintf("Pop the head of list %q", key),
+				Removed: []any{base.SafeValue(head)},
+			}, nil
+		}
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("All lists are empty; wait up to %dms for an element to pop", timeout.Milliseconds()),
+	}, nil
+}
+
+// parse validates input and returns how long the call may wait.
+func (t *Tool) parse(input json.RawMessage) (Input, time.Duration, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, 0, err
+	}
+	if len(params.Keys) == 0 {
+		return params, 0, fmt.Errorf("keys cannot be empty")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return params, 0, fmt.Errorf("key cannot be empty")
+		}
+	}
+	timeout, err := base.BlockTimeout(params.TimeoutMs, t.maxTimeout)
+	return params, timeout, err
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration) {
+	reg.MustRegister(NewTool(client, maxTimeout))
+}
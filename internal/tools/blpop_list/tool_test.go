@@ -0,0 +1,83 @@
+package blpop_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLPopList_Execute_PopsFirstNonEmpty(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "low", []string{"a", "b"}, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"keys":["high","low"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "low", Element: "a", TimeoutMs: 10000}, result)
+}
+
+func TestBLPopList_Execute_WaitsForPush(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_, _ = mockClient.PushList(ctx, "jobs", []string{"job-1"}, true)
+	}()
+	result, err := tool.Execute(ctx, json.RawMessage(`{"keys":["jobs"],"timeout_ms":5000}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "jobs", Element: "job-1", TimeoutMs: 5000}, result)
+}
+
+func TestBLPopList_Execute_TimedOut(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"keys":["jobs"],"timeout_ms":10}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{TimedOut: true, TimeoutMs: 10}, result)
+}
+
+func TestBLPopList_Execute_Cancelled(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err := tool.Execute(ctx, json.RawMessage(`{"keys":["jobs"]}`))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBLPopList_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, time.Second).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"keys":["jobs"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "All lists are empty; wait up to 1000ms for an element to pop", preview.(*base.Preview).Summary)
+
+	_, err = mockClient.PushList(ctx, "jobs", []string{"job-1"}, true)
+	require.NoError(t, err)
+	preview, err = tool.Preview(ctx, json.RawMessage(`{"keys":["jobs"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, &base.Preview{Summary: `Pop the head of list "jobs"`, Removed: []any{"job-1"}}, preview)
+}
+
+func TestBLPopList_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"keys":[]}`))
+	assert.EqualError(t, err, "keys cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"keys":["jobs",""]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"keys":["jobs"],"timeout_ms":-1}`))
+	assert.EqualError(t, err, "timeout_ms must be positive")
+}
@@ -0,0 +1,118 @@
+// Package bzpopmin_zset implements the bzpopmin_zset tool.
+package bzpopmin_zset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the bzpopmin_zset functionality.
+type Tool struct {
+	base.BaseTool
+	client     client.ValkeyClient
+	maxTimeout time.Duration
+}
+
+// Input represents the input for bzpopmin_zset tool.
+type Input struct {
+	Keys      []string `json:"keys" jsonschema:"required,description=Sorted set keys checked in order"`
+	TimeoutMs *int64   `json:"timeout_ms,omitempty" jsonschema:"description=Milliseconds to wait for a member (default: 10000; capped at the server maximum)"`
+}
+
+// Output represents the output of bzpopmin_zset tool.
+type Output struct {
+	// Key is the sorted set the member was popped from.
+	Key       string   `json:"key,omitempty"`
+	Member    any      `json:"member,omitempty"`
+	Score     *float64 `json:"score,omitempty"`
+	TimedOut  bool     `json:"timed_out"`
+	TimeoutMs int64    `json:"timeout_ms"`
+}
+
+// NewTool creates a new bzpopmin_zset tool that waits at most maxTimeout.
+func NewTool(client client.ValkeyClient, maxTimeout time.Duration) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"bzpopmin_zset",
+			"Remove and return the lowest scored member of the first non-empty sorted set with BZPOPMIN, waiting for a member to be added when all are empty. Suits priority and delay queues. Reports timed_out when none arrives within timeout_ms",
+			Input{},
+		),
+		client:     client,
+		maxTimeout: maxTimeout,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, timeout, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pop, err := t.client.BlockingPopMinSortedSet(ctx, params.Keys, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pop from sorted sets %q: %w", params.Keys, err)
+	}
+
+	output := Output{TimedOut: pop == nil, TimeoutMs: timeout.Milliseconds()}
+	if pop != nil {
+		output.Key, output.Member, output.Score = pop.Key, base.SafeValue(pop.Member), &pop.Score
+	}
+	return output, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, timeout, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range params.Keys {
+		lowest, err := t.client.GetSortedSetRange(ctx, key, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sorted set %q: %w", key, err)
+		}
+		if len(lowest) > 0 {
+			removed := make([]any, 0, 1)
+			for _, member := range base.SafeSortedSet(lowest) {
+				removed = append(removed, member)
+			}
+			return &base.Preview{
+				Summary: fmt.Sprintf("Pop the lowest scored member of sorted set %q", key),
+				Removed: removed,
+			}, nil
+		}
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("All sorted sets are empty; wait up to %dms for a member to pop", timeout.Milliseconds()),
+	}, nil
+}
+
+// parse validates input and returns how long the call may wait.
+func (t *Tool) parse(input json.RawMessage) (Input, time.Duration, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, 0, err
+	}
+	if len(params.Keys) == 0 {
+		return params, 0, fmt.Errorf("keys cannot be empty")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return params, 0, fmt.Errorf("key cannot be empty")
+		}
+	}
+	timeout, err := base.BlockTimeout(params.TimeoutMs, t.maxTimeout)
+	return params, timeout, err
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration) {
+	reg.MustRegister(NewTool(client, maxTimeout))
+}
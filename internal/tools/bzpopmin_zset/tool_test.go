@@ -0,0 +1,88 @@
+package bzpopmin_zset
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBZPopMinZSet_Execute_PopsLowest(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.AddSortedSet(ctx, "tasks", []client.SortedSetMember{
+		{Member: []byte("later"), Score: 20},
+		{Member: []byte("soon"), Score: 5},
+	})
+	require.NoError(t, err)
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"keys":["urgent","tasks"]}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, "tasks", output.Key)
+	assert.Equal(t, "soon", output.Member)
+	require.NotNil(t, output.Score)
+	assert.Equal(t, float64(5), *output.Score)
+	assert.False(t, output.TimedOut)
+}
+
+func TestBZPopMinZSet_Execute_WaitsForAdd(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_, _ = mockClient.AddSortedSet(ctx, "tasks", []client.SortedSetMember{{Member: []byte("task-1"), Score: 1}})
+	}()
+	result, err := tool.Execute(ctx, json.RawMessage(`{"keys":["tasks"],"timeout_ms":5000}`))
+	require.NoError(t, err)
+	assert.Equal(t, "task-1", result.(Output).Member)
+}
+
+func TestBZPopMinZSet_Execute_TimedOut(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"keys":["tasks"],"timeout_ms":10}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{TimedOut: true, TimeoutMs: 10}, result)
+}
+
+func TestBZPopMinZSet_Execute_Cancelled(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err := tool.Execute(ctx, json.RawMessage(`{"keys":["tasks"]}`))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBZPopMinZSet_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.AddSortedSet(ctx, "tasks", []client.SortedSetMember{{Member: []byte("soon"), Score: 5}})
+	require.NoError(t, err)
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"keys":["tasks"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, &base.Preview{
+		Summary: `Pop the lowest scored member of sorted set "tasks"`,
+		Removed: []any{map[string]any{"member": "soon", "score": float64(5)}},
+	}, preview)
+}
+
+func TestBZPopMinZSet_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"keys":[]}`))
+	assert.EqualError(t, err, "keys cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"keys":["tasks"],"timeout_ms":0}`))
+	assert.EqualError(t, err, "timeout_ms must be positive")
+}
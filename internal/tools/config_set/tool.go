@@ -66,6 +66,48 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	summary := fmt.Sprintf("Set configuration %q to %q", params.Parameter, params.Value)
+	if current, err := t.client.ConfigGet(ctx, params.Parameter); err == nil {
+		if value, ok := current[params.Parameter]; ok {
+			summary = fmt.Sprintf("Change configuration %q from %q to %q", params.Parameter, value, params.Value)
+		}
+	}
+	return &registry.Impact{
+		Summary:          summary,
+		ConfigParameters: []string{params.Parameter},
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	current, err := t.client.ConfigGet(ctx, params.Parameter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configuration %q: %w", params.Parameter, err)
+	}
+	value, ok := current[params.Parameter]
+	if !ok {
+		return &base.Preview{
+			Summary: fmt.Sprintf("Configuration parameter %q is unknown; the call would fail", params.Parameter),
+		}, nil
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Change configuration %q from %q to %q", params.Parameter, value, params.Value),
+		Changes: []base.Change{{Target: params.Parameter, Current: value, New: params.Value}},
+	}, nil
+}
+
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 )
 
 func TestConfigSetTool_Execute_Success(t *testing.T) {
@@ -93,3 +94,47 @@ func TestConfigSetTool_Metadata(t *testing.T) {
 		t.Error("Expected non-nil input schema")
 	}
 }
+
+func TestTool_Assess(t *testing.T) {
+	mockClient := client.NewMockClient()
+	if _, err := mockClient.ConfigSet(context.Background(), "maxmemory", "100mb"); err != nil {
+		t.Fatal(err)
+	}
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"parameter": "maxmemory", "value": "200mb"})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	if err != nil {
+		t.Fatalf("Assess returned error: %v", err)
+	}
+	if want := `Change configuration "maxmemory" from "100mb" to "200mb"`; impact.Summary != want {
+		t.Errorf("Summary = %q, want %q", impact.Summary, want)
+	}
+	if len(impact.ConfigParameters) != 1 || impact.ConfigParameters[0] != "maxmemory" {
+		t.Errorf("ConfigParameters = %v", impact.ConfigParameters)
+	}
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	if _, err := mockClient.ConfigSet(context.Background(), "maxmemory", "100mb"); err != nil {
+		t.Fatal(err)
+	}
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"parameter": "maxmemory", "value": "200mb"})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	if err != nil {
+		t.Fatalf("Preview returned error: %v", err)
+	}
+	preview := result.(*base.Preview)
+	want := base.Change{Target: "maxmemory", Current: "100mb", New: "200mb"}
+	if len(preview.Changes) != 1 || preview.Changes[0] != want {
+		t.Errorf("Changes = %v, want %v", preview.Changes, want)
+	}
+
+	current, _ := mockClient.ConfigGet(context.Background(), "maxmemory")
+	if current["maxmemory"] != "100mb" {
+		t.Errorf("maxmemory changed to %q", current["maxmemory"])
+	}
+}
@@ -0,0 +1,487 @@
+// Package copy_keys implements the copy_keys tool.
+package copy_keys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/connections"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Modes of the copy.
+const (
+	ModeCopy = "copy"
+	ModeMove = "move"
+)
+
+// Statuses of a key in the report.
+const (
+	StatusCopied  = "copied"
+	StatusMoved   = "moved"
+	StatusSkipped = "skipped"
+	StatusFailed  = "failed"
+)
+
+// maxListedKeys is the number of per-key results reported.
+const maxListedKeys = 100
+
+// previewedKeys is the number of keys described in a dry run.
+const previewedKeys = 20
+
+// progressInterval is the number of explicit keys between progress reports.
+const progressInterval = 100
+
+// Tool implements the copy_keys functionality.
+type Tool struct {
+	base.BaseTool
+	connections *connections.Manager
+}
+
+// Input represents the input for copy_keys tool.
+type Input struct {
+	Keys             []string `json:"keys,omitempty" jsonschema:"description=Keys to copy; alternative to pattern"`
+	Pattern          string   `json:"pattern,omitempty" jsonschema:"description=Glob pattern of the keys to copy found with SCAN; alternative to keys"`
+	SourceConnection string   `json:"source_connection,omitempty" jsonschema:"description=Connection to copy from (default: the server's own)"`
+	TargetConnection string   `json:"target_connection,omitempty" jsonschema:"description=Connection to copy to (default: the server's own)"`
+	TargetDB         *int     `json:"target_db,omitempty" jsonschema:"minimum=0,description=Database to copy to on the same connection with COPY or MOVE"`
+	Mode             string   `json:"mode,omitempty" jsonschema:"description=copy (the default) keeps the source keys; move deletes each one once it is copied"`
+	OldPrefix        string   `json:"old_prefix,omitempty" jsonschema:"description=Prefix replaced by new_prefix in target key names; keys without it keep their name"`
+	NewPrefix        string   `json:"new_prefix,omitempty" jsonschema:"description=Prefix of the target key names"`
+	Replace          bool     `json:"replace,omitempty" jsonschema:"description=Overwrite existing target keys (default: false skips them)"`
+	MaxKeys          int64    `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after this many keys matching pattern (default: 10000)"`
+	KeysPerSecond    int64    `json:"keys_per_second,omitempty" jsonschema:"minimum=1,description=Maximum number of keys matching pattern copied per second (default: 1000)"`
+	BatchSize        int64    `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// KeyResult is the outcome for one key.
+type KeyResult struct {
+	Key    string `json:"key"`
+	Target string `json:"target"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Output represents the output of copy_keys tool.
+type Output struct {
+	Mode     string `json:"mode"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	TargetDB *int   `json:"target_db,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+	Matched  int64  `json:"matched"`
+	Copied   int64  `json:"copied"`
+	Skipped  int64  `json:"skipped"`
+	Failed   int64  `json:"failed"`
+	// Keys lists the outcome of the first keys processed.
+	Keys      []KeyResult `json:"keys"`
+	Truncated bool        `json:"truncated,omitempty"`
+	// LimitReached is set when more keys matched pattern than max_keys.
+	LimitReached bool `json:"limit_reached,omitempty"`
+	// Partial is set when the copy was cancelled.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// NewTool creates a new copy_keys tool copying between the connections of
+// manager.
+func NewTool(manager *connections.Manager) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"copy_keys",
+			"Copy or move keys given as a list or a SCAN pattern to another database with COPY or MOVE or to another named connection with DUMP and RESTORE. TTLs are preserved; old_prefix and new_prefix rename the copies; existing target keys are skipped unless replace is set. In a cluster keys are routed to their slots and copies to another slot fall back to DUMP and RESTORE. The report lists copied and skipped and failed keys with reasons",
+			Input{},
+		),
+		connections: manager,
+	}
+}
+
+// copier copies keys for one call.
+type copier struct {
+	params   *Input
+	source   client.ValkeyClient
+	target   client.ValkeyClient
+	sameConn bool
+	db       int
+	// written holds the targets created on the source database, so that a
+	// scan does not copy them again.
+	written map[string]bool
+	output  *Output
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	cp, err := t.prepare(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	output := cp.output
+
+	if cp.params.Pattern == "" {
+		output.Matched = int64(len(cp.params.Keys))
+		for i, key := range cp.params.Keys {
+			if ctx.Err() != nil {
+				output.Partial = true
+				break
+			}
+			if i > 0 && i%progressInterval == 0 {
+				progress.Report(ctx, float64(i), float64(len(cp.params.Keys)), fmt.Sprintf("copied %d of %d keys", i, len(cp.params.Keys)))
+			}
+			if err := cp.copy(ctx, key); err != nil {
+				output.Partial = true
+				break
+			}
+		}
+		return output, nil
+	}
+
+	var ignored int64
+	result, err := base.RunBulk(ctx, cp.source, cp.bulkOptions(), func(ctx context.Context, keys []string) (int64, []string, error) {
+		before := output.Copied
+		for _, key := range keys {
+			if cp.written[key] {
+				ignored++
+				continue
+			}
+			if err := cp.copy(ctx, key); err != nil {
+				return output.Copied - before, nil, err
+			}
+		}
+		return output.Copied - before, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	output.Matched = result.Matched - ignored
+	output.LimitReached = result.LimitReached
+	output.Partial = result.Partial
+	return output, nil
+}
+
+// copy copies one key and records its outcome. It returns an error only when
+// ctx was cancelled.
+func (cp *copier) copy(ctx context.Context, key string) error {
+	target := cp.targetName(key)
+	status, reason, err := cp.copyKey(ctx, key, target)
+	if err != nil {
+		if base.Interrupted(ctx, err) {
+			return err
+		}
+		status, reason = StatusFailed, err.Error()
+	}
+	cp.output.record(KeyResult{Key: key, Target: target, Status: status, Reason: reason})
+	if status != StatusSkipped && status != StatusFailed && cp.sameConn && cp.db < 0 {
+		cp.written[target] = true
+	}
+	return nil
+}
+
+// copyKey copies key to target and returns its status.
+func (cp *copier) copyKey(ctx context.Context, key, target string) (string, string, error) {
+	if !cp.sameConn {
+		return cp.dumpRestore(ctx, key, target)
+	}
+	if cp.db < 0 && key == target {
+		return StatusSkipped, "target is the source key", nil
+	}
+
+	if cp.params.Mode == ModeMove && key == target && !cp.params.Replace {
+		moved, err := cp.source.MoveKey(ctx, key, cp.db)
+		if err != nil {
+			return "", "", err
+		}
+		if !moved {
+			return cp.notCopied(ctx, key)
+		}
+		return StatusMoved, "", nil
+	}
+
+	if cp.db < 0 && !cp.source.SameSlot(key, target) {
+		// The names hash to different cluster slots, which COPY refuses.
+		return cp.dumpRestore(ctx, key, target)
+	}
+	copied, err := cp.source.CopyKey(ctx, key, target, cp.db, cp.params.Replace)
+	if err != nil {
+		return "", "", err
+	}
+	if !copied {
+		return cp.notCopied(ctx, key)
+	}
+	return cp.finish(ctx, key)
+}
+
+// dumpRestore copies key to target with DUMP, PTTL and RESTORE.
+func (cp *copier) dumpRestore(ctx context.Context, key, target string) (string, string, error) {
+	pttl, err := cp.source.GetPTTL(ctx, key)
+	if err != nil {
+		return "", "", err
+	}
+	if pttl == -2 {
+		return StatusSkipped, "source key does not exist", nil
+	}
+	payload, err := cp.source.DumpKey(ctx, key)
+	if err != nil {
+		return "", "", err
+	}
+	_, err = cp.target.RestoreKey(ctx, target, max(pttl, 0), payload, client.RestoreOptions{Replace: cp.params.Replace})
+	if err != nil && strings.Contains(err.Error(), "BUSYKEY") {
+		return StatusSkipped, "target key exists", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	return cp.finish(ctx, key)
+}
+
+// finish deletes the source key of a move.
+func (cp *copier) finish(ctx context.Context, key string) (string, string, error) {
+	if cp.params.Mode != ModeMove {
+		return StatusCopied, "", nil
+	}
+	if _, err := cp.source.DeleteKey(ctx, key); err != nil {
+		return StatusFailed, fmt.Sprintf("copied but the source key could not be deleted: %v", err), nil
+	}
+	return StatusMoved, "", nil
+}
+
+// notCopied explains why COPY or MOVE left key alone.
+func (cp *copier) notCopied(ctx context.Context, key string) (string, string, error) {
+	exists, err := cp.source.ExistsKeys(ctx, []string{key})
+	if err != nil {
+		return "", "", err
+	}
+	if !exists[key] {
+		return StatusSkipped, "source key does not exist", nil
+	}
+	return StatusSkipped, "target key exists", nil
+}
+
+// targetName applies the renaming rule to key.
+func (cp *copier) targetName(key string) string {
+	if !strings.HasPrefix(key, cp.params.OldPrefix) {
+		return key
+	}
+	return cp.params.NewPrefix + strings.TrimPrefix(key, cp.params.OldPrefix)
+}
+
+func (cp *copier) bulkOptions() base.BulkOptions {
+	return base.BulkOptions{
+		Pattern:       cp.params.Pattern,
+		MaxKeys:       cp.params.MaxKeys,
+		KeysPerSecond: cp.params.KeysPerSecond,
+		BatchSize:     cp.params.BatchSize,
+	}
+}
+
+// record counts result and lists it while there is room.
+func (o *Output) record(result KeyResult) {
+	switch result.Status {
+	case StatusCopied, StatusMoved:
+		o.Copied++
+	case StatusSkipped:
+		o.Skipped++
+	default:
+		o.Failed++
+	}
+	if len(o.Keys) < maxListedKeys {
+		o.Keys = append(o.Keys, result)
+	} else {
+		o.Truncated = true
+	}
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	cp, err := t.prepare(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	keys, limitReached, existing, err := cp.plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	states, err := base.DescribeKeys(ctx, cp.source, keys[:min(len(keys), previewedKeys)])
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &base.Preview{Summary: cp.summary(len(keys)), Keys: states, Truncated: len(keys) > len(states)}
+	if len(existing) > 0 {
+		if cp.params.Replace {
+			preview.Summary += fmt.Sprintf(", replacing %d existing keys", len(existing))
+			preview.Overwrites = existing[:min(len(existing), base.MaxPreviewElements)]
+		} else {
+			preview.Summary += fmt.Sprintf("; %d keys would be skipped because their target exists", len(existing))
+		}
+	}
+	if limitReached {
+		preview.Summary += fmt.Sprintf("; more keys match but max_keys stops at %d", len(keys))
+	}
+	return preview, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	cp, err := t.prepare(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	keys, _, existing, err := cp.plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := &registry.Impact{Summary: cp.summary(len(keys))}
+	for _, key := range keys {
+		impact.Keys = append(impact.Keys, cp.targetName(key))
+	}
+	if cp.params.Mode == ModeMove {
+		impact.Keys = append(impact.Keys, keys...)
+	}
+	if cp.params.Replace {
+		impact.Overwrites = existing
+		if len(existing) > 0 {
+			impact.Summary += fmt.Sprintf(", replacing %d existing keys", len(existing))
+		}
+	}
+	return impact, nil
+}
+
+// summary describes the call for n keys.
+func (cp *copier) summary(n int) string {
+	verb := "Copy"
+	if cp.params.Mode == ModeMove {
+		verb = "Move"
+	}
+	target := cp.output.Target
+	if cp.db >= 0 {
+		target = fmt.Sprintf("%s db %d", target, cp.db)
+	}
+	summary := fmt.Sprintf("%s %d keys from %s to %s", verb, n, cp.output.Source, target)
+	if cp.params.OldPrefix != "" || cp.params.NewPrefix != "" {
+		summary += fmt.Sprintf(" renaming prefix %q to %q", cp.params.OldPrefix, cp.params.NewPrefix)
+	}
+	return summary
+}
+
+// plan returns the keys the call would copy and the targets that already
+// exist. Targets in another database cannot be checked and are all listed
+// as existing when replace is set.
+func (cp *copier) plan(ctx context.Context) ([]string, bool, []string, error) {
+	keys := cp.params.Keys
+	var limitReached bool
+	if cp.params.Pattern != "" {
+		collected, result, err := base.CollectBulkKeys(ctx, cp.source, cp.bulkOptions())
+		if err != nil {
+			return nil, false, nil, err
+		}
+		keys, limitReached = collected, result.LimitReached
+	}
+
+	targets := make([]string, len(keys))
+	for i, key := range keys {
+		targets[i] = cp.targetName(key)
+	}
+	if cp.db >= 0 {
+		if cp.params.Replace {
+			return keys, limitReached, targets, nil
+		}
+		return keys, limitReached, nil, nil
+	}
+	exists, err := cp.target.ExistsKeys(ctx, targets)
+	if err != nil {
+		return nil, false, nil, fmt.Errorf("failed to check target keys: %w", err)
+	}
+	var existing []string
+	for i, target := range targets {
+		if exists[target] && !(cp.sameConn && target == keys[i]) {
+			existing = append(existing, target)
+		}
+	}
+	return keys, limitReached, existing, nil
+}
+
+// prepare validates input and resolves its connections.
+func (t *Tool) prepare(ctx context.Context, input json.RawMessage) (*copier, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(params.Keys) == 0 && params.Pattern == "":
+		return nil, fmt.Errorf("keys or pattern is required")
+	case len(params.Keys) > 0 && params.Pattern != "":
+		return nil, fmt.Errorf("pass either keys or pattern, not both")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return nil, fmt.Errorf("key cannot be empty")
+		}
+	}
+	switch params.Mode {
+	case "":
+		params.Mode = ModeCopy
+	case ModeCopy, ModeMove:
+	default:
+		return nil, fmt.Errorf("mode must be %s or %s", ModeCopy, ModeMove)
+	}
+
+	sourceName, targetName := t.name(params.SourceConnection), t.name(params.TargetConnection)
+	sameConn := sourceName == targetName
+	renames := params.OldPrefix != params.NewPrefix
+	switch {
+	case !sameConn && params.TargetDB != nil:
+		return nil, fmt.Errorf("target_db applies within one connection only; select the database in the target connection's URL")
+	case sameConn && params.TargetDB == nil && !renames:
+		return nil, fmt.Errorf("source and target are the same; set target_connection or target_db or rename with new_prefix")
+	case params.TargetDB != nil && *params.TargetDB < 0:
+		return nil, fmt.Errorf("target_db cannot be negative")
+	}
+
+	source, err := t.connections.Get(ctx, params.SourceConnection)
+	if err != nil {
+		return nil, err
+	}
+	target, err := t.connections.Get(ctx, params.TargetConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	db := -1
+	if params.TargetDB != nil {
+		db = *params.TargetDB
+	}
+	return &copier{
+		params:   &params,
+		source:   source,
+		target:   target,
+		sameConn: sameConn,
+		db:       db,
+		written:  make(map[string]bool),
+		output: &Output{
+			Mode:     params.Mode,
+			Source:   sourceName,
+			Target:   targetName,
+			TargetDB: params.TargetDB,
+			Pattern:  params.Pattern,
+			Keys:     []KeyResult{},
+		},
+	}, nil
+}
+
+// name returns the connection name, resolving the default.
+func (t *Tool) name(connection string) string {
+	if connection == "" {
+		return t.connections.Default()
+	}
+	return connection
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, manager *connections.Manager) {
+	reg.MustRegister(NewTool(manager))
+}
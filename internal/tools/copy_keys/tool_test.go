@@ -0,0 +1,234 @@
+package copy_keys
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/connections"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setup returns a tool whose default connection is source and whose
+// "backup" connection is target.
+func setup(t *testing.T, source, target client.ValkeyClient) *Tool {
+	t.Helper()
+	manager := connections.New("default", source, func(ctx context.Context, url string) (client.ValkeyClient, error) {
+		return target, nil
+	})
+	require.NoError(t, manager.Add("backup", "valkey://backup:6379"))
+	return NewTool(manager).(*Tool)
+}
+
+func seed(t *testing.T, c *client.MockClient, values map[string]string) {
+	t.Helper()
+	for key, value := range values {
+		_, err := c.SetString(context.Background(), key, value, nil, false, false)
+		require.NoError(t, err)
+	}
+}
+
+func TestTool_Execute_AcrossConnections(t *testing.T) {
+	ctx := context.Background()
+	source, target := client.NewMockClient(), client.NewMockClient()
+	seed(t, source, map[string]string{"user:1": "ann", "user:2": "bob", "other": "x"})
+	_, err := source.ExpireKey(ctx, "user:1", 60)
+	require.NoError(t, err)
+	seed(t, target, map[string]string{"user:2": "old"})
+	tool := setup(t, source, target)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "user:*", "target_connection": "backup"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, "default", output.Source)
+	assert.Equal(t, "backup", output.Target)
+	assert.Equal(t, int64(2), output.Matched)
+	assert.Equal(t, int64(1), output.Copied)
+	assert.Equal(t, int64(1), output.Skipped)
+	assert.ElementsMatch(t, []KeyResult{
+		{Key: "user:1", Target: "user:1", Status: StatusCopied},
+		{Key: "user:2", Target: "user:2", Status: StatusSkipped, Reason: "target key exists"},
+	}, output.Keys)
+
+	value, _, _ := target.GetString(ctx, "user:1")
+	assert.Equal(t, "ann", string(value))
+	ttl, _ := target.GetTTL(ctx, "user:1")
+	assert.Equal(t, int64(60), ttl)
+	value, _, _ = target.GetString(ctx, "user:2")
+	assert.Equal(t, "old", string(value))
+}
+
+func TestTool_Execute_MoveWithRename(t *testing.T) {
+	ctx := context.Background()
+	source, target := client.NewMockClient(), client.NewMockClient()
+	seed(t, source, map[string]string{"old:a": "1", "keep": "2"})
+	seed(t, target, map[string]string{"keep": "taken"})
+	tool := setup(t, source, target)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{
+		"keys": []string{"old:a", "keep", "missing"}, "target_connection": "backup",
+		"mode": "move", "old_prefix": "old:", "new_prefix": "new:", "replace": true,
+	})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, []KeyResult{
+		{Key: "old:a", Target: "new:a", Status: StatusMoved},
+		{Key: "keep", Target: "keep", Status: StatusMoved},
+		{Key: "missing", Target: "missing", Status: StatusSkipped, Reason: "source key does not exist"},
+	}, output.Keys)
+	assert.Equal(t, int64(2), output.Copied)
+
+	value, _, _ := target.GetString(ctx, "new:a")
+	assert.Equal(t, "1", string(value))
+	value, _, _ = target.GetString(ctx, "keep")
+	assert.Equal(t, "2", string(value))
+	exists, _ := source.ExistsKeys(ctx, []string{"old:a", "keep"})
+	assert.False(t, exists["old:a"])
+	assert.False(t, exists["keep"])
+}
+
+func TestTool_Execute_RenameOnSameDatabase(t *testing.T) {
+	ctx := context.Background()
+	source := client.NewMockClient()
+	seed(t, source, map[string]string{"a:1": "x", "a:2": "y"})
+	tool := setup(t, source, nil)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "a:*", "old_prefix": "a:", "new_prefix": "a:copy:", "batch_size": 1})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, int64(2), output.Copied)
+	value, _, _ := source.GetString(ctx, "a:copy:1")
+	assert.Equal(t, "x", string(value))
+	exists, _ := source.ExistsKeys(ctx, []string{"a:copy:copy:1", "a:1"})
+	assert.False(t, exists["a:copy:copy:1"])
+	assert.True(t, exists["a:1"])
+}
+
+func TestTool_Execute_TargetDB(t *testing.T) {
+	var copied, moved []string
+	source := &client.MockValkeyClient{
+		CopyKeyFunc: func(ctx context.Context, src, dst string, db int, replace bool) (bool, error) {
+			assert.Equal(t, 3, db)
+			copied = append(copied, src)
+			return replace, nil
+		},
+		MoveKeyFunc: func(ctx context.Context, key string, db int) (bool, error) {
+			assert.Equal(t, 3, db)
+			moved = append(moved, key)
+			return key != "busy", nil
+		},
+		ExistsKeysFunc: func(ctx context.Context, keys []string) (map[string]bool, error) {
+			return map[string]bool{"busy": true}, nil
+		},
+	}
+	tool := setup(t, source, nil)
+	ctx := context.Background()
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"keys": []string{"a", "busy", "gone"}, "target_db": 3, "mode": "move"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output := result.(*Output)
+	assert.Equal(t, []string{"a", "busy", "gone"}, moved)
+	assert.Equal(t, []KeyResult{
+		{Key: "a", Target: "a", Status: StatusMoved},
+		{Key: "busy", Target: "busy", Status: StatusSkipped, Reason: "target key exists"},
+		{Key: "gone", Target: "gone", Status: StatusMoved},
+	}, output.Keys)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"keys": []string{"a"}, "target_db": 3, "replace": true})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, copied)
+	assert.Equal(t, StatusCopied, result.(*Output).Keys[0].Status)
+}
+
+func TestTool_Execute_CrossSlotFallback(t *testing.T) {
+	var restored string
+	c := &client.MockValkeyClient{
+		SameSlotFunc: func(a, b string) bool {
+			return false
+		},
+		CopyKeyFunc: func(ctx context.Context, src, dst string, db int, replace bool) (bool, error) {
+			t.Fatalf("COPY sent for keys in different slots")
+			return false, nil
+		},
+		GetPTTLFunc: func(ctx context.Context, key string) (int64, error) {
+			return 5000, nil
+		},
+		DumpKeyFunc: func(ctx context.Context, key string) ([]byte, error) {
+			return []byte("payload"), nil
+		},
+		RestoreKeyFunc: func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
+			restored = key
+			assert.Equal(t, int64(5000), ttl)
+			return true, nil
+		},
+	}
+	tool := setup(t, c, nil)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"keys": []string{"{a}1"}, "old_prefix": "{a}", "new_prefix": "{b}"})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, "{b}1", restored)
+	assert.Equal(t, int64(1), result.(*Output).Copied)
+
+	c.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
+		return false, errors.New("RESTORE failed: ERR DUMP payload version or checksum are wrong")
+	}
+	result, err = tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+	output := result.(*Output)
+	assert.Equal(t, int64(1), output.Failed)
+	assert.Equal(t, "RESTORE failed: ERR DUMP payload version or checksum are wrong", output.Keys[0].Reason)
+}
+
+func TestTool_Execute_Invalid(t *testing.T) {
+	tool := setup(t, client.NewMockClient(), client.NewMockClient())
+	ctx := context.Background()
+
+	for input, message := range map[string]string{
+		`{}`:                           "keys or pattern is required",
+		`{"keys":["a"],"pattern":"*"}`: "pass either keys or pattern, not both",
+		`{"keys":["a"],"mode":"swap","target_db":1}`: "mode must be copy or move",
+		`{"keys":["a"]}`: "source and target are the same; set target_connection or target_db or rename with new_prefix",
+		`{"keys":["a"],"target_connection":"backup","target_db":1}`: "target_db applies within one connection only; select the database in the target connection's URL",
+		`{"keys":["a"],"target_connection":"nope"}`:                 `unknown connection "nope" (known: default, backup)`,
+	} {
+		_, err := tool.Execute(ctx, json.RawMessage(input))
+		assert.EqualError(t, err, message, input)
+	}
+}
+
+func TestTool_PreviewAndAssess(t *testing.T) {
+	ctx := context.Background()
+	source, target := client.NewMockClient(), client.NewMockClient()
+	seed(t, source, map[string]string{"k:1": "a", "k:2": "b"})
+	seed(t, target, map[string]string{"k:2": "old"})
+	tool := setup(t, source, target)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "k:*", "target_connection": "backup", "replace": true})
+	result, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, "Copy 2 keys from default to backup, replacing 1 existing keys", preview.Summary)
+	assert.Equal(t, []string{"k:2"}, preview.Overwrites)
+	assert.Len(t, preview.Keys, 2)
+
+	impact, err := tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"k:1", "k:2"}, impact.Keys)
+	assert.Equal(t, []string{"k:2"}, impact.Overwrites)
+
+	exists, _ := target.ExistsKeys(ctx, []string{"k:1"})
+	assert.False(t, exists["k:1"])
+}
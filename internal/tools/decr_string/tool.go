@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -68,6 +69,38 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Amount == 0 {
+		params.Amount = 1
+	}
+
+	raw, found, err := t.client.GetString(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %q: %w", params.Key, err)
+	}
+	change := base.Change{Target: params.Key}
+	var current int64
+	if found {
+		if current, err = strconv.ParseInt(string(raw), 10, 64); err != nil {
+			return nil, fmt.Errorf("value of key %q is not an integer", params.Key)
+		}
+		change.Current = current
+	}
+	change.New = current - params.Amount
+	return &base.Preview{
+		Summary: fmt.Sprintf("Decrement key %q by %d, from %d to %d", params.Key, params.Amount, current, change.New),
+		Changes: []base.Change{change},
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
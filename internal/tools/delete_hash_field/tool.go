@@ -67,6 +67,42 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.client.GetMapFields(ctx, params.Key, params.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields of hash %q: %w", params.Key, err)
+	}
+
+	var changes []base.Change
+	for _, field := range params.Fields {
+		if value, ok := current[field]; ok {
+			changes = append(changes, base.Change{Target: field, Current: base.SafeValue(value)})
+		}
+	}
+	summary := fmt.Sprintf("Delete %d of the %d requested fields from hash %q", len(current), len(params.Fields), params.Key)
+	if len(current) > 0 && int64(len(current)) == state.Size {
+		summary += ", which removes the key"
+	}
+	return &base.Preview{
+		Summary: summary,
+		Keys:    []*base.KeyState{state},
+		Changes: changes,
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -70,6 +71,51 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// maxListedKeys is the number of keys named in an impact summary.
+const maxListedKeys = 10
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	listed := params.Keys
+	more := ""
+	if len(listed) > maxListedKeys {
+		more = fmt.Sprintf(" and %d more", len(listed)-maxListedKeys)
+		listed = listed[:maxListedKeys]
+	}
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Delete %d keys: %s%s", len(params.Keys), strings.Join(listed, ", "), more),
+		Keys:    params.Keys,
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	states, err := base.DescribeKeys(ctx, t.client, params.Keys)
+	if err != nil {
+		return nil, err
+	}
+	existing := 0
+	for _, state := range states {
+		if state.Exists() {
+			existing++
+		}
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Delete %d keys; %d of the %d requested keys do not exist", existing, len(states)-existing, len(states)),
+		Keys:    states,
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -51,3 +52,38 @@ func TestTool_Metadata(t *testing.T) {
 	assert.Equal(t, "delete_keys", tool.Name())
 	assert.NotEmpty(t, tool.Description())
 }
+
+func TestTool_Assess(t *testing.T) {
+	tool := NewTool(client.NewMockClient()).(*Tool)
+
+	keys := make([]string, 12)
+	for i := range keys {
+		keys[i] = string(rune('a' + i))
+	}
+	inputJSON, _ := json.Marshal(map[string]interface{}{"keys": keys})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, keys, impact.Keys)
+	assert.Equal(t, "Delete 12 keys: a, b, c, d, e, f, g, h, i, j and 2 more", impact.Summary)
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("user:1", []byte("alice"))
+	mockClient.SetRawListBytes("queue", [][]byte{[]byte("a"), []byte("b")})
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"keys": []string{"user:1", "queue", "missing"}})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, "Delete 2 keys; 1 of the 3 requested keys do not exist", preview.Summary)
+	require.Len(t, preview.Keys, 3)
+	assert.Equal(t, "string", preview.Keys[0].Type)
+	assert.Equal(t, int64(5), preview.Keys[0].Size)
+	assert.Equal(t, "list", preview.Keys[1].Type)
+	assert.Equal(t, int64(2), preview.Keys[1].Size)
+
+	exists, _ := mockClient.ExistsKey(context.Background(), "user:1")
+	assert.True(t, exists)
+}
@@ -0,0 +1,121 @@
+// Package delete_keys_by_pattern implements the delete_keys_by_pattern tool.
+package delete_keys_by_pattern
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// previewedKeys is the number of keys described in a dry run.
+const previewedKeys = 20
+
+// Tool implements the delete_keys_by_pattern functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for delete_keys_by_pattern tool.
+type Input struct {
+	Pattern       string `json:"pattern" jsonschema:"required,description=Glob pattern of the keys to delete"`
+	MaxKeys       int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after this many keys (default: 10000)"`
+	KeysPerSecond int64  `json:"keys_per_second,omitempty" jsonschema:"minimum=1,description=Maximum number of keys deleted per second (default: 1000)"`
+	BatchSize     int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// NewTool creates a new delete_keys_by_pattern tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"delete_keys_by_pattern",
+			"Delete every key matching a glob pattern. Keys are found with SCAN on each node and removed with UNLINK in rate-limited batches; stops after max_keys. Returns counts, with partial set when cancelled",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return base.RunBulk(ctx, t.client, opts, func(ctx context.Context, keys []string) (int64, []string, error) {
+		removed, err := t.client.UnlinkKeys(ctx, keys)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to delete keys matching %q: %w", opts.Pattern, err)
+		}
+		return removed, nil, nil
+	})
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, result, err := base.CollectBulkKeys(ctx, t.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	states, err := base.DescribeKeys(ctx, t.client, keys[:min(len(keys), previewedKeys)])
+	if err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("Delete %d keys matching %q on %d nodes", len(keys), opts.Pattern, result.Nodes)
+	if result.LimitReached {
+		summary += fmt.Sprintf("; more keys match but max_keys stops at %d", len(keys))
+	}
+	return &base.Preview{
+		Summary:   summary,
+		Keys:      states,
+		Truncated: len(keys) > len(states),
+	}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, _, err := base.CollectBulkKeys(ctx, t.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Delete %d keys matching %q", len(keys), opts.Pattern),
+		Keys:    keys,
+	}, nil
+}
+
+// parse validates input and converts it to bulk options.
+func (t *Tool) parse(input json.RawMessage) (base.BulkOptions, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return base.BulkOptions{}, err
+	}
+	if params.Pattern == "" {
+		return base.BulkOptions{}, fmt.Errorf("pattern cannot be empty")
+	}
+	return base.BulkOptions{
+		Pattern:       params.Pattern,
+		MaxKeys:       params.MaxKeys,
+		KeysPerSecond: params.KeysPerSecond,
+		BatchSize:     params.BatchSize,
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
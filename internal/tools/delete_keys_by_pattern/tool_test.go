@@ -0,0 +1,98 @@
+package delete_keys_by_pattern
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	t.Helper()
+	mockClient := client.NewMockClient()
+	ctx := context.Background()
+	for _, key := range []string{"session:1", "session:2", "session:3", "user:1"} {
+		_, err := mockClient.SetString(ctx, key, "value", nil, false, false)
+		require.NoError(t, err)
+	}
+	return mockClient
+}
+
+func TestTool_Execute_Success(t *testing.T) {
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "session:*", "batch_size": 10})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	bulk := result.(*base.BulkResult)
+	assert.Equal(t, int64(3), bulk.Matched)
+	assert.Equal(t, int64(3), bulk.Changed)
+	assert.False(t, bulk.LimitReached)
+
+	keys, _, _ := mockClient.ScanKeys(ctx, 0, "*", 10)
+	assert.Equal(t, []string{"user:1"}, keys)
+}
+
+func TestTool_Execute_MaxKeys(t *testing.T) {
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "session:*", "max_keys": 2, "batch_size": 10})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	bulk := result.(*base.BulkResult)
+	assert.Equal(t, int64(2), bulk.Changed)
+	assert.True(t, bulk.LimitReached)
+}
+
+func TestTool_Execute_EmptyPattern(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"pattern":""}`))
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := setup(t)
+	tool := NewTool(mockClient).(*Tool)
+	ctx := context.Background()
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "session:*"})
+	result, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Delete 3 keys matching "session:*" on 1 nodes`, preview.Summary)
+	assert.Len(t, preview.Keys, 3)
+	assert.Equal(t, "string", preview.Keys[0].Type)
+
+	// Nothing was deleted.
+	keys, _, _ := mockClient.ScanKeys(ctx, 0, "session:*", 10)
+	assert.Len(t, keys, 3)
+}
+
+func TestTool_Assess(t *testing.T) {
+	tool := NewTool(setup(t)).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "session:*"})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session:1", "session:2", "session:3"}, impact.Keys)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "delete_keys_by_pattern", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -63,6 +63,24 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer. A script's effects cannot be
+// known without running it, so the preview only describes the declared keys.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	states, err := base.DescribeKeys(ctx, t.client, params.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Run a script with %d declared keys and %d arguments; its changes cannot be previewed", len(params.Keys), len(params.Args)),
+		Keys:    states,
+	}, nil
+}
+
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
 }
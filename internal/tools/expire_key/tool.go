@@ -74,6 +74,30 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	preview := &base.Preview{Keys: []*base.KeyState{state}}
+	if !state.Exists() {
+		preview.Summary = fmt.Sprintf("Key %q does not exist; nothing would change", params.Key)
+		return preview, nil
+	}
+	preview.Summary = fmt.Sprintf("Expire key %q in %d seconds", params.Key, params.Seconds)
+	preview.Changes = []base.Change{{Target: "ttl", Current: state.TTL, New: params.Seconds}}
+	return preview, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -0,0 +1,125 @@
+// Package expire_keys_by_pattern implements the expire_keys_by_pattern tool.
+package expire_keys_by_pattern
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// previewedKeys is the number of keys described in a dry run.
+const previewedKeys = 20
+
+// Tool implements the expire_keys_by_pattern functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for expire_keys_by_pattern tool.
+type Input struct {
+	Pattern       string `json:"pattern" jsonschema:"required,description=Glob pattern of the keys to expire"`
+	Seconds       int64  `json:"seconds" jsonschema:"required,minimum=1,description=TTL in seconds to set on each key"`
+	MaxKeys       int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after this many keys (default: 10000)"`
+	KeysPerSecond int64  `json:"keys_per_second,omitempty" jsonschema:"minimum=1,description=Maximum number of keys updated per second (default: 1000)"`
+	BatchSize     int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// NewTool creates a new expire_keys_by_pattern tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"expire_keys_by_pattern",
+			"Set a TTL on every key matching a glob pattern. Keys are found with SCAN on each node and updated with EXPIRE in rate-limited batches; stops after max_keys. Returns counts, with partial set when cancelled",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return base.RunBulk(ctx, t.client, opts, func(ctx context.Context, keys []string) (int64, []string, error) {
+		updated, err := t.client.ExpireKeys(ctx, keys, params.Seconds)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to expire keys matching %q: %w", opts.Pattern, err)
+		}
+		return updated, nil, nil
+	})
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, result, err := base.CollectBulkKeys(ctx, t.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	states, err := base.DescribeKeys(ctx, t.client, keys[:min(len(keys), previewedKeys)])
+	if err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("Expire %d keys matching %q on %d nodes in %d seconds", len(keys), opts.Pattern, result.Nodes, params.Seconds)
+	if result.LimitReached {
+		summary += fmt.Sprintf("; more keys match but max_keys stops at %d", len(keys))
+	}
+	return &base.Preview{
+		Summary:   summary,
+		Keys:      states,
+		Truncated: len(keys) > len(states),
+	}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, _, err := base.CollectBulkKeys(ctx, t.client, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Expire %d keys matching %q in %d seconds", len(keys), opts.Pattern, params.Seconds),
+		Keys:    keys,
+	}, nil
+}
+
+// parse validates input and converts it to bulk options.
+func (t *Tool) parse(input json.RawMessage) (Input, base.BulkOptions, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, base.BulkOptions{}, err
+	}
+	if params.Pattern == "" {
+		return params, base.BulkOptions{}, fmt.Errorf("pattern cannot be empty")
+	}
+	if params.Seconds <= 0 {
+		return params, base.BulkOptions{}, fmt.Errorf("seconds must be positive")
+	}
+	return params, base.BulkOptions{
+		Pattern:       params.Pattern,
+		MaxKeys:       params.MaxKeys,
+		KeysPerSecond: params.KeysPerSecond,
+		BatchSize:     params.BatchSize,
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,65 @@
+package expire_keys_by_pattern
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Success(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+	mockClient.SetString(ctx, "cache:a", "1", nil, false, false)
+	mockClient.SetString(ctx, "cache:b", "2", nil, false, false)
+	mockClient.SetString(ctx, "config", "3", nil, false, false)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "cache:*", "seconds": 60})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	bulk := result.(*base.BulkResult)
+	assert.Equal(t, int64(2), bulk.Matched)
+	assert.Equal(t, int64(2), bulk.Changed)
+
+	ttl, _ := mockClient.GetTTL(ctx, "cache:a")
+	assert.Equal(t, int64(60), ttl)
+	ttl, _ = mockClient.GetTTL(ctx, "config")
+	assert.Equal(t, int64(-1), ttl)
+}
+
+func TestTool_Execute_InvalidSeconds(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"pattern":"cache:*","seconds":0}`))
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+	ctx := context.Background()
+	mockClient.SetString(ctx, "cache:a", "1", nil, false, false)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "cache:*", "seconds": 60})
+	result, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Expire 1 keys matching "cache:*" on 1 nodes in 60 seconds`, preview.Summary)
+	require.Len(t, preview.Keys, 1)
+	assert.Equal(t, int64(-1), preview.Keys[0].TTL)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "expire_keys_by_pattern", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -0,0 +1,156 @@
+// Package export_keys implements the export_keys tool.
+package export_keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/export"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// MaxInlineBytes caps an export returned as an embedded resource; larger
+// exports must be written to a file.
+const MaxInlineBytes = 4 << 20
+
+// Tool implements the export_keys functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+	dir    string
+}
+
+// Input represents the input for export_keys tool.
+type Input struct {
+	Pattern     string `json:"pattern" jsonschema:"required,description=Glob pattern of the keys to export"`
+	Path        string `json:"path,omitempty" jsonschema:"description=File to write relative to the export directory; omit to return the records as an embedded resource"`
+	IncludeDump bool   `json:"include_dump,omitempty" jsonschema:"description=Also store the base64 DUMP payload of each key for an exact restore on a server of a compatible version"`
+	MaxKeys     int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after this many keys (default: 10000)"`
+	BatchSize   int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// Output represents the output of export_keys tool. Without a path the
+// records are attached as an embedded resource.
+type Output struct {
+	Pattern      string           `json:"pattern"`
+	Path         string           `json:"path,omitempty"`
+	URI          string           `json:"uri,omitempty"`
+	Keys         int64            `json:"keys"`
+	Bytes        int64            `json:"bytes"`
+	Types        map[string]int64 `json:"types"`
+	LimitReached bool             `json:"limit_reached,omitempty"`
+	Partial      bool             `json:"partial,omitempty"`
+
+	data []byte
+}
+
+// Resources implements registry.ResourceResult.
+func (o *Output) Resources() []*mcp.ResourceContents {
+	if o.URI == "" {
+		return nil
+	}
+	return []*mcp.ResourceContents{{URI: o.URI, MIMEType: "application/jsonl", Text: string(o.data)}}
+}
+
+// NewTool creates a new export_keys tool writing files inside dir. An
+// empty dir allows inline exports only.
+func NewTool(client client.ValkeyClient, dir string) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"export_keys",
+			"Export the keys matching a glob pattern as JSON Lines with one record per key holding its type, TTL in milliseconds and value (optionally its DUMP payload). Writes to path in the export directory or returns the records as an embedded resource. Keys are found with SCAN on each node; stops after max_keys. import_keys replays the records",
+			Input{},
+		),
+		client: client,
+		dir:    dir,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Pattern == "" {
+		return nil, fmt.Errorf("pattern cannot be empty")
+	}
+
+	output := &Output{Pattern: params.Pattern, Path: params.Path, Types: map[string]int64{}}
+	var buf bytes.Buffer
+	counter := &countingWriter{w: &buf}
+	var file *os.File
+	if params.Path != "" {
+		var err error
+		if file, err = export.Create(t.dir, params.Path); err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		counter.w = file
+	}
+
+	opts := base.BulkOptions{Pattern: params.Pattern, MaxKeys: params.MaxKeys, BatchSize: params.BatchSize}
+	result, err := base.ScanEach(ctx, t.client, opts, func(ctx context.Context, keys []string) error {
+		for _, key := range keys {
+			rec, err := export.Read(ctx, t.client, key, params.IncludeDump)
+			if err != nil {
+				return err
+			}
+			// Keys deleted since the scan found them are left out.
+			if rec == nil {
+				continue
+			}
+			if err := export.Encode(counter, rec); err != nil {
+				return fmt.Errorf("failed to write key %q: %w", key, err)
+			}
+			output.Keys++
+			output.Types[rec.Type]++
+			if params.Path == "" && counter.n > MaxInlineBytes {
+				return fmt.Errorf("export exceeds %d bytes; pass a path to write it to a file", MaxInlineBytes)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		if err := file.Close(); err != nil {
+			return nil, fmt.Errorf("failed to write %q: %w", params.Path, err)
+		}
+	}
+
+	output.Bytes = counter.n
+	output.LimitReached, output.Partial = result.LimitReached, result.Partial
+	if params.Path == "" {
+		output.URI = "valkey-export:" + url.PathEscape(params.Pattern) + ".jsonl"
+		output.data = buf.Bytes()
+	}
+	return output, nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, dir string) {
+	reg.MustRegister(NewTool(client, dir))
+}
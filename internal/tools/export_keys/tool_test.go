@@ -0,0 +1,79 @@
+package export_keys
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/export"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seed(t *testing.T) *client.MockClient {
+	t.Helper()
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetString(ctx, "user:1", "alice", nil, false, false)
+	mockClient.SetMap(ctx, "user:2", map[string]string{"name": "bob"})
+	mockClient.SetString(ctx, "other", "x", nil, false, false)
+	return mockClient
+}
+
+func TestTool_Execute_Inline(t *testing.T) {
+	tool := NewTool(seed(t), "")
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"pattern": "user:*", "batch_size": 10}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, int64(2), output.Keys)
+	assert.Equal(t, map[string]int64{"string": 1, "hash": 1}, output.Types)
+	assert.Equal(t, "valkey-export:user:%2A.jsonl", output.URI)
+
+	resources := output.Resources()
+	require.Len(t, resources, 1)
+	assert.Equal(t, "application/jsonl", resources[0].MIMEType)
+	assert.Equal(t, output.Bytes, int64(len(resources[0].Text)))
+
+	records, err := export.Decode(strings.NewReader(resources[0].Text))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "user:1", records[0].Key)
+	assert.JSONEq(t, `{"name":"bob"}`, string(records[1].Value))
+}
+
+func TestTool_Execute_File(t *testing.T) {
+	dir := t.TempDir()
+	tool := NewTool(seed(t), dir)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"pattern": "*", "path": "all.jsonl", "batch_size": 10}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, int64(3), output.Keys)
+	assert.Empty(t, output.URI)
+	assert.Nil(t, output.Resources())
+
+	data, err := os.ReadFile(filepath.Join(dir, "all.jsonl"))
+	require.NoError(t, err)
+	assert.Equal(t, output.Bytes, int64(len(data)))
+	assert.Equal(t, 3, strings.Count(string(data), "\n"))
+}
+
+func TestTool_Execute_FileRefused(t *testing.T) {
+	tool := NewTool(seed(t), "")
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"pattern": "*", "path": "all.jsonl"}`))
+	assert.ErrorContains(t, err, "no export directory is configured")
+
+	tool = NewTool(seed(t), t.TempDir())
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"pattern": "*", "path": "../all.jsonl"}`))
+	assert.Error(t, err)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{}`))
+	assert.EqualError(t, err, "pattern cannot be empty")
+}
@@ -0,0 +1,163 @@
+// Package find_big_keys implements the find_big_keys tool.
+package find_big_keys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+const (
+	// defaultTop is the number of keys kept per type when top is omitted.
+	defaultTop = 10
+	// defaultBudget is the scan time budget when time_budget_seconds is omitted.
+	defaultBudget = 30 * time.Second
+)
+
+// Tool implements the find_big_keys functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for find_big_keys tool.
+type Input struct {
+	Pattern           string `json:"pattern,omitempty" jsonschema:"description=Glob pattern of the keys to examine (default: *)"`
+	Top               int    `json:"top,omitempty" jsonschema:"minimum=1,description=Number of biggest keys kept per type (default: 10)"`
+	SortBy            string `json:"sort_by,omitempty" jsonschema:"description=Rank keys by memory or by length (default: memory)"`
+	TimeBudgetSeconds int64  `json:"time_budget_seconds,omitempty" jsonschema:"minimum=1,description=Stop scanning after this many seconds and return what was found (default: 30)"`
+	MaxKeys           int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after examining this many keys (default: no limit)"`
+	BatchSize         int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// Output represents the output of find_big_keys tool.
+type Output struct {
+	Pattern     string                  `json:"pattern"`
+	SortBy      string                  `json:"sort_by"`
+	Nodes       int                     `json:"nodes"`
+	ScannedKeys int64                   `json:"scanned_keys"`
+	Types       map[string]*TypeSummary `json:"types"`
+	// Partial is set when the time budget ran out or the call was cancelled
+	// before the scan completed.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// TypeSummary aggregates the scanned keys of one type.
+type TypeSummary struct {
+	Keys        int64    `json:"keys"`
+	TotalLength int64    `json:"total_length"`
+	MemoryBytes int64    `json:"memory_bytes"`
+	Biggest     []BigKey `json:"biggest"`
+
+	top *base.TopN[BigKey]
+}
+
+// BigKey is one of the biggest keys of a type. Length is in bytes for
+// strings and in elements for collections.
+type BigKey struct {
+	Key         string `json:"key"`
+	Length      int64  `json:"length"`
+	MemoryBytes int64  `json:"memory_bytes"`
+	Encoding    string `json:"encoding,omitempty"`
+}
+
+// NewTool creates a new find_big_keys tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"find_big_keys",
+			"Find the biggest keys of each type, like valkey-cli --bigkeys and --memkeys. Scans every node and measures keys with STRLEN, HLEN, LLEN, SCARD, ZCARD or XLEN plus MEMORY USAGE. Stops when the time budget runs out, returning what was found with partial set",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Pattern == "" {
+		params.Pattern = "*"
+	}
+	if params.Top <= 0 {
+		params.Top = defaultTop
+	}
+	var less func(a, b BigKey) bool
+	switch params.SortBy {
+	case "", "memory":
+		params.SortBy = "memory"
+		less = func(a, b BigKey) bool { return a.MemoryBytes < b.MemoryBytes }
+	case "length":
+		less = func(a, b BigKey) bool { return a.Length < b.Length }
+	default:
+		return nil, fmt.Errorf("sort_by must be memory or length, got %q", params.SortBy)
+	}
+	budget := defaultBudget
+	if params.TimeBudgetSeconds > 0 {
+		budget = time.Duration(params.TimeBudgetSeconds) * time.Second
+	}
+	if params.MaxKeys <= 0 {
+		params.MaxKeys = math.MaxInt64
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	output := &Output{Pattern: params.Pattern, SortBy: params.SortBy, Types: map[string]*TypeSummary{}}
+	opts := base.BulkOptions{Pattern: params.Pattern, MaxKeys: params.MaxKeys, BatchSize: params.BatchSize}
+	result, err := base.ScanEach(scanCtx, t.client, opts, func(ctx context.Context, keys []string) error {
+		infos, err := t.client.InspectKeys(ctx, keys)
+		if err != nil {
+			return fmt.Errorf("failed to inspect keys: %w", err)
+		}
+		types := make([]string, len(infos))
+		for i, info := range infos {
+			types[i] = info.Type
+		}
+		lengths, err := t.client.KeyLengths(ctx, keys, types)
+		if err != nil {
+			return fmt.Errorf("failed to measure keys: %w", err)
+		}
+
+		for i, info := range infos {
+			if info.Type == "none" {
+				continue
+			}
+			summary, ok := output.Types[info.Type]
+			if !ok {
+				summary = &TypeSummary{top: base.NewTopN(params.Top, less)}
+				output.Types[info.Type] = summary
+			}
+			summary.Keys++
+			summary.TotalLength += lengths[i]
+			summary.MemoryBytes += info.MemoryBytes
+			summary.top.Add(BigKey{Key: info.Key, Length: lengths[i], MemoryBytes: info.MemoryBytes, Encoding: info.Encoding})
+			output.ScannedKeys++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	output.Nodes = result.Nodes
+	output.Partial = result.Partial
+
+	for _, summary := range output.Types {
+		summary.Biggest = summary.top.Items()
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
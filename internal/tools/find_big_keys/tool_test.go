@@ -0,0 +1,96 @@
+package find_big_keys
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	t.Helper()
+	mockClient := client.NewMockClient()
+	ctx := context.Background()
+	mockClient.SetString(ctx, "small", "a", nil, false, false)
+	mockClient.SetString(ctx, "large", "abcdefgh", nil, false, false)
+	mockClient.SetString(ctx, "medium", "abcd", nil, false, false)
+	mockClient.SetRawListBytes("queue", [][]byte{[]byte("a"), []byte("b"), []byte("c")})
+	return mockClient
+}
+
+func TestTool_Execute_ByLength(t *testing.T) {
+	tool := NewTool(setup(t))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"sort_by": "length", "top": 2}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, int64(4), output.ScannedKeys)
+	assert.False(t, output.Partial)
+	require.Contains(t, output.Types, "string")
+	strs := output.Types["string"]
+	assert.Equal(t, int64(3), strs.Keys)
+	assert.Equal(t, int64(13), strs.TotalLength)
+	require.Len(t, strs.Biggest, 2)
+	assert.Equal(t, "large", strs.Biggest[0].Key)
+	assert.Equal(t, int64(8), strs.Biggest[0].Length)
+	assert.Equal(t, "medium", strs.Biggest[1].Key)
+
+	require.Contains(t, output.Types, "list")
+	assert.Equal(t, []BigKey{{Key: "queue", Length: 3}}, output.Types["list"].Biggest)
+}
+
+func TestTool_Execute_ByMemory(t *testing.T) {
+	tool := NewTool(&client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			return []string{"a", "b"}, 0, nil
+		},
+		InspectKeysFunc: func(ctx context.Context, keys []string) ([]client.KeyInfo, error) {
+			return []client.KeyInfo{
+				{Key: "a", Type: "hash", Encoding: "listpack", MemoryBytes: 100},
+				{Key: "b", Type: "hash", Encoding: "hashtable", MemoryBytes: 5000},
+			}, nil
+		},
+		KeyLengthsFunc: func(ctx context.Context, keys, types []string) ([]int64, error) {
+			return []int64{50, 10}, nil
+		},
+	})
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	hashes := result.(*Output).Types["hash"]
+	require.Len(t, hashes.Biggest, 2)
+	assert.Equal(t, BigKey{Key: "b", Length: 10, MemoryBytes: 5000, Encoding: "hashtable"}, hashes.Biggest[0])
+	assert.Equal(t, int64(5100), hashes.MemoryBytes)
+}
+
+func TestTool_Execute_TimeBudget(t *testing.T) {
+	tool := NewTool(&client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			<-ctx.Done()
+			return nil, 0, ctx.Err()
+		},
+	})
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"time_budget_seconds": 1}`))
+	require.NoError(t, err)
+	assert.True(t, result.(*Output).Partial)
+}
+
+func TestTool_Execute_InvalidSortBy(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"sort_by": "age"}`))
+	assert.Error(t, err)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "find_big_keys", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
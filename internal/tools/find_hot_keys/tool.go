@@ -0,0 +1,160 @@
+// Package find_hot_keys implements the find_hot_keys tool.
+package find_hot_keys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+const (
+	// defaultTop is the number of keys returned when top is omitted.
+	defaultTop = 20
+	// defaultBudget is the scan time budget when time_budget_seconds is omitted.
+	defaultBudget = 30 * time.Second
+)
+
+// Ranking methods reported in Output.Method.
+const (
+	methodFrequency = "lfu_frequency"
+	methodIdletime  = "idletime"
+)
+
+// Tool implements the find_hot_keys functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for find_hot_keys tool.
+type Input struct {
+	Pattern           string `json:"pattern,omitempty" jsonschema:"description=Glob pattern of the keys to examine (default: *)"`
+	Top               int    `json:"top,omitempty" jsonschema:"minimum=1,description=Number of hottest keys returned (default: 20)"`
+	TimeBudgetSeconds int64  `json:"time_budget_seconds,omitempty" jsonschema:"minimum=1,description=Stop scanning after this many seconds and return what was found (default: 30)"`
+	MaxKeys           int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after examining this many keys (default: no limit)"`
+	BatchSize         int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// Output represents the output of find_hot_keys tool.
+type Output struct {
+	Pattern         string `json:"pattern"`
+	MaxmemoryPolicy string `json:"maxmemory_policy"`
+	// Method is lfu_frequency when keys are ranked by OBJECT FREQ, or
+	// idletime when the policy is not LFU and keys are ranked by how
+	// recently they were accessed.
+	Method      string   `json:"method"`
+	Note        string   `json:"note,omitempty"`
+	Nodes       int      `json:"nodes"`
+	ScannedKeys int64    `json:"scanned_keys"`
+	Keys        []HotKey `json:"keys"`
+	// Partial is set when the time budget ran out or the call was cancelled
+	// before the scan completed.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// HotKey is one of the most accessed keys. Frequency is the logarithmic
+// LFU counter; IdleSeconds is the time since the last access.
+type HotKey struct {
+	Key         string `json:"key"`
+	Frequency   *int64 `json:"frequency,omitempty"`
+	IdleSeconds *int64 `json:"idle_seconds,omitempty"`
+}
+
+// NewTool creates a new find_hot_keys tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"find_hot_keys",
+			"Find the most accessed keys, like valkey-cli --hotkeys. Scans every node and ranks keys by OBJECT FREQ when maxmemory-policy is an LFU policy; otherwise ranks them by OBJECT IDLETIME, which shows recent rather than frequent access. Stops when the time budget runs out, returning what was found with partial set",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Pattern == "" {
+		params.Pattern = "*"
+	}
+	if params.Top <= 0 {
+		params.Top = defaultTop
+	}
+	budget := defaultBudget
+	if params.TimeBudgetSeconds > 0 {
+		budget = time.Duration(params.TimeBudgetSeconds) * time.Second
+	}
+	if params.MaxKeys <= 0 {
+		params.MaxKeys = math.MaxInt64
+	}
+
+	config, err := t.client.ConfigGet(ctx, "maxmemory-policy")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maxmemory-policy: %w", err)
+	}
+	output := &Output{Pattern: params.Pattern, MaxmemoryPolicy: config["maxmemory-policy"], Keys: []HotKey{}}
+
+	// OBJECT FREQ only works under an LFU policy and OBJECT IDLETIME only
+	// under any other.
+	lfu := strings.Contains(output.MaxmemoryPolicy, "lfu")
+	measure := t.client.ObjectIdletimes
+	top := base.NewTopN(params.Top, func(a, b HotKey) bool { return *a.IdleSeconds > *b.IdleSeconds })
+	output.Method = methodIdletime
+	output.Note = "maxmemory-policy is not an LFU policy, so keys are ranked by last access; set an allkeys-lfu or volatile-lfu policy to rank by access frequency"
+	if lfu {
+		measure = t.client.ObjectFreqs
+		top = base.NewTopN(params.Top, func(a, b HotKey) bool { return *a.Frequency < *b.Frequency })
+		output.Method = methodFrequency
+		output.Note = ""
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	opts := base.BulkOptions{Pattern: params.Pattern, MaxKeys: params.MaxKeys, BatchSize: params.BatchSize}
+	result, err := base.ScanEach(scanCtx, t.client, opts, func(ctx context.Context, keys []string) error {
+		values, err := measure(ctx, keys)
+		if err != nil {
+			return fmt.Errorf("failed to read access statistics: %w", err)
+		}
+		for i, key := range keys {
+			// Keys deleted since the scan found them are left out.
+			if values[i] < 0 {
+				continue
+			}
+			value := values[i]
+			hot := HotKey{Key: key}
+			if lfu {
+				hot.Frequency = &value
+			} else {
+				hot.IdleSeconds = &value
+			}
+			top.Add(hot)
+			output.ScannedKeys++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	output.Nodes = result.Nodes
+	output.Partial = result.Partial
+	output.Keys = append(output.Keys, top.Items()...)
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
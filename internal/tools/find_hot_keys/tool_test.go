@@ -0,0 +1,68 @@
+package find_hot_keys
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_LFU(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+	mockClient.ConfigSet(ctx, "maxmemory-policy", "allkeys-lfu")
+	for key, freq := range map[string]int64{"cold": 1, "warm": 20, "hot": 200} {
+		mockClient.SetString(ctx, key, "v", nil, false, false)
+		mockClient.SetObjectFreq(key, freq)
+	}
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"top": 2}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, methodFrequency, output.Method)
+	assert.Empty(t, output.Note)
+	assert.Equal(t, int64(3), output.ScannedKeys)
+	require.Len(t, output.Keys, 2)
+	assert.Equal(t, "hot", output.Keys[0].Key)
+	assert.Equal(t, int64(200), *output.Keys[0].Frequency)
+	assert.Equal(t, "warm", output.Keys[1].Key)
+	assert.Nil(t, output.Keys[0].IdleSeconds)
+}
+
+func TestTool_Execute_IdletimeFallback(t *testing.T) {
+	tool := NewTool(&client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			return []string{"old", "recent", "gone"}, 0, nil
+		},
+		ObjectIdletimesFunc: func(ctx context.Context, keys []string) ([]int64, error) {
+			return []int64{3600, 2, -1}, nil
+		},
+		ObjectFreqsFunc: func(ctx context.Context, keys []string) ([]int64, error) {
+			t.Fatal("OBJECT FREQ used without an LFU policy")
+			return nil, nil
+		},
+	})
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, methodIdletime, output.Method)
+	assert.NotEmpty(t, output.Note)
+	assert.Equal(t, int64(2), output.ScannedKeys)
+	require.Len(t, output.Keys, 2)
+	assert.Equal(t, "recent", output.Keys[0].Key)
+	assert.Equal(t, int64(2), *output.Keys[0].IdleSeconds)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "find_hot_keys", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
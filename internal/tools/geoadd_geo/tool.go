@@ -0,0 +1,142 @@
+// Package geoadd_geo implements the geoadd_geo tool.
+package geoadd_geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the geoadd_geo functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for geoadd_geo tool.
+type Input struct {
+	Key       string     `json:"key" jsonschema:"required,description=Geo set key"`
+	Locations []Location `json:"locations" jsonschema:"required,description=Members and their positions"`
+	NX        bool       `json:"nx,omitempty" jsonschema:"description=Only add new members; never move existing ones"`
+	XX        bool       `json:"xx,omitempty" jsonschema:"description=Only move existing members; never add new ones"`
+	CH        bool       `json:"ch,omitempty" jsonschema:"description=Count moved members as well as added ones"`
+}
+
+// Location is a member and its position.
+type Location struct {
+	Member    string  `json:"member" jsonschema:"required,description=Member name"`
+	Longitude float64 `json:"longitude" jsonschema:"required,minimum=-180,maximum=180,description=Longitude in degrees"`
+	Latitude  float64 `json:"latitude" jsonschema:"required,minimum=-85.05112878,maximum=85.05112878,description=Latitude in degrees"`
+}
+
+// Output represents the output of geoadd_geo tool.
+type Output struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count" jsonschema:"description=Members added; plus members moved with ch"`
+}
+
+// NewTool creates a new geoadd_geo tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"geoadd_geo",
+			"Add members to a geo set with GEOADD or move existing ones. Geo sets are sorted sets scored by geohash; positions read back are accurate to within about a meter",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, locations, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := t.client.GeoAdd(ctx, params.Key, locations, client.GeoAddOptions{NX: params.NX, XX: params.XX, CH: params.CH})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add to geo set %q: %w", params.Key, err)
+	}
+	return Output{Key: params.Key, Count: count}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, locations, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]string, len(locations))
+	for i, l := range locations {
+		members[i] = l.Member
+	}
+	current, err := t.client.GeoPos(ctx, params.Key, members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get positions in geo set %q: %w", params.Key, err)
+	}
+
+	var changes []base.Change
+	added, moved := 0, 0
+	for i, l := range locations {
+		exists := current[i] != nil
+		if (exists && params.NX) || (!exists && params.XX) {
+			continue
+		}
+		if exists {
+			moved++
+		} else {
+			added++
+		}
+		if len(changes) < base.MaxPreviewElements {
+			changes = append(changes, base.Change{Target: l.Member, Current: base.NewCoordinates(current[i]), New: base.NewCoordinates(&l.GeoPosition)})
+		}
+	}
+	return &base.Preview{
+		Summary:   fmt.Sprintf("Add %d and move %d members of geo set %q", added, moved, params.Key),
+		Keys:      []*base.KeyState{state},
+		Changes:   changes,
+		Truncated: added+moved > len(changes),
+	}, nil
+}
+
+// parse validates input and converts the locations.
+func (t *Tool) parse(input json.RawMessage) (Input, []client.GeoLocation, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, nil, err
+	}
+	if params.Key == "" {
+		return params, nil, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.Locations) == 0 {
+		return params, nil, fmt.Errorf("locations cannot be empty")
+	}
+	if params.NX && params.XX {
+		return params, nil, fmt.Errorf("nx and xx cannot be used together")
+	}
+	locations := make([]client.GeoLocation, len(params.Locations))
+	for i, l := range params.Locations {
+		if l.Member == "" {
+			return params, nil, fmt.Errorf("member cannot be empty")
+		}
+		if err := base.ValidatePosition(l.Longitude, l.Latitude); err != nil {
+			return params, nil, fmt.Errorf("member %q: %w", l.Member, err)
+		}
+		locations[i] = client.GeoLocation{Member: l.Member, GeoPosition: client.GeoPosition{Longitude: l.Longitude, Latitude: l.Latitude}}
+	}
+	return params, locations, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,81 @@
+package geoadd_geo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sicily = `{"key":"Sicily","locations":[
+	{"member":"Palermo","longitude":13.361389,"latitude":38.115556},
+	{"member":"Catania","longitude":15.087269,"latitude":37.502669}
+]`
+
+func TestGeoAddGeo_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(sicily+`}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "Sicily", Count: 2}, result)
+
+	positions, err := mockClient.GeoPos(ctx, "Sicily", []string{"Palermo"})
+	require.NoError(t, err)
+	assert.InDelta(t, 13.361389, positions[0].Longitude, 1e-5)
+	assert.InDelta(t, 38.115556, positions[0].Latitude, 1e-5)
+
+	moved := `{"key":"Sicily","locations":[
+		{"member":"Palermo","longitude":13.5,"latitude":38.1},
+		{"member":"Agrigento","longitude":13.583333,"latitude":37.316667}
+	]`
+	result, err = tool.Execute(ctx, json.RawMessage(moved+`,"xx":true,"ch":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "Sicily", Count: 1}, result)
+	result, err = tool.Execute(ctx, json.RawMessage(moved+`,"nx":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "Sicily", Count: 1}, result)
+}
+
+func TestGeoAddGeo_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	tests := map[string]string{
+		`{"key":"","locations":[{"member":"a","longitude":0,"latitude":0}]}`:                      "key cannot be empty",
+		`{"key":"k","locations":[]}`:                                                              "locations cannot be empty",
+		`{"key":"k","locations":[{"member":"a","longitude":0,"latitude":0}],"nx":true,"xx":true}`: "nx and xx cannot be used together",
+		`{"key":"k","locations":[{"member":"","longitude":0,"latitude":0}]}`:                      "member cannot be empty",
+		`{"key":"k","locations":[{"member":"a","longitude":0,"latitude":89}]}`:                    `member "a": latitude must be between -85.05112878 and 85.05112878`,
+	}
+	for input, want := range tests {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
+
+func TestGeoAddGeo_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+	_, err := tool.Execute(ctx, json.RawMessage(`{"key":"Sicily","locations":[{"member":"Palermo","longitude":13.361389,"latitude":38.115556}]}`))
+	require.NoError(t, err)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(sicily+`}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Add 1 and move 1 members of geo set "Sicily"`, p.Summary)
+	require.Len(t, p.Changes, 2)
+	assert.Equal(t, "Palermo", p.Changes[0].Target)
+	assert.NotNil(t, p.Changes[0].Current)
+	assert.Equal(t, &base.Coordinates{Longitude: 15.087269, Latitude: 37.502669}, p.Changes[1].New)
+	assert.Nil(t, p.Changes[1].Current)
+
+	preview, err = tool.Preview(ctx, json.RawMessage(sicily+`,"nx":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, `Add 1 and move 0 members of geo set "Sicily"`, preview.(*base.Preview).Summary)
+}
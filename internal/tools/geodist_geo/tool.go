@@ -0,0 +1,83 @@
+// Package geodist_geo implements the geodist_geo tool.
+package geodist_geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the geodist_geo functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for geodist_geo tool.
+type Input struct {
+	Key     string `json:"key" jsonschema:"required,description=Geo set key"`
+	Member1 string `json:"member1" jsonschema:"required,description=First member"`
+	Member2 string `json:"member2" jsonschema:"required,description=Second member"`
+	Unit    string `json:"unit,omitempty" jsonschema:"description=Distance unit: m; km; ft or mi (default: m)"`
+}
+
+// Output represents the output of geodist_geo tool.
+type Output struct {
+	Key      string   `json:"key"`
+	Member1  string   `json:"member1"`
+	Member2  string   `json:"member2"`
+	Unit     string   `json:"unit"`
+	Distance *float64 `json:"distance" jsonschema:"description=Great-circle distance; null when either member is missing"`
+	Found    bool     `json:"found"`
+}
+
+// NewTool creates a new geodist_geo tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"geodist_geo",
+			"Get the distance between two members of a geo set with GEODIST. The earth is taken to be a sphere so the error can reach 0.5%",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Member1 == "" || params.Member2 == "" {
+		return nil, fmt.Errorf("member1 and member2 cannot be empty")
+	}
+	unit, err := base.GeoUnit(params.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	distance, err := t.client.GeoDist(ctx, params.Key, params.Member1, params.Member2, unit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get distance in geo set %q: %w", params.Key, err)
+	}
+	return Output{
+		Key:      params.Key,
+		Member1:  params.Member1,
+		Member2:  params.Member2,
+		Unit:     unit,
+		Distance: distance,
+		Found:    distance != nil,
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,49 @@
+package geodist_geo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	mockClient := client.NewMockClient()
+	_, err := mockClient.GeoAdd(context.Background(), "Sicily", []client.GeoLocation{
+		{Member: "Palermo", GeoPosition: client.GeoPosition{Longitude: 13.361389, Latitude: 38.115556}},
+		{Member: "Catania", GeoPosition: client.GeoPosition{Longitude: 15.087269, Latitude: 37.502669}},
+	}, client.GeoAddOptions{})
+	require.NoError(t, err)
+	return mockClient
+}
+
+func TestGeoDistGeo_Execute(t *testing.T) {
+	tool := NewTool(setup(t))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","member1":"Palermo","member2":"Catania"}`))
+	require.NoError(t, err)
+	distance := 166274.1516
+	assert.Equal(t, Output{Key: "Sicily", Member1: "Palermo", Member2: "Catania", Unit: "m", Distance: &distance, Found: true}, result)
+
+	result, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","member1":"Palermo","member2":"Catania","unit":"KM"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 166.2742, *result.(Output).Distance)
+
+	result, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","member1":"Palermo","member2":"Rome"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "Sicily", Member1: "Palermo", Member2: "Rome", Unit: "m"}, result)
+}
+
+func TestGeoDistGeo_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","member1":"a","member2":"b"}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"k","member1":"a","member2":""}`))
+	assert.EqualError(t, err, "member1 and member2 cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"k","member1":"a","member2":"b","unit":"yd"}`))
+	assert.EqualError(t, err, "unit must be m, km, ft or mi")
+}
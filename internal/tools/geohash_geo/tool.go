@@ -0,0 +1,76 @@
+// Package geohash_geo implements the geohash_geo tool.
+package geohash_geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the geohash_geo functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for geohash_geo tool.
+type Input struct {
+	Key     string   `json:"key" jsonschema:"required,description=Geo set key"`
+	Members []string `json:"members" jsonschema:"required,description=Members to get the geohash of"`
+}
+
+// Output represents the output of geohash_geo tool.
+type Output struct {
+	Key    string `json:"key"`
+	Hashes []Hash `json:"hashes"`
+}
+
+// Hash is the geohash of a member; nil when it is not in the set.
+type Hash struct {
+	Member string  `json:"member"`
+	Hash   *string `json:"hash"`
+}
+
+// NewTool creates a new geohash_geo tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"geohash_geo",
+			"Get the standard 11-character geohash of members of a geo set with GEOHASH; usable with geohash.org and other geohash tools. Missing members have a null hash",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.Members) == 0 {
+		return nil, fmt.Errorf("members cannot be empty")
+	}
+
+	hashes, err := t.client.GeoHash(ctx, params.Key, params.Members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get geohashes in geo set %q: %w", params.Key, err)
+	}
+	output := Output{Key: params.Key, Hashes: make([]Hash, len(params.Members))}
+	for i, member := range params.Members {
+		output.Hashes[i] = Hash{Member: member, Hash: hashes[i]}
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
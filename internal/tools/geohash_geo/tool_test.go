@@ -0,0 +1,39 @@
+package geohash_geo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoHashGeo_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.GeoAdd(ctx, "Sicily", []client.GeoLocation{
+		{Member: "Palermo", GeoPosition: client.GeoPosition{Longitude: 13.361389, Latitude: 38.115556}},
+		{Member: "Catania", GeoPosition: client.GeoPosition{Longitude: 15.087269, Latitude: 37.502669}},
+	}, client.GeoAddOptions{})
+	require.NoError(t, err)
+
+	result, err := NewTool(mockClient).Execute(ctx, json.RawMessage(`{"key":"Sicily","members":["Palermo","Catania","Rome"]}`))
+	require.NoError(t, err)
+	palermo, catania := "sqc8b49rny0", "sqdtr74hyu0"
+	assert.Equal(t, Output{Key: "Sicily", Hashes: []Hash{
+		{Member: "Palermo", Hash: &palermo},
+		{Member: "Catania", Hash: &catania},
+		{Member: "Rome"},
+	}}, result)
+}
+
+func TestGeoHashGeo_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","members":["a"]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","members":[]}`))
+	assert.EqualError(t, err, "members cannot be empty")
+}
@@ -0,0 +1,76 @@
+// Package geopos_geo implements the geopos_geo tool.
+package geopos_geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the geopos_geo functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for geopos_geo tool.
+type Input struct {
+	Key     string   `json:"key" jsonschema:"required,description=Geo set key"`
+	Members []string `json:"members" jsonschema:"required,description=Members to locate"`
+}
+
+// Output represents the output of geopos_geo tool.
+type Output struct {
+	Key       string     `json:"key"`
+	Locations []Location `json:"locations"`
+}
+
+// Location is the position of a member; nil when it is not in the set.
+type Location struct {
+	Member   string            `json:"member"`
+	Position *base.Coordinates `json:"position"`
+}
+
+// NewTool creates a new geopos_geo tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"geopos_geo",
+			"Get the longitude and latitude of members of a geo set with GEOPOS. Missing members have a null position",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.Members) == 0 {
+		return nil, fmt.Errorf("members cannot be empty")
+	}
+
+	positions, err := t.client.GeoPos(ctx, params.Key, params.Members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get positions in geo set %q: %w", params.Key, err)
+	}
+	locations := make([]Location, len(params.Members))
+	for i, member := range params.Members {
+		locations[i] = Location{Member: member, Position: base.NewCoordinates(positions[i])}
+	}
+	return Output{Key: params.Key, Locations: locations}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
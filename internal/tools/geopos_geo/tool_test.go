@@ -0,0 +1,36 @@
+package geopos_geo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoPosGeo_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.GeoAdd(ctx, "Sicily", []client.GeoLocation{{Member: "Palermo", GeoPosition: client.GeoPosition{Longitude: 13.361389, Latitude: 38.115556}}}, client.GeoAddOptions{})
+	require.NoError(t, err)
+
+	result, err := NewTool(mockClient).Execute(ctx, json.RawMessage(`{"key":"Sicily","members":["Palermo","Rome"]}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	require.Len(t, output.Locations, 2)
+	assert.Equal(t, "Palermo", output.Locations[0].Member)
+	assert.InDelta(t, 13.36138933897018433, output.Locations[0].Position.Longitude, 1e-12)
+	assert.InDelta(t, 38.11555639549629859, output.Locations[0].Position.Latitude, 1e-12)
+	assert.Equal(t, Location{Member: "Rome"}, output.Locations[1])
+}
+
+func TestGeoPosGeo_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","members":["a"]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","members":[]}`))
+	assert.EqualError(t, err, "members cannot be empty")
+}
@@ -0,0 +1,106 @@
+// Package geosearch_geo implements the geosearch_geo tool.
+package geosearch_geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the geosearch_geo functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for geosearch_geo tool.
+type Input struct {
+	Key        string   `json:"key" jsonschema:"required,description=Geo set key"`
+	FromMember string   `json:"from_member,omitempty" jsonschema:"description=Member to centre the search on; or give longitude and latitude"`
+	Longitude  *float64 `json:"longitude,omitempty" jsonschema:"description=Longitude of the centre of the search"`
+	Latitude   *float64 `json:"latitude,omitempty" jsonschema:"description=Latitude of the centre of the search"`
+	Radius     float64  `json:"radius,omitempty" jsonschema:"description=Radius of a circular search; or give width and height"`
+	Width      float64  `json:"width,omitempty" jsonschema:"description=Width of a box search centred on the centre"`
+	Height     float64  `json:"height,omitempty" jsonschema:"description=Height of a box search centred on the centre"`
+	Unit       string   `json:"unit,omitempty" jsonschema:"description=Unit of radius; width; height and distances: m; km; ft or mi (default: m)"`
+	Order      string   `json:"order,omitempty" jsonschema:"description=ASC for nearest first or DESC for farthest first (default: nearest first with count and no order otherwise)"`
+	Count      int64    `json:"count,omitempty" jsonschema:"minimum=0,description=Return at most this many matches"`
+	Any        bool     `json:"any,omitempty" jsonschema:"description=With count stop at the first count matches instead of finding the nearest; faster on large sets"`
+	WithCoord  bool     `json:"with_coord,omitempty" jsonschema:"description=Return the position of each match"`
+	WithDist   bool     `json:"with_dist,omitempty" jsonschema:"description=Return the distance of each match from the centre"`
+	WithHash   bool     `json:"with_hash,omitempty" jsonschema:"description=Return the raw 52-bit geohash score of each match"`
+}
+
+// Output represents the output of geosearch_geo tool.
+type Output struct {
+	Key     string  `json:"key"`
+	Unit    string  `json:"unit"`
+	Count   int     `json:"count"`
+	Matches []Match `json:"matches"`
+}
+
+// Match is a member found by the search. Distance, Hash and Position are
+// only set when asked for.
+type Match struct {
+	Member   string            `json:"member"`
+	Distance *float64          `json:"distance,omitempty"`
+	Hash     *int64            `json:"hash,omitempty"`
+	Position *base.Coordinates `json:"position,omitempty"`
+}
+
+// NewTool creates a new geosearch_geo tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"geosearch_geo",
+			"Find the members of a geo set within a radius or box of a member or a longitude and latitude with GEOSEARCH. Optionally returns each match's distance; position and geohash",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	q := client.GeoSearchQuery{
+		FromMember: params.FromMember,
+		Radius:     params.Radius,
+		Width:      params.Width,
+		Height:     params.Height,
+		Unit:       params.Unit,
+		Order:      params.Order,
+		Count:      params.Count,
+		Any:        params.Any,
+		WithCoord:  params.WithCoord,
+		WithDist:   params.WithDist,
+		WithHash:   params.WithHash,
+	}
+	if err := base.GeoSearch(&q, params.Longitude, params.Latitude); err != nil {
+		return nil, err
+	}
+
+	found, err := t.client.GeoSearch(ctx, params.Key, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search geo set %q: %w", params.Key, err)
+	}
+	matches := make([]Match, len(found))
+	for i, m := range found {
+		matches[i] = Match{Member: m.Member, Distance: m.Distance, Hash: m.Hash, Position: base.NewCoordinates(m.Position)}
+	}
+	return Output{Key: params.Key, Unit: q.Unit, Count: len(matches), Matches: matches}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
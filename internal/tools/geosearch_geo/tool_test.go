@@ -0,0 +1,87 @@
+package geosearch_geo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	mockClient := client.NewMockClient()
+	_, err := mockClient.GeoAdd(context.Background(), "Sicily", []client.GeoLocation{
+		{Member: "Palermo", GeoPosition: client.GeoPosition{Longitude: 13.361389, Latitude: 38.115556}},
+		{Member: "Catania", GeoPosition: client.GeoPosition{Longitude: 15.087269, Latitude: 37.502669}},
+		{Member: "edge1", GeoPosition: client.GeoPosition{Longitude: 12.758489, Latitude: 38.788135}},
+		{Member: "edge2", GeoPosition: client.GeoPosition{Longitude: 17.241510, Latitude: 38.788135}},
+	}, client.GeoAddOptions{})
+	require.NoError(t, err)
+	return mockClient
+}
+
+func members(output Output) []string {
+	names := make([]string, len(output.Matches))
+	for i, m := range output.Matches {
+		names[i] = m.Member
+	}
+	return names
+}
+
+func TestGeoSearchGeo_Execute_Radius(t *testing.T) {
+	tool := NewTool(setup(t))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","longitude":15,"latitude":37,"radius":200,"unit":"km","order":"asc","with_dist":true,"with_coord":true,"with_hash":true}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, "km", output.Unit)
+	require.Equal(t, 2, output.Count)
+	assert.Equal(t, "Catania", output.Matches[0].Member)
+	assert.Equal(t, 56.4413, *output.Matches[0].Distance)
+	assert.Equal(t, int64(3479447370796909), *output.Matches[0].Hash)
+	assert.InDelta(t, 15.087269, output.Matches[0].Position.Longitude, 1e-5)
+	assert.Equal(t, "Palermo", output.Matches[1].Member)
+	assert.Equal(t, 190.4424, *output.Matches[1].Distance)
+}
+
+func TestGeoSearchGeo_Execute_Box(t *testing.T) {
+	tool := NewTool(setup(t))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","longitude":15,"latitude":37,"width":400,"height":400,"unit":"km","order":"DESC"}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, []string{"edge1", "edge2", "Palermo", "Catania"}, members(output))
+	assert.Equal(t, Match{Member: "edge1"}, output.Matches[0])
+}
+
+func TestGeoSearchGeo_Execute_FromMemberCount(t *testing.T) {
+	tool := NewTool(setup(t))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","from_member":"Palermo","radius":500,"unit":"km","count":2}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Palermo", "edge1"}, members(result.(Output)))
+
+	result, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","from_member":"Palermo","radius":500,"unit":"km","count":1,"any":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.(Output).Count)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"Sicily","from_member":"Rome","radius":5}`))
+	assert.ErrorContains(t, err, "could not decode requested zset member")
+}
+
+func TestGeoSearchGeo_Execute_MissingKey(t *testing.T) {
+	result, err := NewTool(client.NewMockClient()).Execute(context.Background(), json.RawMessage(`{"key":"none","longitude":0,"latitude":0,"radius":5}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "none", Unit: "m", Matches: []Match{}}, result)
+}
+
+func TestGeoSearchGeo_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","from_member":"a","radius":1}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"k","radius":1}`))
+	assert.EqualError(t, err, "from_member or longitude and latitude are required")
+}
@@ -0,0 +1,155 @@
+// Package geosearchstore_geo implements the geosearchstore_geo tool.
+package geosearchstore_geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the geosearchstore_geo functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for geosearchstore_geo tool.
+type Input struct {
+	Destination string   `json:"destination" jsonschema:"required,description=Key to store the matches in; replaced if it exists"`
+	Key         string   `json:"key" jsonschema:"required,description=Geo set to search"`
+	FromMember  string   `json:"from_member,omitempty" jsonschema:"description=Member to centre the search on; or give longitude and latitude"`
+	Longitude   *float64 `json:"longitude,omitempty" jsonschema:"description=Longitude of the centre of the search"`
+	Latitude    *float64 `json:"latitude,omitempty" jsonschema:"description=Latitude of the centre of the search"`
+	Radius      float64  `json:"radius,omitempty" jsonschema:"description=Radius of a circular search; or give width and height"`
+	Width       float64  `json:"width,omitempty" jsonschema:"description=Width of a box search centred on the centre"`
+	Height      float64  `json:"height,omitempty" jsonschema:"description=Height of a box search centred on the centre"`
+	Unit        string   `json:"unit,omitempty" jsonschema:"description=Unit of radius; width; height and stored distances: m; km; ft or mi (default: m)"`
+	Order       string   `json:"order,omitempty" jsonschema:"description=ASC or DESC; decides which matches count keeps"`
+	Count       int64    `json:"count,omitempty" jsonschema:"minimum=0,description=Store at most this many matches; the nearest unless any is set"`
+	Any         bool     `json:"any,omitempty" jsonschema:"description=With count stop at the first count matches instead of finding the nearest"`
+	StoreDist   bool     `json:"store_dist,omitempty" jsonschema:"description=Store a sorted set scored by distance from the centre instead of a geo set"`
+}
+
+// Output represents the output of geosearchstore_geo tool.
+type Output struct {
+	Destination string `json:"destination"`
+	Key         string `json:"key"`
+	Stored      int64  `json:"stored" jsonschema:"description=Number of matches stored"`
+}
+
+// NewTool creates a new geosearchstore_geo tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"geosearchstore_geo",
+			"Store the members of a geo set within a radius or box in destination with GEOSEARCHSTORE. destination is replaced; deleted when nothing matches. In a cluster both keys must share a hash slot",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, q, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := t.client.GeoSearchStore(ctx, params.Destination, params.Key, q, params.StoreDist)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store search of geo set %q in %q: %w", params.Key, params.Destination, err)
+	}
+	return Output{Destination: params.Destination, Key: params.Key, Stored: stored}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, _, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := t.client.ExistsKey(ctx, params.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check key %q: %w", params.Destination, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return &registry.Impact{
+		Summary:    fmt.Sprintf("Store matches of geo set %q in %q, replacing its current value", params.Key, params.Destination),
+		Keys:       []string{params.Destination},
+		Overwrites: []string{params.Destination},
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, q, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := base.DescribeKeys(ctx, t.client, []string{params.Key, params.Destination})
+	if err != nil {
+		return nil, err
+	}
+	matches, err := t.client.GeoSearch(ctx, params.Key, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search geo set %q: %w", params.Key, err)
+	}
+	preview := &base.Preview{Keys: states}
+	destination := states[1]
+	switch {
+	case len(matches) == 0 && destination.Exists():
+		preview.Summary = fmt.Sprintf("Nothing matches; delete the existing %s %q", destination.Type, params.Destination)
+	case len(matches) == 0:
+		preview.Summary = "Nothing matches; nothing would be stored"
+	default:
+		preview.Summary = fmt.Sprintf("Store %d matches of geo set %q in %q", len(matches), params.Key, params.Destination)
+		if destination.Exists() {
+			preview.Summary += fmt.Sprintf(", overwriting the existing %s", destination.Type)
+		}
+	}
+	if destination.Exists() {
+		preview.Overwrites = []string{params.Destination}
+	}
+	return preview, nil
+}
+
+// parse validates input and converts it to a search query.
+func (t *Tool) parse(input json.RawMessage) (Input, client.GeoSearchQuery, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, client.GeoSearchQuery{}, err
+	}
+	if params.Key == "" {
+		return params, client.GeoSearchQuery{}, fmt.Errorf("key cannot be empty")
+	}
+	if params.Destination == "" {
+		return params, client.GeoSearchQuery{}, fmt.Errorf("destination cannot be empty")
+	}
+	q := client.GeoSearchQuery{
+		FromMember: params.FromMember,
+		Radius:     params.Radius,
+		Width:      params.Width,
+		Height:     params.Height,
+		Unit:       params.Unit,
+		Order:      params.Order,
+		Count:      params.Count,
+		Any:        params.Any,
+	}
+	if err := base.GeoSearch(&q, params.Longitude, params.Latitude); err != nil {
+		return params, client.GeoSearchQuery{}, err
+	}
+	return params, q, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
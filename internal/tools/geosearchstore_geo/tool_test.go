@@ -0,0 +1,89 @@
+package geosearchstore_geo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	mockClient := client.NewMockClient()
+	_, err := mockClient.GeoAdd(context.Background(), "Sicily", []client.GeoLocation{
+		{Member: "Palermo", GeoPosition: client.GeoPosition{Longitude: 13.361389, Latitude: 38.115556}},
+		{Member: "Catania", GeoPosition: client.GeoPosition{Longitude: 15.087269, Latitude: 37.502669}},
+	}, client.GeoAddOptions{})
+	require.NoError(t, err)
+	return mockClient
+}
+
+func TestGeoSearchStoreGeo_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"destination":"near","key":"Sicily","longitude":15,"latitude":37,"radius":100,"unit":"km"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Destination: "near", Key: "Sicily", Stored: 1}, result)
+	positions, err := mockClient.GeoPos(ctx, "near", []string{"Catania"})
+	require.NoError(t, err)
+	assert.NotNil(t, positions[0])
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"destination":"near","key":"Sicily","longitude":15,"latitude":37,"radius":200,"unit":"km","store_dist":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.(Output).Stored)
+	members, err := mockClient.GetSortedSetRange(ctx, "near", 0, -1)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.InDelta(t, 56.4413, members[0].Score, 1e-3)
+	assert.InDelta(t, 190.4424, members[1].Score, 1e-3)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"destination":"near","key":"Sicily","longitude":0,"latitude":0,"radius":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), result.(Output).Stored)
+	exists, err := mockClient.ExistsKey(ctx, "near")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGeoSearchStoreGeo_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"destination":"","key":"Sicily","from_member":"a","radius":1}`))
+	assert.EqualError(t, err, "destination cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"destination":"d","key":"Sicily","from_member":"a"}`))
+	assert.EqualError(t, err, "radius or width and height are required")
+}
+
+func TestGeoSearchStoreGeo_AssessAndPreview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := setup(t)
+	tool := NewTool(mockClient).(*Tool)
+	input := json.RawMessage(`{"destination":"near","key":"Sicily","from_member":"Catania","radius":100,"unit":"km"}`)
+
+	impact, err := tool.Assess(ctx, input)
+	require.NoError(t, err)
+	assert.Nil(t, impact)
+	preview, err := tool.Preview(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, `Store 1 matches of geo set "Sicily" in "near"`, preview.(*base.Preview).Summary)
+
+	_, err = tool.Execute(ctx, input)
+	require.NoError(t, err)
+	impact, err = tool.Assess(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, &registry.Impact{
+		Summary:    `Store matches of geo set "Sicily" in "near", replacing its current value`,
+		Keys:       []string{"near"},
+		Overwrites: []string{"near"},
+	}, impact)
+	preview, err = tool.Preview(ctx, json.RawMessage(`{"destination":"near","key":"Sicily","longitude":0,"latitude":0,"radius":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, `Nothing matches; delete the existing zset "near"`, preview.(*base.Preview).Summary)
+	assert.Equal(t, []string{"near"}, preview.(*base.Preview).Overwrites)
+}
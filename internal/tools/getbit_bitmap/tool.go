@@ -0,0 +1,67 @@
+// Package getbit_bitmap implements the getbit_bitmap tool.
+package getbit_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the getbit_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for getbit_bitmap tool.
+type Input struct {
+	Key    string `json:"key" jsonschema:"required,description=Bitmap key"`
+	Offset int64  `json:"offset" jsonschema:"required,minimum=0,description=Bit offset; 0 is the most significant bit of the first byte"`
+}
+
+// Output represents the output of getbit_bitmap tool.
+type Output struct {
+	Key    string `json:"key"`
+	Offset int64  `json:"offset"`
+	Value  int64  `json:"value"`
+}
+
+// NewTool creates a new getbit_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"getbit_bitmap",
+			"Get one bit of a bitmap with GETBIT. Bits past the end of the string and missing keys read as 0",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Offset < 0 {
+		return nil, fmt.Errorf("offset cannot be negative")
+	}
+
+	value, err := t.client.GetBit(ctx, params.Key, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bit %d of key %q: %w", params.Offset, params.Key, err)
+	}
+	return Output{Key: params.Key, Offset: params.Offset, Value: value}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
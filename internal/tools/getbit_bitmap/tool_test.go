@@ -0,0 +1,36 @@
+package getbit_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBitBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "flags", "\x40", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"flags","offset":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Offset: 1, Value: 1}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"flags","offset":100}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Offset: 100}, result)
+}
+
+func TestGetBitBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","offset":0}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"flags","offset":-1}`))
+	assert.EqualError(t, err, "offset cannot be negative")
+}
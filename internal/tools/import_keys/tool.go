@@ -0,0 +1,269 @@
+// Package import_keys implements the import_keys tool.
+package import_keys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/export"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Conflict modes for keys that already exist.
+const (
+	ConflictSkip    = "skip"
+	ConflictReplace = "replace"
+	ConflictFail    = "fail"
+)
+
+// maxErrors is the number of failures and conflicts listed.
+const maxErrors = 10
+
+// progressInterval is the number of records between progress reports.
+const progressInterval = 100
+
+// Tool implements the import_keys functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+	dir    string
+}
+
+// Input represents the input for import_keys tool.
+type Input struct {
+	Path        string `json:"path,omitempty" jsonschema:"description=File to read relative to the export directory"`
+	Data        string `json:"data,omitempty" jsonschema:"description=JSON Lines records as returned by export_keys; used when path is omitted"`
+	OnConflict  string `json:"on_conflict,omitempty" jsonschema:"description=Handling of keys that already exist: skip (the default) or replace or fail before writing anything"`
+	PreserveTTL *bool  `json:"preserve_ttl,omitempty" jsonschema:"description=Give each key the TTL it had when exported; counted from the import (default: true)"`
+}
+
+// Output represents the output of import_keys tool.
+type Output struct {
+	Records  int      `json:"records"`
+	Created  int64    `json:"created"`
+	Replaced int64    `json:"replaced"`
+	Skipped  int64    `json:"skipped"`
+	Failed   int64    `json:"failed,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+	// Partial is set when the import was cancelled.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// NewTool creates a new import_keys tool reading files inside dir. An empty
+// dir allows inline data only.
+func NewTool(client client.ValkeyClient, dir string) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"import_keys",
+			"Recreate keys from JSON Lines records written by export_keys, read from path in the export directory or passed as data. Existing keys are skipped or replaced or fail the import according to on_conflict; TTLs are preserved unless preserve_ttl is false. Records with a DUMP payload are restored with RESTORE when the server accepts it",
+			Input{},
+		),
+		client: client,
+		dir:    dir,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, records, err := t.load(input)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := t.existing(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+	if params.OnConflict == ConflictFail {
+		if conflicts := conflicting(records, existing); len(conflicts) > 0 {
+			return nil, fmt.Errorf("%d keys already exist: %s", len(conflicts), listKeys(conflicts))
+		}
+	}
+
+	output := &Output{Records: len(records)}
+	opts := export.WriteOptions{IgnoreTTL: params.PreserveTTL != nil && !*params.PreserveTTL}
+	for i, rec := range records {
+		if ctx.Err() != nil {
+			output.Partial = true
+			break
+		}
+		if i > 0 && i%progressInterval == 0 {
+			progress.Report(ctx, float64(i), float64(len(records)), fmt.Sprintf("imported %d of %d keys", i, len(records)))
+		}
+
+		exists := existing[rec.Key]
+		switch {
+		case exists && params.OnConflict == ConflictSkip:
+			output.Skipped++
+			continue
+		case exists && params.OnConflict == ConflictFail:
+			// Only a key repeated in the records conflicts here.
+			output.fail(fmt.Sprintf("key %q appears more than once", rec.Key))
+			continue
+		}
+
+		opts.Replace = exists
+		if err := export.Write(ctx, t.client, rec, opts); err != nil {
+			output.fail(err.Error())
+			continue
+		}
+		existing[rec.Key] = true
+		if exists {
+			output.Replaced++
+		} else {
+			output.Created++
+		}
+	}
+	return output, nil
+}
+
+// fail records a record that could not be imported.
+func (o *Output) fail(message string) {
+	o.Failed++
+	if len(o.Errors) < maxErrors {
+		o.Errors = append(o.Errors, message)
+	}
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, records, err := t.load(input)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := t.existing(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := conflicting(records, existing)
+	created := len(records) - len(conflicts)
+	preview := &base.Preview{}
+	switch params.OnConflict {
+	case ConflictSkip:
+		preview.Summary = fmt.Sprintf("Create %d keys; skip %d existing keys", created, len(conflicts))
+	case ConflictReplace:
+		preview.Summary = fmt.Sprintf("Create %d keys; replace %d existing keys", created, len(conflicts))
+		preview.Overwrites = conflicts[:min(len(conflicts), base.MaxPreviewElements)]
+		preview.Truncated = len(conflicts) > len(preview.Overwrites)
+	case ConflictFail:
+		preview.Summary = fmt.Sprintf("Create %d keys", created)
+		if len(conflicts) > 0 {
+			preview.Summary = fmt.Sprintf("Fail without writing: %d keys already exist: %s", len(conflicts), listKeys(conflicts))
+		}
+	}
+	return preview, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, records, err := t.load(input)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := t.existing(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := &registry.Impact{Summary: fmt.Sprintf("Import %d keys", len(records))}
+	for _, rec := range records {
+		if !existing[rec.Key] {
+			impact.Keys = append(impact.Keys, rec.Key)
+		} else if params.OnConflict == ConflictReplace {
+			impact.Keys = append(impact.Keys, rec.Key)
+			impact.Overwrites = append(impact.Overwrites, rec.Key)
+		}
+	}
+	if len(impact.Overwrites) > 0 {
+		impact.Summary += fmt.Sprintf(", replacing %d existing keys", len(impact.Overwrites))
+	}
+	return impact, nil
+}
+
+// load validates input and reads its records.
+func (t *Tool) load(input json.RawMessage) (*Input, []*export.Record, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, nil, err
+	}
+
+	switch params.OnConflict {
+	case "":
+		params.OnConflict = ConflictSkip
+	case ConflictSkip, ConflictReplace, ConflictFail:
+	default:
+		return nil, nil, fmt.Errorf("on_conflict must be %s, %s or %s", ConflictSkip, ConflictReplace, ConflictFail)
+	}
+
+	var r io.Reader
+	switch {
+	case params.Path != "" && params.Data != "":
+		return nil, nil, fmt.Errorf("pass either path or data, not both")
+	case params.Path != "":
+		f, err := export.Open(t.dir, params.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer f.Close()
+		r = f
+	case params.Data != "":
+		r = strings.NewReader(params.Data)
+	default:
+		return nil, nil, fmt.Errorf("path or data is required")
+	}
+
+	records, err := export.Decode(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &params, records, nil
+}
+
+// existing reports which keys of records already exist.
+func (t *Tool) existing(ctx context.Context, records []*export.Record) (map[string]bool, error) {
+	keys := make([]string, len(records))
+	for i, rec := range records {
+		keys[i] = rec.Key
+	}
+	existing, err := t.client.ExistsKeys(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing keys: %w", err)
+	}
+	return existing, nil
+}
+
+// conflicting returns the keys of records that already exist, once each.
+func conflicting(records []*export.Record, existing map[string]bool) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, rec := range records {
+		if existing[rec.Key] && !seen[rec.Key] {
+			seen[rec.Key] = true
+			keys = append(keys, rec.Key)
+		}
+	}
+	return keys
+}
+
+// listKeys formats the first keys for an error message.
+func listKeys(keys []string) string {
+	quoted := make([]string, 0, min(len(keys), maxErrors))
+	for _, key := range keys[:min(len(keys), maxErrors)] {
+		quoted = append(quoted, fmt.Sprintf("%q", key))
+	}
+	list := strings.Join(quoted, ", ")
+	if len(keys) > maxErrors {
+		list += ", ..."
+	}
+	return list
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, dir string) {
+	reg.MustRegister(NewTool(client, dir))
+}
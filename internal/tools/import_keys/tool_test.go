@@ -0,0 +1,119 @@
+package import_keys
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const records = `{"key":"user:1","type":"string","pttl":-1,"value":"alice"}
+{"key":"user:2","type":"hash","pttl":60000,"value":{"name":"bob"}}
+`
+
+func input(t *testing.T, fields map[string]any) json.RawMessage {
+	t.Helper()
+	b, err := json.Marshal(fields)
+	require.NoError(t, err)
+	return b
+}
+
+func TestTool_Execute_Data(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, "")
+
+	result, err := tool.Execute(ctx, input(t, map[string]any{"data": records}))
+	require.NoError(t, err)
+	assert.Equal(t, &Output{Records: 2, Created: 2}, result)
+
+	value, _, err := mockClient.GetString(ctx, "user:1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", string(value))
+	ttl, err := mockClient.GetTTL(ctx, "user:2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), ttl)
+}
+
+func TestTool_Execute_Conflicts(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetString(ctx, "user:1", "old", nil, false, false)
+	tool := NewTool(mockClient, "")
+
+	result, err := tool.Execute(ctx, input(t, map[string]any{"data": records}))
+	require.NoError(t, err)
+	assert.Equal(t, &Output{Records: 2, Created: 1, Skipped: 1}, result)
+	value, _, _ := mockClient.GetString(ctx, "user:1")
+	assert.Equal(t, "old", string(value))
+
+	mockClient.DeleteKey(ctx, "user:2")
+	_, err = tool.Execute(ctx, input(t, map[string]any{"data": records, "on_conflict": "fail"}))
+	assert.EqualError(t, err, `1 keys already exist: "user:1"`)
+	exists, _ := mockClient.ExistsKey(ctx, "user:2")
+	assert.False(t, exists)
+
+	result, err = tool.Execute(ctx, input(t, map[string]any{"data": records, "on_conflict": "replace", "preserve_ttl": false}))
+	require.NoError(t, err)
+	assert.Equal(t, &Output{Records: 2, Created: 1, Replaced: 1}, result)
+	value, _, _ = mockClient.GetString(ctx, "user:1")
+	assert.Equal(t, "alice", string(value))
+	ttl, _ := mockClient.GetTTL(ctx, "user:2")
+	assert.Equal(t, int64(-1), ttl)
+}
+
+func TestTool_Execute_File(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fixtures.jsonl"), []byte(records), 0o600))
+	tool := NewTool(client.NewMockClient(), dir)
+
+	result, err := tool.Execute(context.Background(), input(t, map[string]any{"path": "fixtures.jsonl"}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.(*Output).Created)
+}
+
+func TestTool_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), "")
+	ctx := context.Background()
+
+	_, err := tool.Execute(ctx, input(t, map[string]any{}))
+	assert.EqualError(t, err, "path or data is required")
+
+	_, err = tool.Execute(ctx, input(t, map[string]any{"data": records, "on_conflict": "merge"}))
+	assert.EqualError(t, err, "on_conflict must be skip, replace or fail")
+
+	_, err = tool.Execute(ctx, input(t, map[string]any{"data": "not json"}))
+	assert.ErrorContains(t, err, "invalid record 1")
+}
+
+func TestTool_PreviewAndAssess(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetString(ctx, "user:1", "old", nil, false, false)
+	tool := NewTool(mockClient, "").(*Tool)
+	replace := input(t, map[string]any{"data": records, "on_conflict": "replace"})
+
+	preview, err := tool.Preview(ctx, replace)
+	require.NoError(t, err)
+	assert.Equal(t, &base.Preview{Summary: "Create 1 keys; replace 1 existing keys", Overwrites: []string{"user:1"}}, preview)
+
+	impact, err := tool.Assess(ctx, replace)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user:1", "user:2"}, impact.Keys)
+	assert.Equal(t, []string{"user:1"}, impact.Overwrites)
+
+	impact, err = tool.Assess(ctx, input(t, map[string]any{"data": records}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user:2"}, impact.Keys)
+	assert.Empty(t, impact.Overwrites)
+
+	// Nothing was written.
+	value, _, _ := mockClient.GetString(ctx, "user:1")
+	assert.Equal(t, "old", string(value))
+}
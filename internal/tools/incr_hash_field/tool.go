@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -56,6 +57,35 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	return Output{Key: params.Key, Field: params.Field, NewValue: value}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Amount == 0 {
+		params.Amount = 1
+	}
+
+	raw, found, err := t.client.GetMapField(ctx, params.Key, params.Field)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get field %q of hash %q: %w", params.Field, params.Key, err)
+	}
+	change := base.Change{Target: params.Field}
+	var current int64
+	if found {
+		if current, err = strconv.ParseInt(string(raw), 10, 64); err != nil {
+			return nil, fmt.Errorf("field %q of hash %q is not an integer", params.Field, params.Key)
+		}
+		change.Current = current
+	}
+	change.New = current + params.Amount
+	return &base.Preview{
+		Summary: fmt.Sprintf("Increment field %q of hash %q by %d, from %d to %d", params.Field, params.Key, params.Amount, current, change.New),
+		Changes: []base.Change{change},
+	}, nil
+}
+
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
 }
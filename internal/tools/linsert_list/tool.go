@@ -0,0 +1,119 @@
+// Package linsert_list implements the linsert_list tool.
+package linsert_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the linsert_list functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for linsert_list tool.
+type Input struct {
+	Key      string `json:"key" jsonschema:"required,description=List key"`
+	Position string `json:"position" jsonschema:"required,description=Where to insert relative to the pivot: before or after"`
+	Pivot    string `json:"pivot" jsonschema:"required,description=Existing element to insert next to (its first occurrence)"`
+	Element  string `json:"element" jsonschema:"required,description=Element to insert"`
+}
+
+// Output represents the output of linsert_list tool.
+type Output struct {
+	Key        string `json:"key"`
+	Inserted   bool   `json:"inserted" jsonschema:"description=False when the list or the pivot does not exist"`
+	ListLength int64  `json:"list_length" jsonschema:"description=Length of the list after the insert"`
+}
+
+// NewTool creates a new linsert_list tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"linsert_list",
+			"Insert an element before or after the first occurrence of a pivot element in a list with LINSERT. Nothing is inserted when the list or the pivot does not exist",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, before, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := t.client.InsertList(ctx, params.Key, before, params.Pivot, params.Element)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert into list %q: %w", params.Key, err)
+	}
+
+	output := Output{Key: params.Key, Inserted: length > 0, ListLength: length}
+	if length < 0 {
+		// The pivot was not found, so the list is unchanged.
+		if output.ListLength, err = t.client.GetListLength(ctx, params.Key); err != nil {
+			return nil, fmt.Errorf("failed to get length of list %q: %w", params.Key, err)
+		}
+	}
+	return output, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, _, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := t.client.GetListPositions(ctx, params.Key, params.Pivot, client.ListPositionOptions{Count: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pivot in list %q: %w", params.Key, err)
+	}
+	if len(positions) == 0 {
+		return &base.Preview{
+			Summary: fmt.Sprintf("Pivot %q is not in list %q; nothing would be inserted", params.Pivot, params.Key),
+			Keys:    []*base.KeyState{state},
+		}, nil
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Insert %q %s %q at index %d of list %q, growing it from %d to %d elements",
+			params.Element, strings.ToLower(params.Position), params.Pivot, positions[0], params.Key, state.Size, state.Size+1),
+		Keys: []*base.KeyState{state},
+	}, nil
+}
+
+// parse validates input and reports whether the element goes before the
+// pivot.
+func (t *Tool) parse(input json.RawMessage) (Input, bool, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, false, err
+	}
+	if params.Key == "" {
+		return params, false, fmt.Errorf("key cannot be empty")
+	}
+	switch strings.ToLower(params.Position) {
+	case "before":
+		return params, true, nil
+	case "after":
+		return params, false, nil
+	}
+	return params, false, fmt.Errorf("invalid position %q: must be before or after", params.Position)
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,95 @@
+package linsert_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Success(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "steps", []string{"a", "c"}, true)
+	require.NoError(t, err)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"steps","position":"before","pivot":"c","element":"b"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "steps", Inserted: true, ListLength: 3}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"steps","position":"AFTER","pivot":"c","element":"d"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "steps", Inserted: true, ListLength: 4}, result)
+
+	values, err := mockClient.GetListRange(ctx, "steps", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d")}, values)
+}
+
+func TestTool_Execute_MissingPivot(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "steps", []string{"a"}, true)
+	require.NoError(t, err)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"steps","position":"after","pivot":"z","element":"b"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "steps", Inserted: false, ListLength: 1}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"none","position":"after","pivot":"z","element":"b"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "none", Inserted: false, ListLength: 0}, result)
+}
+
+func TestTool_Execute_InvalidInput(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	for _, input := range []string{
+		`{"key":"","position":"before","pivot":"a","element":"b"}`,
+		`{"key":"steps","position":"middle","pivot":"a","element":"b"}`,
+	} {
+		result, err := tool.Execute(ctx, json.RawMessage(input))
+		require.Error(t, err, input)
+		assert.Nil(t, result)
+	}
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "steps", []string{"a", "c"}, true)
+	require.NoError(t, err)
+
+	result, err := tool.Preview(ctx, json.RawMessage(`{"key":"steps","position":"before","pivot":"c","element":"b"}`))
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Insert "b" before "c" at index 1 of list "steps", growing it from 2 to 3 elements`, preview.Summary)
+
+	result, err = tool.Preview(ctx, json.RawMessage(`{"key":"steps","position":"before","pivot":"z","element":"b"}`))
+	require.NoError(t, err)
+	assert.Contains(t, result.(*base.Preview).Summary, "nothing would be inserted")
+
+	values, err := mockClient.GetListRange(ctx, "steps", 0, -1)
+	require.NoError(t, err)
+	assert.Len(t, values, 2)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	assert.Equal(t, "linsert_list", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
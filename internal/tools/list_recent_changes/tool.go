@@ -0,0 +1,69 @@
+// Package list_recent_changes implements the list_recent_changes tool.
+package list_recent_changes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/ItsJooL/valkey-mcp-server/internal/undo"
+)
+
+// defaultLimit is the number of changes listed when no limit is given.
+const defaultLimit = 20
+
+// Tool implements the list_recent_changes functionality.
+type Tool struct {
+	base.BaseTool
+	journal *undo.Journal
+}
+
+// Input represents the input for list_recent_changes tool.
+type Input struct {
+	Key   string `json:"key,omitempty" jsonschema:"description=Only list changes to this key"`
+	Limit int    `json:"limit,omitempty" jsonschema:"minimum=1,description=Maximum number of changes to list (default: 20)"`
+}
+
+// Output represents the output of list_recent_changes tool.
+type Output struct {
+	Changes []undo.Change `json:"changes"`
+	Count   int           `json:"count"`
+}
+
+// NewTool creates a new list_recent_changes tool.
+func NewTool(journal *undo.Journal) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"list_recent_changes",
+			"List recent changes made by write tools, newest first, with the state of each key before the change. Pass an id to undo_change to revert one",
+			Input{},
+		),
+		journal: journal,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = defaultLimit
+	}
+
+	changes := t.journal.List(params.Key, params.Limit)
+	if changes == nil {
+		changes = []undo.Change{}
+	}
+	return Output{
+		Changes: changes,
+		Count:   len(changes),
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, journal *undo.Journal) {
+	reg.MustRegister(NewTool(journal))
+}
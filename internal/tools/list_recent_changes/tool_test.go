@@ -0,0 +1,48 @@
+package list_recent_changes
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/undo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	journal := undo.New(client.NewMockClient(), undo.Config{})
+	for _, key := range []string{"a", "b", "a"} {
+		done, err := journal.Record(ctx, "set_string", json.RawMessage(`{"key":"`+key+`"}`))
+		require.NoError(t, err)
+		done(nil)
+	}
+	tool := NewTool(journal)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"a"}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, 2, output.Count)
+	assert.Equal(t, int64(3), output.Changes[0].ID)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"limit":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.(Output).Count)
+}
+
+func TestTool_Execute_Empty(t *testing.T) {
+	tool := NewTool(undo.New(client.NewMockClient(), undo.Config{}))
+
+	result, err := tool.Execute(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Changes: []undo.Change{}}, result)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(undo.New(client.NewMockClient(), undo.Config{}))
+
+	assert.Equal(t, "list_recent_changes", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -0,0 +1,128 @@
+// Package lmove_list implements the lmove_list tool.
+package lmove_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the lmove_list functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for lmove_list tool.
+type Input struct {
+	Source      string `json:"source" jsonschema:"required,description=List to take the element from"`
+	Destination string `json:"destination" jsonschema:"required,description=List to add the element to (may equal source to rotate it)"`
+	From        string `json:"from,omitempty" jsonschema:"description=End of source to take from: left or right (default: left)"`
+	To          string `json:"to,omitempty" jsonschema:"description=End of destination to add to: left or right (default: right)"`
+}
+
+// Output represents the output of lmove_list tool.
+type Output struct {
+	Element any  `json:"element,omitempty"`
+	Moved   bool `json:"moved" jsonschema:"description=False when source is empty"`
+}
+
+// NewTool creates a new lmove_list tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"lmove_list",
+			"Atomically move an element from one end of a list to an end of another with LMOVE; from right to left is RPOPLPUSH. Reports moved false when the source is empty; use blmove_list to wait for an element",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	value, moved, err := t.client.MoveList(ctx, params.Source, params.Destination, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move from list %q to list %q: %w", params.Source, params.Destination, err)
+	}
+
+	output := Output{Moved: moved}
+	if moved {
+		output.Element = base.SafeValue(value)
+	}
+	return output, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := base.DescribeKeys(ctx, t.client, []string{params.Source, params.Destination})
+	if err != nil {
+		return nil, err
+	}
+	index := int64(0)
+	if params.From == "RIGHT" {
+		index = -1
+	}
+	value, ok, err := t.client.GetListIndex(ctx, params.Source, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read list %q: %w", params.Source, err)
+	}
+	if !ok {
+		return &base.Preview{
+			Summary: fmt.Sprintf("List %q is empty; nothing would move to list %q", params.Source, params.Destination),
+			Keys:    keys,
+		}, nil
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Move the %s element of list %q to the %s of list %q",
+			strings.ToLower(params.From), params.Source, strings.ToLower(params.To), params.Destination),
+		Keys:    keys,
+		Changes: []base.Change{{Target: params.Destination, Current: nil, New: base.SafeValue(value)}},
+	}, nil
+}
+
+// parse validates input and normalizes the list ends to LEFT or RIGHT.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Source == "" {
+		return params, fmt.Errorf("source cannot be empty")
+	}
+	if params.Destination == "" {
+		return params, fmt.Errorf("destination cannot be empty")
+	}
+	if params.From == "" {
+		params.From = "left"
+	}
+	if params.To == "" {
+		params.To = "right"
+	}
+	params.From, params.To = strings.ToUpper(params.From), strings.ToUpper(params.To)
+	for _, end := range []string{params.From, params.To} {
+		if end != "LEFT" && end != "RIGHT" {
+			return params, fmt.Errorf("invalid list end %q: must be left or right", strings.ToLower(end))
+		}
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
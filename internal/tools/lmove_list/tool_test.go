@@ -0,0 +1,94 @@
+package lmove_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLMoveList_Execute_Moves(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a", "b"}, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	// RPOPLPUSH
+	result, err := tool.Execute(ctx, json.RawMessage(`{"source":"jobs","destination":"processing","from":"right","to":"left"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Element: "b", Moved: true}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"source":"jobs","destination":"processing"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Element: "a", Moved: true}, result)
+
+	processing, err := mockClient.GetListRange(ctx, "processing", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("a")}, processing)
+	exists, err := mockClient.ExistsKey(ctx, "jobs")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"source":"jobs","destination":"processing"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Moved: false}, result)
+}
+
+func TestLMoveList_Execute_Rotates(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "ring", []string{"a", "b", "c"}, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	_, err = tool.Execute(ctx, json.RawMessage(`{"source":"ring","destination":"ring"}`))
+	require.NoError(t, err)
+	ring, err := mockClient.GetListRange(ctx, "ring", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("b"), []byte("c"), []byte("a")}, ring)
+}
+
+func TestLMoveList_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	tests := map[string]string{
+		`{"destination":"b"}`: "source cannot be empty",
+		`{"source":"a"}`:      "destination cannot be empty",
+		`{"source":"a","destination":"b","from":"top"}`: `invalid list end "top": must be left or right`,
+		`{"source":"a","destination":"b","to":"up"}`:    `invalid list end "up": must be left or right`,
+	}
+	for input, want := range tests {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
+
+func TestLMoveList_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"source":"jobs","destination":"processing"}`))
+	require.NoError(t, err)
+	assert.Equal(t, `List "jobs" is empty; nothing would move to list "processing"`, preview.(*base.Preview).Summary)
+
+	_, err = mockClient.PushList(ctx, "jobs", []string{"a", "b"}, true)
+	require.NoError(t, err)
+	preview, err = tool.Preview(ctx, json.RawMessage(`{"source":"jobs","destination":"processing","from":"right","to":"left"}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Move the right element of list "jobs" to the left of list "processing"`, p.Summary)
+	assert.Equal(t, []base.Change{{Target: "processing", New: "b"}}, p.Changes)
+}
+
+func TestLMoveList_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "lmove_list", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
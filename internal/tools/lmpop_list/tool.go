@@ -0,0 +1,141 @@
+// Package lmpop_list implements the lmpop_list tool.
+package lmpop_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the lmpop_list functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for lmpop_list tool.
+type Input struct {
+	Keys  []string `json:"keys" jsonschema:"required,minItems=1,description=Lists to pop from in order; the first non-empty one is used"`
+	From  string   `json:"from,omitempty" jsonschema:"description=End to pop from: left or right (default: left)"`
+	Count int64    `json:"count,omitempty" jsonschema:"description=Number of elements to pop (default: 1)"`
+}
+
+// Output represents the output of lmpop_list tool.
+type Output struct {
+	Key      string `json:"key,omitempty" jsonschema:"description=List the elements were popped from; absent when every list is empty"`
+	Elements []any  `json:"elements"`
+	Count    int    `json:"count"`
+}
+
+// NewTool creates a new lmpop_list tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"lmpop_list",
+			"Pop up to count elements from the left or right end of the first non-empty list of several with LMPOP. Returns no key when every list is empty",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pop, err := t.client.MultiPopList(ctx, params.Keys, params.From == "right", params.Count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pop from lists %q: %w", params.Keys, err)
+	}
+	if pop == nil {
+		return Output{Elements: []any{}}, nil
+	}
+
+	return Output{
+		Key:      pop.Key,
+		Elements: base.SafeSlice(pop.Values),
+		Count:    len(pop.Values),
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range params.Keys {
+		length, err := t.client.GetListLength(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get length of list %q: %w", key, err)
+		}
+		if length == 0 {
+			continue
+		}
+
+		popped := min(params.Count, length)
+		listed := min(popped, int64(base.MaxPreviewElements))
+		start, stop := int64(0), listed-1
+		if params.From == "right" {
+			start, stop = -listed, -1
+		}
+		elements, err := t.client.GetListRange(ctx, key, start, stop)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read list %q: %w", key, err)
+		}
+		if params.From == "right" {
+			// LMPOP RIGHT returns the last element first.
+			slices.Reverse(elements)
+		}
+		return &base.Preview{
+			Summary:   fmt.Sprintf("Pop %d of %d elements from the %s of list %q", popped, length, params.From, key),
+			Removed:   base.SafeSlice(elements),
+			Truncated: popped > int64(len(elements)),
+		}, nil
+	}
+	return &base.Preview{Summary: fmt.Sprintf("All %d lists are empty; nothing would be popped", len(params.Keys))}, nil
+}
+
+// parse validates input and normalizes from to left or right.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if len(params.Keys) == 0 {
+		return params, fmt.Errorf("at least one key must be provided")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return params, fmt.Errorf("key cannot be empty")
+		}
+	}
+	if params.From == "" {
+		params.From = "left"
+	}
+	params.From = strings.ToLower(params.From)
+	if params.From != "left" && params.From != "right" {
+		return params, fmt.Errorf("invalid list end %q: must be left or right", params.From)
+	}
+	if params.Count < 0 {
+		return params, fmt.Errorf("count cannot be negative")
+	}
+	if params.Count == 0 {
+		params.Count = 1
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
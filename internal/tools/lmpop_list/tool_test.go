@@ -0,0 +1,76 @@
+package lmpop_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLMPopList_Execute_FirstNonEmpty(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "low", []string{"l1", "l2", "l3"}, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"keys":["high","low"],"from":"right","count":2}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "low", Elements: []any{"l3", "l2"}, Count: 2}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"keys":["high","low"],"count":5}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "low", Elements: []any{"l1"}, Count: 1}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"keys":["high","low"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Elements: []any{}}, result)
+}
+
+func TestLMPopList_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	tests := map[string]string{
+		`{"keys":[]}`:                    "at least one key must be provided",
+		`{"keys":["a",""]}`:              "key cannot be empty",
+		`{"keys":["a"],"from":"middle"}`: `invalid list end "middle": must be left or right`,
+		`{"keys":["a"],"count":-1}`:      "count cannot be negative",
+	}
+	for input, want := range tests {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
+
+func TestLMPopList_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"keys":["high","low"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "All 2 lists are empty; nothing would be popped", preview.(*base.Preview).Summary)
+
+	_, err = mockClient.PushList(ctx, "low", []string{"l1", "l2", "l3"}, true)
+	require.NoError(t, err)
+	preview, err = tool.Preview(ctx, json.RawMessage(`{"keys":["high","low"],"from":"RIGHT","count":2}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Pop 2 of 3 elements from the right of list "low"`, p.Summary)
+	assert.Equal(t, []any{"l3", "l2"}, p.Removed)
+
+	length, err := mockClient.GetListLength(ctx, "low")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), length)
+}
+
+func TestLMPopList_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "lmpop_list", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -1,10 +1,24 @@
 package tools
 
 import (
+	"time"
+
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/connections"
+	"github.com/ItsJooL/valkey-mcp-server/internal/notifications"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/add_set"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/analyze_keyspace"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/analyze_rdb"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/append_string"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/bitcount_bitmap"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/bitfield_bitmap"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/bitfield_ro_bitmap"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/bitop_bitmap"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/bitpos_bitmap"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/blmove_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/blpop_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/bzpopmin_zset"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/client_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/cluster_count_keysinslot"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/cluster_info"
@@ -12,15 +26,27 @@ import (
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/cluster_nodes"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/config_get"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/config_set"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/copy_keys"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/dbsize"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/decr_string"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/delete_hash_field"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/delete_keys"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/delete_keys_by_pattern"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/dump_key"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/eval_script"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/evalsha_script"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/exists_key"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/expire_key"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/expire_keys_by_pattern"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/export_keys"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/find_big_keys"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/find_hot_keys"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/geoadd_geo"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/geodist_geo"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/geohash_geo"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/geopos_geo"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/geosearch_geo"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/geosearchstore_geo"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/get_hash"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/get_hash_field"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/get_hash_fields"
@@ -33,16 +59,25 @@ import (
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/get_set_members"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/get_string"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/get_string_range"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/getbit_bitmap"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/hash_field_exists"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/hkeys_hash"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/hlen_hash"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/hvals_hash"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/import_keys"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/incr_hash_field"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/incr_string"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/keys_by_pattern"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/linsert_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/list_recent_changes"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lmove_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lmpop_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lpop_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lpos_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lpush_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lpushx_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lrange_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lrem_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/lset_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/ltrim_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/memory_usage"
@@ -50,12 +85,23 @@ import (
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/object_encoding"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/object_idletime"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/persist_key"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pfadd_hll"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pfcount_hll"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pfmerge_hll"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pop_set_member"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/publish"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pubsub_channels"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pubsub_numpat"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/pubsub_numsub"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/rdb_get_key"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/remove_set_member"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/rename_key"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/rename_keys_by_prefix"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/render_bitmap"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/restore_key"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/rpop_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/rpush_list"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/rpushx_list"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/scan_keys"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/script_load"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/sdiff_sets"
@@ -64,15 +110,38 @@ import (
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/set_hash"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/set_is_member"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/set_string"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/setbit_bitmap"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/sinter_sets"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/slowlog_get"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/stream_health"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/string_length"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/subscribe_capture"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/sunion_sets"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/touch_keys"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/undo_change"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/watch_keyspace"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xack_stream"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xadd_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xautoclaim_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xclaim_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xdel_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xgroup_create_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xgroup_createconsumer_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xgroup_delconsumer_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xgroup_destroy_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xgroup_setid_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xinfo_consumers"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xinfo_groups"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xinfo_stream"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xlen_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xpending_stream"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xrange_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xread_block_stream"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xread_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xreadgroup_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xrevrange_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/xtrim_stream"
+	"github.com/ItsJooL/valkey-mcp-server/internal/undo"
 )
 
 // RegisterAll registers all available tools with the registry.
@@ -85,6 +154,9 @@ func RegisterAll(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	get_key_type.Init(reg, client)
 	get_key_ttl.Init(reg, client)
 	delete_keys.Init(reg, client)
+	delete_keys_by_pattern.Init(reg, client)
+	expire_keys_by_pattern.Init(reg, client)
+	rename_keys_by_prefix.Init(reg, client)
 
 	get_string.Init(reg, client)
 	set_string.Init(reg, client)
@@ -113,6 +185,13 @@ func RegisterAll(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	rpop_list.Init(reg, client)
 	lset_list.Init(reg, client)
 	ltrim_list.Init(reg, client)
+	lpos_list.Init(reg, client)
+	linsert_list.Init(reg, client)
+	lrem_list.Init(reg, client)
+	lmove_list.Init(reg, client)
+	lmpop_list.Init(reg, client)
+	lpushx_list.Init(reg, client)
+	rpushx_list.Init(reg, client)
 
 	remove_set_member.Init(reg, client)
 	delete_hash_field.Init(reg, client)
@@ -131,6 +210,9 @@ func RegisterAll(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	keys_by_pattern.Init(reg, client)
 	exists_key.Init(reg, client)
 	memory_usage.Init(reg, client)
+	analyze_keyspace.Init(reg, client)
+	find_big_keys.Init(reg, client)
+	find_hot_keys.Init(reg, client)
 	touch_keys.Init(reg, client)
 	object_encoding.Init(reg, client)
 	object_idletime.Init(reg, client)
@@ -143,8 +225,50 @@ func RegisterAll(reg *registry.ToolRegistry, client client.ValkeyClient) {
 
 	xadd_stream.Init(reg, client)
 	xrange_stream.Init(reg, client)
+	xrevrange_stream.Init(reg, client)
 	xlen_stream.Init(reg, client)
+	xdel_stream.Init(reg, client)
+	xtrim_stream.Init(reg, client)
 	xread_stream.Init(reg, client)
+	xgroup_create_stream.Init(reg, client)
+	xgroup_destroy_stream.Init(reg, client)
+	xgroup_setid_stream.Init(reg, client)
+	xgroup_createconsumer_stream.Init(reg, client)
+	xgroup_delconsumer_stream.Init(reg, client)
+	xreadgroup_stream.Init(reg, client)
+	xack_stream.Init(reg, client)
+	xpending_stream.Init(reg, client)
+	xclaim_stream.Init(reg, client)
+	xautoclaim_stream.Init(reg, client)
+	xinfo_stream.Init(reg, client)
+	xinfo_groups.Init(reg, client)
+	xinfo_consumers.Init(reg, client)
+	stream_health.Init(reg, client)
+
+	setbit_bitmap.Init(reg, client)
+	getbit_bitmap.Init(reg, client)
+	bitcount_bitmap.Init(reg, client)
+	bitpos_bitmap.Init(reg, client)
+	bitop_bitmap.Init(reg, client)
+	bitfield_bitmap.Init(reg, client)
+	bitfield_ro_bitmap.Init(reg, client)
+	render_bitmap.Init(reg, client)
+
+	pfadd_hll.Init(reg, client)
+	pfcount_hll.Init(reg, client)
+	pfmerge_hll.Init(reg, client)
+
+	geoadd_geo.Init(reg, client)
+	geopos_geo.Init(reg, client)
+	geodist_geo.Init(reg, client)
+	geohash_geo.Init(reg, client)
+	geosearch_geo.Init(reg, client)
+	geosearchstore_geo.Init(reg, client)
+
+	publish.Init(reg, client)
+	pubsub_channels.Init(reg, client)
+	pubsub_numsub.Init(reg, client)
+	pubsub_numpat.Init(reg, client)
 
 	dump_key.Init(reg, client)
 	restore_key.Init(reg, client)
@@ -164,3 +288,45 @@ func RegisterAll(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	script_load.Init(reg, client)
 	evalsha_script.Init(reg, client)
 }
+
+// RegisterUndo records changes made by write tools in journal and registers
+// the tools that list and revert them.
+func RegisterUndo(reg *registry.ToolRegistry, client client.ValkeyClient, journal *undo.Journal) {
+	reg.SetJournal(journal)
+	list_recent_changes.Init(reg, journal)
+	undo_change.Init(reg, client, journal)
+}
+
+// RegisterExport registers the tools that export keys to JSON Lines and
+// import them back. Files are read and written inside dir; with an empty dir
+// the records are only passed inline.
+func RegisterExport(reg *registry.ToolRegistry, client client.ValkeyClient, dir string) {
+	export_keys.Init(reg, client, dir)
+	import_keys.Init(reg, client, dir)
+}
+
+// RegisterCopy registers the tools that copy keys between the connections
+// of manager.
+func RegisterCopy(reg *registry.ToolRegistry, manager *connections.Manager) {
+	copy_keys.Init(reg, manager)
+}
+
+// RegisterBlocking registers the tools that wait for list, sorted set and
+// stream data, Pub/Sub messages or keyspace events, each waiting at most
+// maxTimeout. Keyspace watches share leases with the other users of keyspace
+// notifications.
+func RegisterBlocking(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration, leases *notifications.Leases) {
+	xread_block_stream.Init(reg, client, maxTimeout)
+	blpop_list.Init(reg, client, maxTimeout)
+	blmove_list.Init(reg, client, maxTimeout)
+	bzpopmin_zset.Init(reg, client, maxTimeout)
+	subscribe_capture.Init(reg, client, maxTimeout)
+	watch_keyspace.Init(reg, client, maxTimeout, leases)
+}
+
+// RegisterRDB registers the tools that read RDB files inside dir without a
+// server.
+func RegisterRDB(reg *registry.ToolRegistry, dir string) {
+	analyze_rdb.Init(reg, dir)
+	rdb_get_key.Init(reg, dir)
+}
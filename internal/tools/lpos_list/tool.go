@@ -0,0 +1,85 @@
+// Package lpos_list implements the lpos_list tool.
+package lpos_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the lpos_list functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for lpos_list tool.
+type Input struct {
+	Key     string `json:"key" jsonschema:"required,description=List key"`
+	Element string `json:"element" jsonschema:"required,description=Element to look for"`
+	Rank    int64  `json:"rank,omitempty" jsonschema:"description=Start from the nth match; negative searches from the tail (default: 1)"`
+	Count   *int64 `json:"count,omitempty" jsonschema:"minimum=0,description=Maximum matches to return; 0 returns all (default: 1)"`
+	MaxLen  int64  `json:"max_len,omitempty" jsonschema:"minimum=0,description=Compare at most this many elements (default: the whole list)"`
+}
+
+// Output represents the output of lpos_list tool.
+type Output struct {
+	Key       string  `json:"key"`
+	Element   string  `json:"element"`
+	Positions []int64 `json:"positions" jsonschema:"description=Indexes of the matches from the head"`
+	Found     bool    `json:"found"`
+}
+
+// NewTool creates a new lpos_list tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"lpos_list",
+			"Find the indexes of an element in a list with LPOS. rank skips earlier matches or searches from the tail when negative; count returns several matches; max_len bounds the scan of long lists",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	opts := client.ListPositionOptions{Rank: params.Rank, Count: 1, MaxLen: params.MaxLen}
+	if params.Count != nil {
+		opts.Count = *params.Count
+	}
+	if opts.Count < 0 {
+		return nil, fmt.Errorf("count cannot be negative")
+	}
+	if opts.MaxLen < 0 {
+		return nil, fmt.Errorf("max_len cannot be negative")
+	}
+
+	positions, err := t.client.GetListPositions(ctx, params.Key, params.Element, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find element in list %q: %w", params.Key, err)
+	}
+
+	return Output{
+		Key:       params.Key,
+		Element:   params.Element,
+		Positions: positions,
+		Found:     len(positions) > 0,
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,68 @@
+package lpos_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Success(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a", "b", "c", "1", "2", "3", "c", "c"}, true)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input string
+		want  []int64
+	}{
+		{"first match", `{"key":"jobs","element":"c"}`, []int64{2}},
+		{"second match", `{"key":"jobs","element":"c","rank":2}`, []int64{6}},
+		{"from the tail", `{"key":"jobs","element":"c","rank":-1}`, []int64{7}},
+		{"all matches", `{"key":"jobs","element":"c","count":0}`, []int64{2, 6, 7}},
+		{"two from the tail", `{"key":"jobs","element":"c","rank":-1,"count":2}`, []int64{7, 6}},
+		{"bounded scan", `{"key":"jobs","element":"c","count":0,"max_len":7}`, []int64{2, 6}},
+		{"missing element", `{"key":"jobs","element":"z"}`, []int64{}},
+		{"missing key", `{"key":"none","element":"c"}`, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, json.RawMessage(tt.input))
+			require.NoError(t, err)
+			output := result.(Output)
+			assert.Equal(t, tt.want, output.Positions)
+			assert.Equal(t, len(tt.want) > 0, output.Found)
+		})
+	}
+}
+
+func TestTool_Execute_InvalidInput(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	for _, input := range []string{
+		`{"key":"","element":"a"}`,
+		`{"key":"jobs","element":"a","count":-1}`,
+		`{"key":"jobs","element":"a","max_len":-1}`,
+	} {
+		result, err := tool.Execute(ctx, json.RawMessage(input))
+		require.Error(t, err, input)
+		assert.Nil(t, result)
+	}
+}
+
+func TestTool_Metadata(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	assert.Equal(t, "lpos_list", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
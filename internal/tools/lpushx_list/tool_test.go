@@ -0,0 +1,82 @@
+package lpushx_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Success(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a"}, true)
+	require.NoError(t, err)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"jobs","values":["b","c"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "jobs", Pushed: true, ListLength: 3, Values: []string{"b", "c"}}, result)
+
+	values, err := mockClient.GetListRange(ctx, "jobs", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("c"), []byte("b"), []byte("a")}, values)
+}
+
+func TestTool_Execute_MissingList(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"jobs","values":["a"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "jobs", Pushed: false, ListLength: 0, Values: []string{"a"}}, result)
+
+	exists, err := mockClient.ExistsKey(ctx, "jobs")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestTool_Execute_InvalidInput(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	for _, input := range []string{
+		`{"key":"","values":["a"]}`,
+		`{"key":"jobs","values":[]}`,
+	} {
+		result, err := tool.Execute(ctx, json.RawMessage(input))
+		require.Error(t, err, input)
+		assert.Nil(t, result)
+	}
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+	ctx := context.Background()
+
+	result, err := tool.Preview(ctx, json.RawMessage(`{"key":"jobs","values":["a"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, `List "jobs" does not exist; nothing would be pushed`, result.(*base.Preview).Summary)
+
+	_, err = mockClient.PushList(ctx, "jobs", []string{"a"}, true)
+	require.NoError(t, err)
+	result, err = tool.Preview(ctx, json.RawMessage(`{"key":"jobs","values":["b","c"]}`))
+	require.NoError(t, err)
+	assert.Contains(t, result.(*base.Preview).Summary, "growing it from 1 to 3 elements")
+}
+
+func TestTool_Metadata(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	assert.Equal(t, "lpushx_list", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
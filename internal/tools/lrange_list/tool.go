@@ -11,6 +11,13 @@ import (
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 )
 
+const (
+	defaultPageSize = 100
+	// maxPageSize caps the elements returned per call so that long lists
+	// are read in pages.
+	maxPageSize = 1000
+)
+
 // Tool implements the lrange_list functionality.
 type Tool struct {
 	base.BaseTool
@@ -20,8 +27,9 @@ type Tool struct {
 // Input represents the input for lrange_list tool.
 type Input struct {
 	Key   string `json:"key" jsonschema:"required,description=List key"`
-	Start int64  `json:"start" jsonschema:"required,description=Start index (0-based, negative for from-end)"`
-	Stop  int64  `json:"stop" jsonschema:"required,description=Stop index (inclusive, negative for from-end)"`
+	Start int64  `json:"start,omitempty" jsonschema:"description=Start index (0-based; negative counts from the end; default: 0)"`
+	Stop  *int64 `json:"stop,omitempty" jsonschema:"description=Stop index (inclusive; negative counts from the end; default: -1)"`
+	Count int64  `json:"count,omitempty" jsonschema:"description=Maximum elements to return in this page (default: 100; capped at 1000)"`
 }
 
 // Output represents the output of lrange_list tool.
@@ -29,6 +37,9 @@ type Output struct {
 	Key    string `json:"key"`
 	Values []any  `json:"values"`
 	Count  int    `json:"count"`
+	Length int64  `json:"length" jsonschema:"description=Length of the list"`
+	// NextStart is the start of the next page when more of the range remains.
+	NextStart *int64 `json:"next_start,omitempty"`
 }
 
 // NewTool creates a new lrange_list tool.
@@ -36,7 +47,7 @@ func NewTool(client client.ValkeyClient) registry.Tool {
 	return &Tool{
 		BaseTool: base.NewBaseTool(
 			"lrange_list",
-			"Get a range of elements from a list",
+			"Get a range of elements from a list one page at a time. Pass next_start as start with the same stop to get the next page until it is absent",
 			Input{},
 		),
 		client: client,
@@ -52,17 +63,60 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	if params.Key == "" {
 		return nil, fmt.Errorf("key cannot be empty")
 	}
+	switch {
+	case params.Count < 0:
+		return nil, fmt.Errorf("count cannot be negative")
+	case params.Count == 0:
+		params.Count = defaultPageSize
+	case params.Count > maxPageSize:
+		params.Count = maxPageSize
+	}
+	stop := int64(-1)
+	if params.Stop != nil {
+		stop = *params.Stop
+	}
+
+	length, err := t.client.GetListLength(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get length of list %q: %w", params.Key, err)
+	}
+	first, last := resolveRange(length, params.Start, stop)
+	output := Output{Key: params.Key, Values: []any{}, Length: length}
+	if first > last {
+		return output, nil
+	}
 
-	raw, err := t.client.GetListRange(ctx, params.Key, params.Start, params.Stop)
+	pageEnd := min(last, first+params.Count-1)
+	raw, err := t.client.GetListRange(ctx, params.Key, first, pageEnd)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get list range for key %q: %w", params.Key, err)
 	}
+	output.Values = base.SafeSlice(raw)
+	output.Count = len(raw)
+	if pageEnd < last && int64(len(raw)) == pageEnd-first+1 {
+		next := pageEnd + 1
+		output.NextStart = &next
+	}
+	return output, nil
+}
 
-	return Output{
-		Key:    params.Key,
-		Values: base.SafeSlice(raw),
-		Count:  len(raw),
-	}, nil
+// resolveRange returns the absolute indexes of the first and last elements
+// LRANGE start stop returns from a list of the given length. first is
+// greater than last when the range is empty.
+func resolveRange(length, start, stop int64) (int64, int64) {
+	if start < 0 {
+		start += length
+	}
+	if stop < 0 {
+		stop += length
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+	return start, stop
 }
 
 // Init registers the tool with the registry.
@@ -3,6 +3,7 @@ package lrange_list
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
@@ -50,3 +51,72 @@ func TestTool_Metadata(t *testing.T) {
 	assert.Equal(t, "lrange_list", tool.Name())
 	assert.NotEmpty(t, tool.Description())
 }
+
+func TestTool_Execute_Pages(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	values := make([]string, 2500)
+	for i := range values {
+		values[i] = fmt.Sprintf("job-%d", i)
+	}
+	_, err := mockClient.PushList(ctx, "queue", values, true)
+	require.NoError(t, err)
+
+	// Oversized pages are capped at maxPageSize.
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"queue","count":5000}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, maxPageSize, output.Count)
+	assert.Equal(t, int64(2500), output.Length)
+	assert.Equal(t, "job-0", output.Values[0])
+	require.NotNil(t, output.NextStart)
+	assert.Equal(t, int64(1000), *output.NextStart)
+
+	var seen int
+	start := int64(0)
+	for {
+		result, err := tool.Execute(ctx, json.RawMessage(fmt.Sprintf(`{"key":"queue","start":%d}`, start)))
+		require.NoError(t, err)
+		output := result.(Output)
+		assert.Equal(t, fmt.Sprintf("job-%d", seen), output.Values[0])
+		seen += output.Count
+		if output.NextStart == nil {
+			break
+		}
+		start = *output.NextStart
+	}
+	assert.Equal(t, 2500, seen)
+}
+
+func TestTool_Execute_StopBoundsPages(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "queue", []string{"a", "b", "c", "d", "e"}, true)
+	require.NoError(t, err)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"queue","start":1,"stop":-2,"count":2}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, []any{"b", "c"}, output.Values)
+	require.NotNil(t, output.NextStart)
+	assert.Equal(t, int64(3), *output.NextStart)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"queue","start":3,"stop":-2,"count":2}`))
+	require.NoError(t, err)
+	output = result.(Output)
+	assert.Equal(t, []any{"d"}, output.Values)
+	assert.Nil(t, output.NextStart)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"missing"}`))
+	require.NoError(t, err)
+	output = result.(Output)
+	assert.Empty(t, output.Values)
+	assert.Nil(t, output.NextStart)
+
+	_, err = tool.Execute(ctx, json.RawMessage(`{"key":"queue","count":-1}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+// Package lrem_list implements the lrem_list tool.
+package lrem_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the lrem_list functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for lrem_list tool.
+type Input struct {
+	Key     string `json:"key" jsonschema:"required,description=List key"`
+	Element string `json:"element" jsonschema:"required,description=Element to remove"`
+	Count   int64  `json:"count,omitempty" jsonschema:"description=Occurrences to remove: the first n from the head when positive; the last n from the tail when negative; all when 0 (default: 0)"`
+}
+
+// Output represents the output of lrem_list tool.
+type Output struct {
+	Key     string `json:"key"`
+	Element string `json:"element"`
+	Removed int64  `json:"removed" jsonschema:"description=Number of elements removed"`
+}
+
+// NewTool creates a new lrem_list tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"lrem_list",
+			"Remove occurrences of an element from a list with LREM. count limits the removals to the first or last n occurrences; 0 removes them all",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := t.client.RemoveListElements(ctx, params.Key, params.Count, params.Element)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove from list %q: %w", params.Key, err)
+	}
+
+	return Output{
+		Key:     params.Key,
+		Element: params.Element,
+		Removed: removed,
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	opts := client.ListPositionOptions{Rank: 1, Count: params.Count}
+	if params.Count < 0 {
+		opts = client.ListPositionOptions{Rank: -1, Count: -params.Count}
+	}
+	positions, err := t.client.GetListPositions(ctx, params.Key, params.Element, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find element in list %q: %w", params.Key, err)
+	}
+
+	listed := positions
+	if len(listed) > base.MaxPreviewElements {
+		listed = listed[:base.MaxPreviewElements]
+	}
+	removed := make([]any, len(listed))
+	for i := range listed {
+		removed[i] = params.Element
+	}
+	return &base.Preview{
+		Summary:   fmt.Sprintf("Remove %d occurrences of %q from list %q, shrinking it from %d to %d elements", len(positions), params.Element, params.Key, state.Size, state.Size-int64(len(positions))),
+		Keys:      []*base.KeyState{state},
+		Removed:   removed,
+		Truncated: len(positions) > len(listed),
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Key == "" {
+		return params, fmt.Errorf("key cannot be empty")
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,99 @@
+package lrem_list
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Success(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int64
+		removed int64
+		want    []string
+	}{
+		{"all", 0, 3, []string{"b", "c"}},
+		{"from the head", 2, 2, []string{"b", "c", "a"}},
+		{"from the tail", -1, 1, []string{"a", "b", "a", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := client.NewMockClient()
+			tool := NewTool(mockClient)
+			ctx := context.Background()
+
+			_, err := mockClient.PushList(ctx, "jobs", []string{"a", "b", "a", "c", "a"}, true)
+			require.NoError(t, err)
+
+			input, _ := json.Marshal(map[string]any{"key": "jobs", "element": "a", "count": tt.count})
+			result, err := tool.Execute(ctx, input)
+			require.NoError(t, err)
+			assert.Equal(t, Output{Key: "jobs", Element: "a", Removed: tt.removed}, result)
+
+			values, err := mockClient.GetListRange(ctx, "jobs", 0, -1)
+			require.NoError(t, err)
+			got := make([]string, len(values))
+			for i, v := range values {
+				got[i] = string(v)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTool_Execute_RemovesEmptyList(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a", "a"}, true)
+	require.NoError(t, err)
+
+	_, err = tool.Execute(ctx, json.RawMessage(`{"key":"jobs","element":"a"}`))
+	require.NoError(t, err)
+	exists, err := mockClient.ExistsKey(ctx, "jobs")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestTool_Execute_EmptyKey(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","element":"a"}`))
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+	ctx := context.Background()
+
+	_, err := mockClient.PushList(ctx, "jobs", []string{"a", "b", "a", "c", "a"}, true)
+	require.NoError(t, err)
+
+	result, err := tool.Preview(ctx, json.RawMessage(`{"key":"jobs","element":"a","count":-2}`))
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Remove 2 occurrences of "a" from list "jobs", shrinking it from 5 to 3 elements`, preview.Summary)
+	assert.Equal(t, []any{"a", "a"}, preview.Removed)
+
+	length, err := mockClient.GetListLength(ctx, "jobs")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), length)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	assert.Equal(t, "lrem_list", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -70,6 +71,31 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	current, found, err := t.client.GetListIndex(ctx, params.Key, params.Index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index %d of list %q: %w", params.Index, params.Key, err)
+	}
+	if !found {
+		return &base.Preview{
+			Summary: fmt.Sprintf("Index %d is out of range for list %q; the call would fail", params.Index, params.Key),
+		}, nil
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Replace element %d of list %q", params.Index, params.Key),
+		Changes: []base.Change{{Target: strconv.FormatInt(params.Index, 10), Current: base.SafeValue(current), New: params.Value}},
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -67,6 +67,98 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	length, err := t.client.GetListLength(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get length of list %q: %w", params.Key, err)
+	}
+	kept := keptElements(length, params.Start, params.Stop)
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Trim list %q to range [%d, %d]: keeps %d of %d elements and drops %d", params.Key, params.Start, params.Stop, kept, length, length-kept),
+		Keys:    []string{params.Key},
+	}, nil
+}
+
+// keptElements returns how many elements of a list of the given length
+// LTRIM start stop keeps, resolving negative indexes like the server does.
+func keptElements(length, start, stop int64) int64 {
+	first, last := keptRange(length, start, stop)
+	if first > last {
+		return 0
+	}
+	return last - first + 1
+}
+
+// keptRange returns the absolute indexes of the first and last elements
+// LTRIM keeps. first is greater than last when it keeps none.
+func keptRange(length, start, stop int64) (int64, int64) {
+	if start < 0 {
+		start += length
+	}
+	if stop < 0 {
+		stop += length
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+	return start, stop
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	length, err := t.client.GetListLength(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get length of list %q: %w", params.Key, err)
+	}
+	first, last := keptRange(length, params.Start, params.Stop)
+	if first > last {
+		first, last = length, length-1
+	}
+
+	// Dropped elements are those before first and after last.
+	var dropped [][]byte
+	budget := int64(base.MaxPreviewElements)
+	if head := min(first, budget); head > 0 {
+		elements, err := t.client.GetListRange(ctx, params.Key, 0, head-1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read list %q: %w", params.Key, err)
+		}
+		dropped = append(dropped, elements...)
+		budget -= int64(len(elements))
+	}
+	if tail := min(length-1-last, budget); tail > 0 {
+		elements, err := t.client.GetListRange(ctx, params.Key, last+1, last+tail)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read list %q: %w", params.Key, err)
+		}
+		dropped = append(dropped, elements...)
+	}
+
+	kept := last - first + 1
+	return &base.Preview{
+		Summary:   fmt.Sprintf("Trim list %q to range [%d, %d]: keeps %d of %d elements and drops %d", params.Key, params.Start, params.Stop, kept, length, length-kept),
+		Removed:   base.SafeSlice(dropped),
+		Truncated: length-kept > int64(len(dropped)),
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
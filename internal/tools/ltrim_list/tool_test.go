@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -50,3 +51,46 @@ func TestTool_Metadata(t *testing.T) {
 	assert.Equal(t, "ltrim_list", tool.Name())
 	assert.NotEmpty(t, tool.Description())
 }
+
+func TestTool_Assess(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawListBytes("queue", [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")})
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "queue", "start": 0, "stop": 1})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue"}, impact.Keys)
+	assert.Contains(t, impact.Summary, "keeps 2 of 5 elements and drops 3")
+}
+
+func TestKeptElements(t *testing.T) {
+	assert.Equal(t, int64(2), keptElements(5, 0, 1))
+	assert.Equal(t, int64(5), keptElements(5, 0, -1))
+	assert.Equal(t, int64(2), keptElements(5, -2, -1))
+	assert.Equal(t, int64(5), keptElements(5, -10, 100))
+	assert.Equal(t, int64(0), keptElements(5, 3, 1))
+	assert.Equal(t, int64(0), keptElements(0, 0, -1))
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawListBytes("queue", [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")})
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "queue", "start": 1, "stop": -2})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, []any{"a", "e"}, preview.Removed)
+	assert.False(t, preview.Truncated)
+
+	// A range that keeps nothing drops every element.
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "queue", "start": 3, "stop": 1})
+	result, err = tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", "b", "c", "d", "e"}, result.(*base.Preview).Removed)
+
+	length, _ := mockClient.GetListLength(context.Background(), "queue")
+	assert.Equal(t, int64(5), length)
+}
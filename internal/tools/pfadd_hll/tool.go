@@ -0,0 +1,91 @@
+// Package pfadd_hll implements the pfadd_hll tool.
+package pfadd_hll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the pfadd_hll functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for pfadd_hll tool.
+type Input struct {
+	Key      string   `json:"key" jsonschema:"required,description=HyperLogLog key"`
+	Elements []string `json:"elements" jsonschema:"required,description=Elements to add; an empty list only creates the key"`
+}
+
+// Output represents the output of pfadd_hll tool.
+type Output struct {
+	Key     string `json:"key"`
+	Added   int    `json:"added" jsonschema:"description=Number of elements sent"`
+	Changed bool   `json:"changed" jsonschema:"description=Whether the estimated cardinality may have changed"`
+}
+
+// NewTool creates a new pfadd_hll tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"pfadd_hll",
+			"Add elements to a HyperLogLog with PFADD; creating it if needed. Reports whether the estimate changed; use pfcount_hll to read it",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := t.client.PFAdd(ctx, params.Key, params.Elements)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add to HyperLogLog %q: %w", params.Key, err)
+	}
+	return Output{Key: params.Key, Added: len(params.Elements), Changed: changed}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("Add %d elements to HyperLogLog %q", len(params.Elements), params.Key)
+	if !state.Exists() {
+		summary += ", creating it"
+	}
+	return &base.Preview{Summary: summary, Keys: []*base.KeyState{state}}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Key == "" {
+		return params, fmt.Errorf("key cannot be empty")
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,62 @@
+package pfadd_hll
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPFAddHLL_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"visitors","elements":["alice","bob","alice"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "visitors", Added: 3, Changed: true}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"visitors","elements":["bob"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "visitors", Added: 1, Changed: false}, result)
+
+	count, err := mockClient.PFCount(ctx, []string{"visitors"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestPFAddHLL_Execute_NotHyperLogLog(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "visitors", "plain", nil, false, false)
+	require.NoError(t, err)
+
+	_, err = NewTool(mockClient).Execute(ctx, json.RawMessage(`{"key":"visitors","elements":["alice"]}`))
+	assert.ErrorContains(t, err, "not a valid HyperLogLog")
+}
+
+func TestPFAddHLL_Execute_EmptyKey(t *testing.T) {
+	_, err := NewTool(client.NewMockClient()).Execute(context.Background(), json.RawMessage(`{"key":"","elements":["a"]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+}
+
+func TestPFAddHLL_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient).(*Tool)
+	input := json.RawMessage(`{"key":"visitors","elements":["alice","bob"]}`)
+
+	preview, err := tool.Preview(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, `Add 2 elements to HyperLogLog "visitors", creating it`, preview.(*base.Preview).Summary)
+
+	_, err = tool.Execute(ctx, input)
+	require.NoError(t, err)
+	preview, err = tool.Preview(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, `Add 2 elements to HyperLogLog "visitors"`, preview.(*base.Preview).Summary)
+}
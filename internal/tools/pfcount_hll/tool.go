@@ -0,0 +1,67 @@
+// Package pfcount_hll implements the pfcount_hll tool.
+package pfcount_hll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the pfcount_hll functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for pfcount_hll tool.
+type Input struct {
+	Keys []string `json:"keys" jsonschema:"required,description=HyperLogLog keys; several keys count their union without changing them. In a cluster they must share a hash slot"`
+}
+
+// Output represents the output of pfcount_hll tool.
+type Output struct {
+	Keys  []string `json:"keys"`
+	Count int64    `json:"count" jsonschema:"description=Estimated number of distinct elements; the standard error is 0.81%"`
+}
+
+// NewTool creates a new pfcount_hll tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"pfcount_hll",
+			"Estimate the number of distinct elements in a HyperLogLog with PFCOUNT; or in the union of several. Missing keys count as empty",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if len(params.Keys) == 0 {
+		return nil, fmt.Errorf("keys cannot be empty")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return nil, fmt.Errorf("key cannot be empty")
+		}
+	}
+
+	count, err := t.client.PFCount(ctx, params.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count HyperLogLogs %q: %w", params.Keys, err)
+	}
+	return Output{Keys: params.Keys, Count: count}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,48 @@
+package pfcount_hll
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPFCountHLL_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PFAdd(ctx, "visitors:mon", []string{"alice", "bob"})
+	require.NoError(t, err)
+	_, err = mockClient.PFAdd(ctx, "visitors:tue", []string{"bob", "carol", "dave"})
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"keys":["visitors:mon"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Keys: []string{"visitors:mon"}, Count: 2}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"keys":["visitors:mon","visitors:tue","visitors:wed"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), result.(Output).Count)
+}
+
+func TestPFCountHLL_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"keys":[]}`))
+	assert.EqualError(t, err, "keys cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"keys":["a",""]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+}
+
+func TestPFCountHLL_Execute_Error(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "visitors", []string{"alice"}, true)
+	require.NoError(t, err)
+
+	_, err = NewTool(mockClient).Execute(ctx, json.RawMessage(`{"keys":["visitors"]}`))
+	assert.ErrorContains(t, err, "WRONGTYPE")
+}
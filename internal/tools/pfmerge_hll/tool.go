@@ -0,0 +1,114 @@
+// Package pfmerge_hll implements the pfmerge_hll tool.
+package pfmerge_hll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the pfmerge_hll functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for pfmerge_hll tool.
+type Input struct {
+	Destination string   `json:"destination" jsonschema:"required,description=HyperLogLog to store the union in; its current elements are kept"`
+	Keys        []string `json:"keys" jsonschema:"required,description=HyperLogLogs to merge. In a cluster they must share a hash slot with destination"`
+}
+
+// Output represents the output of pfmerge_hll tool.
+type Output struct {
+	Destination string   `json:"destination"`
+	Keys        []string `json:"keys"`
+	Count       int64    `json:"count" jsonschema:"description=Estimated number of distinct elements in destination after the merge"`
+}
+
+// NewTool creates a new pfmerge_hll tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"pfmerge_hll",
+			"Merge HyperLogLogs into destination with PFMERGE. destination is created if needed and otherwise keeps its own elements; returns its estimated count after the merge",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.client.PFMerge(ctx, params.Destination, params.Keys); err != nil {
+		return nil, fmt.Errorf("failed to merge into HyperLogLog %q: %w", params.Destination, err)
+	}
+	count, err := t.client.PFCount(ctx, []string{params.Destination})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count HyperLogLog %q: %w", params.Destination, err)
+	}
+	return Output{Destination: params.Destination, Keys: params.Keys, Count: count}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := base.DescribeKeys(ctx, t.client, append(append([]string(nil), params.Keys...), params.Destination))
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.client.PFCount(ctx, []string{params.Destination})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count HyperLogLog %q: %w", params.Destination, err)
+	}
+	merged, err := t.client.PFCount(ctx, append(append([]string(nil), params.Keys...), params.Destination))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count HyperLogLogs %q: %w", params.Keys, err)
+	}
+	summary := fmt.Sprintf("Merge %d HyperLogLogs into %q", len(params.Keys), params.Destination)
+	if !states[len(states)-1].Exists() {
+		summary += ", creating it"
+	}
+	return &base.Preview{
+		Summary: summary,
+		Keys:    states,
+		Changes: []base.Change{{Target: params.Destination + " count", Current: current, New: merged}},
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Destination == "" {
+		return params, fmt.Errorf("destination cannot be empty")
+	}
+	if len(params.Keys) == 0 {
+		return params, fmt.Errorf("keys cannot be empty")
+	}
+	for _, key := range params.Keys {
+		if key == "" {
+			return params, fmt.Errorf("key cannot be empty")
+		}
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
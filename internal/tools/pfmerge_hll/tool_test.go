@@ -0,0 +1,67 @@
+package pfmerge_hll
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PFAdd(ctx, "visitors:mon", []string{"alice", "bob"})
+	require.NoError(t, err)
+	_, err = mockClient.PFAdd(ctx, "visitors:tue", []string{"bob", "carol"})
+	require.NoError(t, err)
+	return mockClient
+}
+
+func TestPFMergeHLL_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"destination":"visitors:week","keys":["visitors:mon","visitors:tue"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Destination: "visitors:week", Keys: []string{"visitors:mon", "visitors:tue"}, Count: 3}, result)
+
+	// The destination keeps its own elements.
+	_, err = mockClient.PFAdd(ctx, "visitors:wed", []string{"dave"})
+	require.NoError(t, err)
+	result, err = tool.Execute(ctx, json.RawMessage(`{"destination":"visitors:week","keys":["visitors:wed"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), result.(Output).Count)
+}
+
+func TestPFMergeHLL_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"destination":"","keys":["a"]}`))
+	assert.EqualError(t, err, "destination cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"destination":"d","keys":[]}`))
+	assert.EqualError(t, err, "keys cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"destination":"d","keys":[""]}`))
+	assert.EqualError(t, err, "key cannot be empty")
+}
+
+func TestPFMergeHLL_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := setup(t)
+	tool := NewTool(mockClient).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"destination":"visitors:week","keys":["visitors:mon","visitors:tue"]}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Merge 2 HyperLogLogs into "visitors:week", creating it`, p.Summary)
+	assert.Len(t, p.Keys, 3)
+	assert.Equal(t, []base.Change{{Target: "visitors:week count", Current: int64(0), New: int64(3)}}, p.Changes)
+
+	exists, err := mockClient.ExistsKey(ctx, "visitors:week")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -52,6 +52,26 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	return Output{Key: params.Key, Members: base.SafeSlice(raw), Count: len(raw)}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Count == 0 {
+		params.Count = 1
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Remove %d random members from set %q of %d; which ones cannot be known in advance", min(params.Count, state.Size), params.Key, state.Size),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
 }
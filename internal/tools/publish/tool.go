@@ -0,0 +1,67 @@
+// Package publish implements the publish tool.
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the publish functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for publish tool.
+type Input struct {
+	Channel string `json:"channel" jsonschema:"required,description=Channel to publish to"`
+	Message string `json:"message" jsonschema:"required,description=Message to send"`
+	Sharded bool   `json:"sharded,omitempty" jsonschema:"description=Publish to a shard channel with SPUBLISH (default: false)"`
+}
+
+// Output represents the output of publish tool.
+type Output struct {
+	Channel string `json:"channel"`
+	// Receivers counts the subscriptions the message was delivered to.
+	Receivers int64 `json:"receivers"`
+}
+
+// NewTool creates a new publish tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"publish",
+			"Publish a message to a Pub/Sub channel with PUBLISH or to a shard channel with SPUBLISH and return how many subscribers received it",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Channel == "" {
+		return nil, fmt.Errorf("channel cannot be empty")
+	}
+
+	receivers, err := t.client.Publish(ctx, params.Channel, params.Message, params.Sharded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish to channel %q: %w", params.Channel, err)
+	}
+
+	return Output{Channel: params.Channel, Receivers: receivers}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
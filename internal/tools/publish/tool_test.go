@@ -0,0 +1,61 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublish_Execute_Success(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockClient := client.NewMockClient()
+	received := make(chan client.PubSubMessage, 2)
+	go func() {
+		_ = mockClient.Subscribe(ctx, []string{"orders"}, []string{"ord*"}, nil, func(msg client.PubSubMessage) {
+			received <- msg
+		})
+	}()
+	require.Eventually(t, func() bool {
+		counts, _ := mockClient.PubSubNumSub(ctx, []string{"orders"}, false)
+		return counts["orders"] == 1
+	}, time.Second, time.Millisecond)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"channel":"orders","message":"created"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Channel: "orders", Receivers: 2}, result)
+	assert.Equal(t, "created", string((<-received).Message))
+}
+
+func TestPublish_Execute_Sharded(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.PublishFunc = func(ctx context.Context, channel, message string, sharded bool) (int64, error) {
+		assert.True(t, sharded)
+		return 0, nil
+	}
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"channel":"orders","message":"created","sharded":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Channel: "orders"}, result)
+}
+
+func TestPublish_Execute_Error(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.PublishFunc = func(ctx context.Context, channel, message string, sharded bool) (int64, error) {
+		return 0, errors.New("NOPERM")
+	}
+	tool := NewTool(mockClient)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"channel":"orders","message":"created"}`))
+	assert.EqualError(t, err, `failed to publish to channel "orders": NOPERM`)
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"channel":"","message":"created"}`))
+	assert.EqualError(t, err, "channel cannot be empty")
+}
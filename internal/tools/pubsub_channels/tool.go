@@ -0,0 +1,61 @@
+// Package pubsub_channels implements the pubsub_channels tool.
+package pubsub_channels
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the pubsub_channels functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for pubsub_channels tool.
+type Input struct {
+	Pattern string `json:"pattern,omitempty" jsonschema:"description=Glob pattern the channels must match (default: all channels)"`
+	Sharded bool   `json:"sharded,omitempty" jsonschema:"description=List shard channels with PUBSUB SHARDCHANNELS (default: false)"`
+}
+
+// Output represents the output of pubsub_channels tool.
+type Output struct {
+	Channels []string `json:"channels"`
+	Count    int      `json:"count"`
+}
+
+// NewTool creates a new pubsub_channels tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"pubsub_channels",
+			"List the Pub/Sub channels that have at least one subscriber with PUBSUB CHANNELS or PUBSUB SHARDCHANNELS. Pattern subscriptions are not included",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	channels, err := t.client.PubSubChannels(ctx, params.Pattern, params.Sharded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list channels: %w", err)
+	}
+
+	return Output{Channels: channels, Count: len(channels)}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,38 @@
+package pubsub_channels
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubSubChannels_Execute(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockClient := client.NewMockClient()
+	go func() {
+		_ = mockClient.Subscribe(ctx, []string{"orders", "users"}, nil, []string{"shard"}, func(client.PubSubMessage) {})
+	}()
+	require.Eventually(t, func() bool {
+		channels, _ := mockClient.PubSubChannels(ctx, "", false)
+		return len(channels) == 2
+	}, time.Second, time.Millisecond)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Channels: []string{"orders", "users"}, Count: 2}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"pattern":"ord*"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Channels: []string{"orders"}, Count: 1}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"sharded":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Channels: []string{"shard"}, Count: 1}, result)
+}
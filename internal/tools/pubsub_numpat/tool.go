@@ -0,0 +1,58 @@
+// Package pubsub_numpat implements the pubsub_numpat tool.
+package pubsub_numpat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the pubsub_numpat functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for pubsub_numpat tool.
+type Input struct {
+}
+
+// Output represents the output of pubsub_numpat tool.
+type Output struct {
+	Patterns int64 `json:"patterns"`
+}
+
+// NewTool creates a new pubsub_numpat tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"pubsub_numpat",
+			"Count the unique patterns subscribed to with PSUBSCRIBE using PUBSUB NUMPAT",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	patterns, err := t.client.PubSubNumPat(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count patterns: %w", err)
+	}
+
+	return Output{Patterns: patterns}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
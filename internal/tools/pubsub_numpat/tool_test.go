@@ -0,0 +1,23 @@
+package pubsub_numpat
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubSubNumPat_Execute(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.PubSubNumPatFunc = func(ctx context.Context) (int64, error) {
+		return 4, nil
+	}
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Patterns: 4}, result)
+}
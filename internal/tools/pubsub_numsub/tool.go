@@ -0,0 +1,74 @@
+// Package pubsub_numsub implements the pubsub_numsub tool.
+package pubsub_numsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the pubsub_numsub functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for pubsub_numsub tool.
+type Input struct {
+	Channels []string `json:"channels" jsonschema:"required,description=Channels to count the subscribers of"`
+	Sharded  bool     `json:"sharded,omitempty" jsonschema:"description=Count shard channel subscribers with PUBSUB SHARDNUMSUB (default: false)"`
+}
+
+// ChannelSubscribers is the subscriber count of one channel.
+type ChannelSubscribers struct {
+	Channel     string `json:"channel"`
+	Subscribers int64  `json:"subscribers"`
+}
+
+// Output represents the output of pubsub_numsub tool.
+type Output struct {
+	Channels []ChannelSubscribers `json:"channels"`
+}
+
+// NewTool creates a new pubsub_numsub tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"pubsub_numsub",
+			"Count the subscribers of Pub/Sub channels with PUBSUB NUMSUB or PUBSUB SHARDNUMSUB. Pattern subscriptions are not counted",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if len(params.Channels) == 0 {
+		return nil, fmt.Errorf("channels cannot be empty")
+	}
+
+	counts, err := t.client.PubSubNumSub(ctx, params.Channels, params.Sharded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count subscribers: %w", err)
+	}
+
+	output := Output{Channels: make([]ChannelSubscribers, len(params.Channels))}
+	for i, channel := range params.Channels {
+		output.Channels[i] = ChannelSubscribers{Channel: channel, Subscribers: counts[channel]}
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
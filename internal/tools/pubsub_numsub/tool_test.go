@@ -0,0 +1,34 @@
+package pubsub_numsub
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubSubNumSub_Execute(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.PubSubNumSubFunc = func(ctx context.Context, channels []string, sharded bool) (map[string]int64, error) {
+		assert.Equal(t, []string{"orders", "users"}, channels)
+		return map[string]int64{"orders": 3, "users": 0}, nil
+	}
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"channels":["orders","users"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Channels: []ChannelSubscribers{
+		{Channel: "orders", Subscribers: 3},
+		{Channel: "users", Subscribers: 0},
+	}}, result)
+}
+
+func TestPubSubNumSub_Execute_EmptyChannels(t *testing.T) {
+	tool := NewTool(&client.MockValkeyClient{})
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"channels":[]}`))
+	assert.EqualError(t, err, "channels cannot be empty")
+}
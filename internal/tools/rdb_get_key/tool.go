@@ -0,0 +1,152 @@
+// Package rdb_get_key implements the rdb_get_key tool.
+package rdb_get_key
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/rdb"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// defaultMaxElements caps the elements of a collection returned when
+// max_elements is omitted.
+const defaultMaxElements = 1000
+
+// Tool implements the rdb_get_key functionality.
+type Tool struct {
+	base.BaseTool
+	dir string
+}
+
+// Input represents the input for rdb_get_key tool.
+type Input struct {
+	Path        string `json:"path" jsonschema:"required,description=RDB file path relative to the RDB directory"`
+	Key         string `json:"key" jsonschema:"required,description=Key to read"`
+	DB          *int   `json:"db,omitempty" jsonschema:"minimum=0,description=Database of the key (default: the first database holding it)"`
+	MaxElements int    `json:"max_elements,omitempty" jsonschema:"minimum=1,description=Maximum number of collection elements returned (default: 1000)"`
+}
+
+// Output represents the output of rdb_get_key tool. TTL is in seconds
+// relative to when the snapshot was written.
+type Output struct {
+	*base.KeyValue
+	DB                   int    `json:"db"`
+	Encoding             string `json:"encoding"`
+	Length               int64  `json:"length"`
+	EstimatedMemoryBytes int64  `json:"estimated_memory_bytes"`
+	SerializedBytes      int64  `json:"serialized_bytes"`
+}
+
+// NewTool creates a new rdb_get_key tool reading files inside dir.
+func NewTool(dir string) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"rdb_get_key",
+			"Read one key from an RDB snapshot file offline with its type, encoding, TTL at snapshot time, length, estimated memory and value. Collections are capped at max_elements",
+			Input{},
+		),
+		dir: dir,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Path == "" {
+		return nil, fmt.Errorf("path cannot be empty")
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.MaxElements <= 0 {
+		params.MaxElements = defaultMaxElements
+	}
+
+	f, err := rdb.Open(t.dir, params.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := rdb.NewParser(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read RDB file %q: %w", params.Path, err)
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		entry, err := p.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("key %q not found in %q", params.Key, params.Path)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read RDB file %q: %w", params.Path, err)
+		}
+		if entry.Key != params.Key || params.DB != nil && entry.DB != *params.DB {
+			continue
+		}
+		return newOutput(entry, rdb.SnapshotTime(p.Aux()), params.MaxElements), nil
+	}
+}
+
+// newOutput converts entry to JSON-safe values, keeping at most limit
+// elements of a collection.
+func newOutput(entry *rdb.Entry, at time.Time, limit int) *Output {
+	kv := &base.KeyValue{Key: entry.Key, Type: entry.Type, TTL: -1}
+	if pttl := entry.PTTL(at); pttl >= 0 {
+		kv.TTL = pttl / 1000
+	}
+
+	switch value := entry.Value.(type) {
+	case []byte:
+		kv.Value = base.SafeValue(value)
+	case [][]byte:
+		kv.Value, kv.Truncated = base.SafeSlice(value[:min(len(value), limit)]), len(value) > limit
+	case []rdb.ZsetMember:
+		members := make([]map[string]any, 0, min(len(value), limit))
+		for _, m := range value[:min(len(value), limit)] {
+			members = append(members, map[string]any{"member": base.SafeValue(m.Member), "score": m.Score})
+		}
+		kv.Value, kv.Truncated = members, len(value) > limit
+	case []rdb.HashField:
+		fields := make(map[string]any, min(len(value), limit))
+		for _, f := range value[:min(len(value), limit)] {
+			fields[string(f.Field)] = base.SafeValue(f.Value)
+		}
+		kv.Value, kv.Truncated = fields, len(value) > limit
+	case *rdb.Stream:
+		entries := make([]map[string]any, 0, min(len(value.Entries), limit))
+		for _, e := range value.Entries[:min(len(value.Entries), limit)] {
+			m := make(map[string]any, len(e.Fields)+1)
+			m["_id"] = e.ID
+			for _, f := range e.Fields {
+				m[string(f.Name)] = base.SafeValue(f.Value)
+			}
+			entries = append(entries, m)
+		}
+		kv.Value, kv.Truncated = entries, len(value.Entries) > limit
+	}
+
+	return &Output{
+		KeyValue:             kv,
+		DB:                   entry.DB,
+		Encoding:             entry.Encoding,
+		Length:               entry.Length,
+		EstimatedMemoryBytes: entry.MemoryEstimate(),
+		SerializedBytes:      entry.SerializedBytes,
+	}
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, dir string) {
+	reg.MustRegister(NewTool(dir))
+}
@@ -0,0 +1,104 @@
+package rdb_get_key
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRDB writes a version 11 RDB file holding a string expiring an hour
+// after the snapshot, a set and a hash, the hash in database 2.
+func writeRDB(t *testing.T, dir string) {
+	t.Helper()
+	data := []byte("REDIS0011")
+	str := func(s string) {
+		data = append(data, byte(len(s)))
+		data = append(data, s...)
+	}
+	data = append(data, 0xfa)
+	str("ctime")
+	str("1700000000")
+
+	data = append(data, 0xfc)
+	data = binary.LittleEndian.AppendUint64(data, (1700000000+3600)*1000)
+	data = append(data, 0x00)
+	str("greeting")
+	str("hello")
+
+	data = append(data, 0x02)
+	str("tags")
+	data = append(data, 3)
+	str("a")
+	str("b")
+	str("c")
+
+	data = append(data, 0xfe, 2, 0x04)
+	str("user:1")
+	data = append(data, 1)
+	str("name")
+	str("alice")
+
+	data = append(data, 0xff, 0, 0, 0, 0, 0, 0, 0, 0)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dump.rdb"), data, 0o600))
+}
+
+func TestTool_Execute_String(t *testing.T) {
+	dir := t.TempDir()
+	writeRDB(t, dir)
+	tool := NewTool(dir)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb", "key": "greeting"}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, &base.KeyValue{Key: "greeting", Type: "string", TTL: 3600, Value: "hello"}, output.KeyValue)
+	assert.Equal(t, "embstr", output.Encoding)
+	assert.Equal(t, int64(5), output.Length)
+	assert.Equal(t, int64(6), output.SerializedBytes)
+	assert.Positive(t, output.EstimatedMemoryBytes)
+}
+
+func TestTool_Execute_MaxElements(t *testing.T) {
+	dir := t.TempDir()
+	writeRDB(t, dir)
+	tool := NewTool(dir)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb", "key": "tags", "max_elements": 2}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, int64(-1), output.TTL)
+	assert.Equal(t, []any{"a", "b"}, output.Value)
+	assert.True(t, output.Truncated)
+	assert.Equal(t, int64(3), output.Length)
+}
+
+func TestTool_Execute_DB(t *testing.T) {
+	dir := t.TempDir()
+	writeRDB(t, dir)
+	tool := NewTool(dir)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb", "key": "user:1", "db": 2}`))
+	require.NoError(t, err)
+
+	output := result.(*Output)
+	assert.Equal(t, 2, output.DB)
+	assert.Equal(t, map[string]any{"name": "alice"}, output.Value)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb", "key": "user:1", "db": 0}`))
+	assert.EqualError(t, err, `key "user:1" not found in "dump.rdb"`)
+}
+
+func TestTool_Execute_EmptyKey(t *testing.T) {
+	tool := NewTool(t.TempDir())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"path": "dump.rdb"}`))
+	assert.EqualError(t, err, "key cannot be empty")
+}
@@ -67,6 +67,46 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	var removed []any
+	seen := make(map[string]bool, len(params.Members))
+	for _, member := range params.Members {
+		if seen[member] {
+			continue
+		}
+		seen[member] = true
+		exists, err := t.client.CheckSetMember(ctx, params.Key, member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check member of set %q: %w", params.Key, err)
+		}
+		if exists {
+			removed = append(removed, member)
+		}
+	}
+	summary := fmt.Sprintf("Remove %d of the %d requested members from set %q", len(removed), len(seen), params.Key)
+	if len(removed) > 0 && int64(len(removed)) == state.Size {
+		summary += ", which removes the key"
+	}
+	return &base.Preview{
+		Summary: summary,
+		Keys:    []*base.KeyState{state},
+		Removed: removed,
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
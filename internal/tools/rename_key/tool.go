@@ -77,6 +77,53 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	impact := &registry.Impact{
+		Summary: fmt.Sprintf("Rename key %q to %q", params.Key, params.NewKey),
+		Keys:    []string{params.Key, params.NewKey},
+	}
+	exists, err := t.client.ExistsKey(ctx, params.NewKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check key %q: %w", params.NewKey, err)
+	}
+	if exists {
+		impact.Summary += fmt.Sprintf(", replacing the existing %q", params.NewKey)
+		impact.Overwrites = []string{params.NewKey}
+	}
+	return impact, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	states, err := base.DescribeKeys(ctx, t.client, []string{params.Key, params.NewKey})
+	if err != nil {
+		return nil, err
+	}
+	preview := &base.Preview{Keys: states}
+	source, destination := states[0], states[1]
+	switch {
+	case !source.Exists():
+		preview.Summary = fmt.Sprintf("Key %q does not exist; the call would fail", params.Key)
+	case destination.Exists():
+		preview.Summary = fmt.Sprintf("Rename key %q to %q, overwriting the existing %s %q", params.Key, params.NewKey, destination.Type, params.NewKey)
+		preview.Overwrites = []string{params.NewKey}
+	default:
+		preview.Summary = fmt.Sprintf("Rename key %q to %q", params.Key, params.NewKey)
+	}
+	return preview, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -52,3 +53,40 @@ func TestTool_Metadata(t *testing.T) {
 	assert.Equal(t, "rename_key", tool.Name())
 	assert.NotEmpty(t, tool.Description())
 }
+
+func TestTool_Assess_Overwrite(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("old", []byte("1"))
+	mockClient.SetRawBytes("taken", []byte("2"))
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "old", "new_key": "taken"})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"taken"}, impact.Overwrites)
+	assert.Contains(t, impact.Summary, "replacing the existing")
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "old", "new_key": "free"})
+	impact, err = tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Empty(t, impact.Overwrites)
+}
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("old", []byte("1"))
+	mockClient.SetRawListBytes("taken", [][]byte{[]byte("x")})
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "old", "new_key": "taken"})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, []string{"taken"}, preview.Overwrites)
+	assert.Contains(t, preview.Summary, `overwriting the existing list "taken"`)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "old", "new_key": "free"})
+	result, err = tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Empty(t, result.(*base.Preview).Overwrites)
+}
@@ -0,0 +1,181 @@
+// Package rename_keys_by_prefix implements the rename_keys_by_prefix tool.
+package rename_keys_by_prefix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// previewedKeys is the number of keys described in a dry run.
+const previewedKeys = 20
+
+// Tool implements the rename_keys_by_prefix functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for rename_keys_by_prefix tool.
+type Input struct {
+	Prefix        string `json:"prefix" jsonschema:"required,description=Prefix of the keys to rename"`
+	NewPrefix     string `json:"new_prefix" jsonschema:"required,description=Prefix that replaces prefix in each key name"`
+	Overwrite     bool   `json:"overwrite,omitempty" jsonschema:"description=Replace existing destination keys (default: false skips them)"`
+	MaxKeys       int64  `json:"max_keys,omitempty" jsonschema:"minimum=1,description=Stop after this many keys (default: 10000)"`
+	KeysPerSecond int64  `json:"keys_per_second,omitempty" jsonschema:"minimum=1,description=Maximum number of keys renamed per second (default: 1000)"`
+	BatchSize     int64  `json:"batch_size,omitempty" jsonschema:"minimum=1,description=COUNT hint for each SCAN call (default: 100)"`
+}
+
+// NewTool creates a new rename_keys_by_prefix tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"rename_keys_by_prefix",
+			"Rename every key starting with prefix so that it starts with new_prefix instead. Keys are found with SCAN on each node and renamed in rate-limited batches; existing destinations are skipped unless overwrite is set. In a cluster both names must hash to the same slot, e.g. through a shared {hash tag}",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return base.RunBulk(ctx, t.client, opts, func(ctx context.Context, keys []string) (int64, []string, error) {
+		var renamed int64
+		var failures []string
+		for _, key := range keys {
+			newKey := params.NewPrefix + strings.TrimPrefix(key, params.Prefix)
+			var ok bool
+			var err error
+			if params.Overwrite {
+				ok, err = t.client.RenameKey(ctx, key, newKey)
+			} else {
+				ok, err = t.client.RenameKeyNX(ctx, key, newKey)
+			}
+			switch {
+			case err != nil && base.Interrupted(ctx, err):
+				return renamed, failures, err
+			case err != nil:
+				failures = append(failures, fmt.Sprintf("%s: %v", key, err))
+			case !ok:
+				failures = append(failures, fmt.Sprintf("%s: %s already exists", key, newKey))
+			default:
+				renamed++
+			}
+		}
+		return renamed, failures, nil
+	})
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, result, existing, err := t.plan(ctx, params, opts)
+	if err != nil {
+		return nil, err
+	}
+	states, err := base.DescribeKeys(ctx, t.client, keys[:min(len(keys), previewedKeys)])
+	if err != nil {
+		return nil, err
+	}
+
+	summary := fmt.Sprintf("Rename %d keys from prefix %q to %q on %d nodes", len(keys), params.Prefix, params.NewPrefix, result.Nodes)
+	preview := &base.Preview{Keys: states, Truncated: len(keys) > len(states)}
+	if len(existing) > 0 {
+		if params.Overwrite {
+			summary += fmt.Sprintf(", overwriting %d existing keys", len(existing))
+			preview.Overwrites = existing[:min(len(existing), base.MaxPreviewElements)]
+		} else {
+			summary += fmt.Sprintf("; %d keys would be skipped because their new name exists", len(existing))
+		}
+	}
+	if result.LimitReached {
+		summary += fmt.Sprintf("; more keys match but max_keys stops at %d", len(keys))
+	}
+	preview.Summary = summary
+	return preview, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, opts, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, _, existing, err := t.plan(ctx, params, opts)
+	if err != nil {
+		return nil, err
+	}
+	impact := &registry.Impact{
+		Summary: fmt.Sprintf("Rename %d keys from prefix %q to %q", len(keys), params.Prefix, params.NewPrefix),
+		Keys:    keys,
+	}
+	if params.Overwrite {
+		impact.Overwrites = existing
+	}
+	return impact, nil
+}
+
+// plan returns the keys the call would rename and the destinations that
+// already exist.
+func (t *Tool) plan(ctx context.Context, params Input, opts base.BulkOptions) ([]string, *base.BulkResult, []string, error) {
+	keys, result, err := base.CollectBulkKeys(ctx, t.client, opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	destinations := make([]string, len(keys))
+	for i, key := range keys {
+		destinations[i] = params.NewPrefix + strings.TrimPrefix(key, params.Prefix)
+	}
+	exists, err := t.client.ExistsKeys(ctx, destinations)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to check destination keys: %w", err)
+	}
+	var existing []string
+	for _, destination := range destinations {
+		if exists[destination] {
+			existing = append(existing, destination)
+		}
+	}
+	return keys, result, existing, nil
+}
+
+// parse validates input and converts it to bulk options.
+func (t *Tool) parse(input json.RawMessage) (Input, base.BulkOptions, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, base.BulkOptions{}, err
+	}
+	if params.Prefix == "" {
+		return params, base.BulkOptions{}, fmt.Errorf("prefix cannot be empty")
+	}
+	// Renamed keys must not match the scan again.
+	if strings.HasPrefix(params.NewPrefix, params.Prefix) || strings.HasPrefix(params.Prefix, params.NewPrefix) {
+		return params, base.BulkOptions{}, fmt.Errorf("prefixes %q and %q overlap: renamed keys could match the scan again", params.Prefix, params.NewPrefix)
+	}
+	return params, base.BulkOptions{
+		Pattern:       base.EscapeGlob(params.Prefix) + "*",
+		MaxKeys:       params.MaxKeys,
+		KeysPerSecond: params.KeysPerSecond,
+		BatchSize:     params.BatchSize,
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
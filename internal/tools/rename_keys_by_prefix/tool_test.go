@@ -0,0 +1,99 @@
+package rename_keys_by_prefix
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setup(t *testing.T) *client.MockClient {
+	t.Helper()
+	mockClient := client.NewMockClient()
+	ctx := context.Background()
+	for key, value := range map[string]string{"old:a": "1", "old:b": "2", "new:b": "taken", "other": "3"} {
+		_, err := mockClient.SetString(ctx, key, value, nil, false, false)
+		require.NoError(t, err)
+	}
+	return mockClient
+}
+
+func TestTool_Execute_SkipsExisting(t *testing.T) {
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"prefix": "old:", "new_prefix": "new:", "batch_size": 10})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	bulk := result.(*base.BulkResult)
+	assert.Equal(t, int64(2), bulk.Matched)
+	assert.Equal(t, int64(1), bulk.Changed)
+	assert.Equal(t, int64(1), bulk.Failed)
+	assert.Equal(t, []string{"old:b: new:b already exists"}, bulk.Errors)
+
+	value, found, _ := mockClient.GetString(ctx, "new:a")
+	assert.True(t, found)
+	assert.Equal(t, "1", string(value))
+	value, _, _ = mockClient.GetString(ctx, "new:b")
+	assert.Equal(t, "taken", string(value))
+}
+
+func TestTool_Execute_Overwrite(t *testing.T) {
+	mockClient := setup(t)
+	tool := NewTool(mockClient)
+	ctx := context.Background()
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"prefix": "old:", "new_prefix": "new:", "overwrite": true, "batch_size": 10})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.(*base.BulkResult).Changed)
+
+	value, _, _ := mockClient.GetString(ctx, "new:b")
+	assert.Equal(t, "2", string(value))
+}
+
+func TestTool_Execute_OverlappingPrefixes(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"prefix": "user:", "new_prefix": "user:v2:"})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "overlap")
+	assert.Nil(t, result)
+}
+
+func TestTool_Preview(t *testing.T) {
+	tool := NewTool(setup(t)).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"prefix": "old:", "new_prefix": "new:", "overwrite": true})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Rename 2 keys from prefix "old:" to "new:" on 1 nodes, overwriting 1 existing keys`, preview.Summary)
+	assert.Equal(t, []string{"new:b"}, preview.Overwrites)
+	assert.Len(t, preview.Keys, 2)
+}
+
+func TestTool_Assess(t *testing.T) {
+	tool := NewTool(setup(t)).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"prefix": "old:", "new_prefix": "new:"})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old:a", "old:b"}, impact.Keys)
+	assert.Empty(t, impact.Overwrites)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	assert.Equal(t, "rename_keys_by_prefix", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -0,0 +1,122 @@
+// Package render_bitmap implements the render_bitmap tool.
+package render_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// maxRenderBytes caps the bytes rendered by one call.
+const maxRenderBytes = 1024
+
+// Tool implements the render_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for render_bitmap tool.
+type Input struct {
+	Key    string `json:"key" jsonschema:"required,description=Bitmap key"`
+	Start  *int64 `json:"start,omitempty" jsonschema:"minimum=0,description=First byte to render; pass next_start of the previous call to continue (default: 0)"`
+	End    *int64 `json:"end,omitempty" jsonschema:"minimum=0,description=Last byte to render inclusive; at most 1024 bytes are rendered per call (default: end of string)"`
+	Format string `json:"format,omitempty" jsonschema:"description=bits for a string of 0s and 1s or offsets for the offsets of the set bits (default: bits)"`
+}
+
+// Output represents the output of render_bitmap tool.
+type Output struct {
+	Key    string `json:"key"`
+	Length int64  `json:"length" jsonschema:"description=Length of the string in bytes"`
+	Start  int64  `json:"start"`
+	End    int64  `json:"end"`
+	// FirstBit is the offset of the first rendered bit.
+	FirstBit int64   `json:"first_bit"`
+	Bits     string  `json:"bits,omitempty"`
+	Offsets  []int64 `json:"offsets,omitempty"`
+	SetBits  int64   `json:"set_bits" jsonschema:"description=Number of set bits in the rendered range"`
+	// NextStart is the start of the next call when the range was capped.
+	NextStart *int64 `json:"next_start,omitempty"`
+}
+
+// NewTool creates a new render_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"render_bitmap",
+			"Show a byte range of a bitmap as a string of 0s and 1s or as the offsets of its set bits. Bit 0 is the most significant bit of the first byte; renders at most 1024 bytes per call and returns next_start to continue",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Format == "" {
+		params.Format = "bits"
+	}
+	if params.Format != "bits" && params.Format != "offsets" {
+		return nil, fmt.Errorf("format must be bits or offsets")
+	}
+	var start int64
+	if params.Start != nil {
+		if start = *params.Start; start < 0 {
+			return nil, fmt.Errorf("start cannot be negative")
+		}
+	}
+	if params.End != nil && *params.End < start {
+		return nil, fmt.Errorf("end cannot be before start")
+	}
+
+	lengths, err := t.client.KeyLengths(ctx, []string{params.Key}, []string{"string"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get length of key %q: %w", params.Key, err)
+	}
+	output := Output{Key: params.Key, Length: lengths[0], Start: start, End: start - 1, FirstBit: start * 8}
+	last := output.Length - 1
+	if params.End != nil {
+		last = min(last, *params.End)
+	}
+	if start > last {
+		if params.Format == "offsets" {
+			output.Offsets = []int64{}
+		}
+		return output, nil
+	}
+	output.End = min(last, start+maxRenderBytes-1)
+	if output.End < last {
+		next := output.End + 1
+		output.NextStart = &next
+	}
+
+	data, err := t.client.GetRange(ctx, params.Key, start, output.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get range of key %q: %w", params.Key, err)
+	}
+	for _, b := range data {
+		output.SetBits += int64(bits.OnesCount8(b))
+	}
+	if params.Format == "offsets" {
+		output.Offsets = base.SetBits(data, output.FirstBit)
+	} else {
+		output.Bits = base.RenderBits(data)
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,79 @@
+package render_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "flags", "\x81\x00\x05", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"flags"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Length: 3, Start: 0, End: 2, Bits: "100000010000000000000101", SetBits: 4}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"flags","start":1,"format":"offsets"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Length: 3, Start: 1, End: 2, FirstBit: 8, Offsets: []int64{21, 23}, SetBits: 2}, result)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"flags","start":0,"end":0,"format":"offsets"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Length: 3, Start: 0, End: 0, Offsets: []int64{0, 7}, SetBits: 2}, result)
+}
+
+func TestRenderBitmap_Execute_Paged(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "dau", strings.Repeat("\x01", maxRenderBytes+10), nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"dau","format":"offsets"}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, int64(maxRenderBytes-1), output.End)
+	require.NotNil(t, output.NextStart)
+	assert.Equal(t, int64(maxRenderBytes), *output.NextStart)
+	assert.Len(t, output.Offsets, maxRenderBytes)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "dau", "start": *output.NextStart, "format": "offsets"})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output = result.(Output)
+	assert.Nil(t, output.NextStart)
+	assert.Equal(t, int64(maxRenderBytes*8+7), output.Offsets[0])
+	assert.Len(t, output.Offsets, 10)
+}
+
+func TestRenderBitmap_Execute_Empty(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"missing","format":"offsets"}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "missing", End: -1, Offsets: []int64{}}, result)
+}
+
+func TestRenderBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	tests := map[string]string{
+		`{"key":""}`:                    "key cannot be empty",
+		`{"key":"k","format":"hex"}`:    "format must be bits or offsets",
+		`{"key":"k","start":-1}`:        "start cannot be negative",
+		`{"key":"k","start":4,"end":2}`: "end cannot be before start",
+	}
+	for input, want := range tests {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, want, input)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -21,6 +22,10 @@ type Input struct {
 	TTL             int64  `json:"ttl" jsonschema:"description=TTL in milliseconds (0 for no expiry)"`
 	SerializedValue string `json:"serialized_value" jsonschema:"description=Base64-encoded serialized value (alternative to serialized)"`
 	Serialized      string `json:"serialized" jsonschema:"description=Base64-encoded serialized value (alternative to serialized_value)"`
+	Replace         bool   `json:"replace,omitempty" jsonschema:"description=Overwrite the key if it already exists"`
+	AbsTTL          bool   `json:"absttl,omitempty" jsonschema:"description=Treat ttl as an absolute Unix time in milliseconds"`
+	IdleTime        *int64 `json:"idletime_seconds,omitempty" jsonschema:"description=Idle time in seconds to set on the restored key (cannot be combined with freq)"`
+	Freq            *int64 `json:"freq,omitempty" jsonschema:"description=LFU access frequency to set on the restored key (cannot be combined with idletime_seconds)"`
 }
 
 type Output struct {
@@ -30,7 +35,7 @@ type Output struct {
 
 func NewTool(client client.ValkeyClient) registry.Tool {
 	return &Tool{
-		BaseTool: base.NewBaseTool("restore_key", "Restore serialized value to key (accepts base64-encoded serialization)", Input{}),
+		BaseTool: base.NewBaseTool("restore_key", "Restore serialized value to key (accepts base64-encoded serialization). Set replace to overwrite an existing key; absttl and idletime_seconds or freq map to the RESTORE options of the same name", Input{}),
 		client:   client,
 	}
 }
@@ -41,8 +46,8 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 		return nil, err
 	}
 
-	if params.Key == "" {
-		return nil, fmt.Errorf("key cannot be empty")
+	if err := validate(&params); err != nil {
+		return nil, err
 	}
 
 	// Support both serialized and serialized_value parameters
@@ -61,7 +66,12 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 		return nil, fmt.Errorf("failed to decode base64 serialized data: %w", err)
 	}
 
-	success, err := t.client.RestoreKey(ctx, params.Key, params.TTL, decoded)
+	success, err := t.client.RestoreKey(ctx, params.Key, params.TTL, decoded, client.RestoreOptions{
+		Replace:  params.Replace,
+		AbsTTL:   params.AbsTTL,
+		IdleTime: params.IdleTime,
+		Freq:     params.Freq,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to restore key: %w", err)
 	}
@@ -77,6 +87,88 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	impact := &registry.Impact{
+		Summary: fmt.Sprintf("Restore key %q from a serialized payload, %s", params.Key, expiry(&params)),
+		Keys:    []string{params.Key},
+	}
+	if params.Replace {
+		existing, err := t.client.ExistsKeys(ctx, []string{params.Key})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check key %q: %w", params.Key, err)
+		}
+		if existing[params.Key] {
+			impact.Summary += ", replacing its current value"
+			impact.Overwrites = []string{params.Key}
+		}
+	}
+	return impact, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if err := validate(&params); err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	preview := &base.Preview{
+		Summary: fmt.Sprintf("Restore key %q from a serialized payload, %s", params.Key, expiry(&params)),
+		Keys:    []*base.KeyState{state},
+	}
+	if state.Exists() {
+		if params.Replace {
+			preview.Summary = fmt.Sprintf("Replace %s key %q with a serialized payload, %s", state.Type, params.Key, expiry(&params))
+			preview.Overwrites = []string{params.Key}
+		} else {
+			preview.Summary = fmt.Sprintf("Key %q already exists as a %s; the call would fail", params.Key, state.Type)
+		}
+	}
+	return preview, nil
+}
+
+// validate checks the key and the RESTORE options.
+func validate(params *Input) error {
+	if params.Key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+	if params.IdleTime != nil && params.Freq != nil {
+		return fmt.Errorf("idletime_seconds and freq cannot be combined")
+	}
+	if params.IdleTime != nil && *params.IdleTime < 0 {
+		return fmt.Errorf("idletime_seconds cannot be negative")
+	}
+	if params.Freq != nil && (*params.Freq < 0 || *params.Freq > 255) {
+		return fmt.Errorf("freq must be between 0 and 255")
+	}
+	return nil
+}
+
+// expiry describes the TTL the key is restored with.
+func expiry(params *Input) string {
+	switch {
+	case params.TTL <= 0:
+		return "without expiry"
+	case params.AbsTTL:
+		return fmt.Sprintf("expiring at %s", time.UnixMilli(params.TTL).UTC().Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("expiring in %dms", params.TTL)
+	}
+}
+
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
 }
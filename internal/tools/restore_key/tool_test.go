@@ -7,13 +7,14 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestRestoreKey_Execute_Success(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error) {
+	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
 		assert.Equal(t, "mykey", key)
 		assert.Equal(t, int64(1000), ttl)
 		return true, nil
@@ -42,7 +43,7 @@ func TestRestoreKey_Execute_Success(t *testing.T) {
 
 func TestRestoreKey_Execute_NoTTL(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error) {
+	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
 		assert.Equal(t, int64(0), ttl)
 		return true, nil
 	}
@@ -124,7 +125,7 @@ func TestRestoreKey_Execute_InvalidBase64(t *testing.T) {
 
 func TestRestoreKey_Execute_LargeBinaryData(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte) (bool, error) {
+	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
 		assert.Equal(t, 1024, len(serialized))
 		return true, nil
 	}
@@ -152,3 +153,65 @@ func TestRestoreKey_Execute_LargeBinaryData(t *testing.T) {
 	require.True(t, ok)
 	assert.True(t, output.Success)
 }
+
+func TestTool_Assess(t *testing.T) {
+	tool := NewTool(client.NewMockClient()).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "k", "ttl": 5000, "serialized": "AAAA"})
+	impact, err := tool.Assess(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"k"}, impact.Keys)
+	assert.Contains(t, impact.Summary, "expiring in 5000ms")
+}
+
+func TestRestoreKey_Execute_Options(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.RestoreKeyFunc = func(ctx context.Context, key string, ttl int64, serialized []byte, opts client.RestoreOptions) (bool, error) {
+		assert.True(t, opts.Replace)
+		assert.True(t, opts.AbsTTL)
+		require.NotNil(t, opts.IdleTime)
+		assert.Equal(t, int64(60), *opts.IdleTime)
+		assert.Nil(t, opts.Freq)
+		return true, nil
+	}
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{
+		"key": "k", "ttl": 1700000000000, "serialized": "AAAA",
+		"replace": true, "absttl": true, "idletime_seconds": 60,
+	})
+	_, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "k", "serialized": "AAAA", "idletime_seconds": 1, "freq": 1})
+	_, err = tool.Execute(context.Background(), inputJSON)
+	assert.EqualError(t, err, "idletime_seconds and freq cannot be combined")
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "k", "serialized": "AAAA", "freq": 300})
+	_, err = tool.Execute(context.Background(), inputJSON)
+	assert.EqualError(t, err, "freq must be between 0 and 255")
+}
+
+func TestTool_Preview_Replace(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "k", "v", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "k", "serialized": "AAAA"})
+	result, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Contains(t, result.(*base.Preview).Summary, "the call would fail")
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "k", "serialized": "AAAA", "replace": true})
+	result, err = tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Replace string key "k" with a serialized payload, without expiry`, preview.Summary)
+	assert.Equal(t, []string{"k"}, preview.Overwrites)
+
+	impact, err := tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"k"}, impact.Overwrites)
+}
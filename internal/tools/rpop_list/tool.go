@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -68,6 +69,41 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Count == 0 {
+		params.Count = 1
+	}
+
+	listed := params.Count
+	if listed > base.MaxPreviewElements {
+		listed = base.MaxPreviewElements
+	}
+	elements, err := t.client.GetListRange(ctx, params.Key, -listed, -1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read list %q: %w", params.Key, err)
+	}
+	// RPOP returns the last element first.
+	slices.Reverse(elements)
+	length, err := t.client.GetListLength(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get length of list %q: %w", params.Key, err)
+	}
+	popped := min(params.Count, length)
+	return &base.Preview{
+		Summary:   fmt.Sprintf("Pop %d of %d elements from the tail of list %q", popped, length, params.Key),
+		Removed:   base.SafeSlice(elements),
+		Truncated: popped > int64(len(elements)),
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
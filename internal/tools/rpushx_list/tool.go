@@ -0,0 +1,106 @@
+// Package rpushx_list implements the rpushx_list tool.
+package rpushx_list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the rpushx_list functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for rpushx_list tool.
+type Input struct {
+	Key    string   `json:"key" jsonschema:"required,description=List key"`
+	Values []string `json:"values" jsonschema:"required,minItems=1,description=Values to push to the right"`
+}
+
+// Output represents the output of rpushx_list tool.
+type Output struct {
+	Key        string   `json:"key"`
+	Pushed     bool     `json:"pushed" jsonschema:"description=False when the list does not exist"`
+	ListLength int64    `json:"list_length" jsonschema:"description=Length of the list after push"`
+	Values     []string `json:"values"`
+}
+
+// NewTool creates a new rpushx_list tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"rpushx_list",
+			"Push values to the right (tail) of a list with RPUSHX only if the list already exists. Unlike RPUSH it never creates a list",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := t.client.PushListExisting(ctx, params.Key, params.Values, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push to list %q: %w", params.Key, err)
+	}
+
+	return Output{
+		Key:        params.Key,
+		Pushed:     length > 0,
+		ListLength: length,
+		Values:     params.Values,
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Exists() {
+		return &base.Preview{
+			Summary: fmt.Sprintf("List %q does not exist; nothing would be pushed", params.Key),
+			Keys:    []*base.KeyState{state},
+		}, nil
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Push %d values onto the tail of list %q, growing it from %d to %d elements", len(params.Values), params.Key, state.Size, state.Size+int64(len(params.Values))),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Key == "" {
+		return params, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.Values) == 0 {
+		return params, fmt.Errorf("at least one value must be provided")
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
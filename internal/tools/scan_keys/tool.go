@@ -4,12 +4,21 @@ package scan_keys
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
 	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 )
 
+const (
+	// defaultCount is the number of keys collected when count is omitted.
+	defaultCount = 1000
+	// batchSize is the COUNT hint passed to each SCAN call.
+	batchSize = 100
+)
+
 // Tool implements the scan_keys functionality.
 type Tool struct {
 	base.BaseTool
@@ -19,7 +28,8 @@ type Tool struct {
 // Input represents the input for scan_keys tool.
 type Input struct {
 	Pattern string `json:"pattern,omitempty" jsonschema:"description=Glob pattern to filter keys (default: *)"`
-	Count   int64  `json:"count,omitempty" jsonschema:"description=Approximate number of keys to return"`
+	Count   int64  `json:"count,omitempty" jsonschema:"description=Approximate number of keys to return (default: 1000)"`
+	Cursor  uint64 `json:"cursor,omitempty" jsonschema:"description=Cursor returned by a previous call to continue the scan (default: 0 to start)"`
 }
 
 // Output represents the output of scan_keys tool.
@@ -27,6 +37,8 @@ type Output struct {
 	Keys    []string `json:"keys"`
 	Count   int      `json:"count"`
 	Pattern string   `json:"pattern"`
+	Cursor  uint64   `json:"cursor"`
+	Partial bool     `json:"partial,omitempty"`
 }
 
 // NewTool creates a new scan_keys tool.
@@ -34,7 +46,7 @@ func NewTool(client client.ValkeyClient) registry.Tool {
 	return &Tool{
 		BaseTool: base.NewBaseTool(
 			"scan_keys",
-			"Scan keys matching a pattern (non-blocking alternative to KEYS)",
+			"Scan keys matching a pattern (non-blocking alternative to KEYS). Returns a cursor to continue from (0 when the scan is complete); partial is true when the scan was cancelled or timed out.",
 			Input{},
 		),
 		client: client,
@@ -50,14 +62,46 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	if params.Pattern == "" {
 		params.Pattern = "*"
 	}
+	limit := params.Count
+	if limit <= 0 {
+		limit = defaultCount
+	}
+
+	// The key count only scales progress; an unknown total is fine.
+	total, _ := t.client.GetDatabaseSize(ctx)
 
-	keys := []string{}
+	output := Output{Keys: []string{}, Pattern: params.Pattern, Cursor: params.Cursor}
+	var examined int64
+	for {
+		if ctx.Err() != nil {
+			output.Partial = true
+			break
+		}
+		keys, next, err := t.client.ScanKeys(ctx, output.Cursor, params.Pattern, batchSize)
+		if err != nil {
+			if base.Interrupted(ctx, err) {
+				output.Partial = true
+				break
+			}
+			return nil, fmt.Errorf("failed to scan keys matching %q: %w", params.Pattern, err)
+		}
+		output.Keys = append(output.Keys, keys...)
+		output.Cursor = next
+
+		// SCAN examines roughly batchSize slots per call, whether or not they match.
+		examined += batchSize
+		if total > 0 && (next == 0 || examined > total) {
+			examined = total
+		}
+		progress.Report(ctx, float64(examined), float64(total), fmt.Sprintf("%d matching keys found", len(output.Keys)))
+
+		if next == 0 || int64(len(output.Keys)) >= limit {
+			break
+		}
+	}
 
-	return Output{
-		Keys:    keys,
-		Count:   len(keys),
-		Pattern: params.Pattern,
-	}, nil
+	output.Count = len(output.Keys)
+	return output, nil
 }
 
 // Init registers the tool with the registry.
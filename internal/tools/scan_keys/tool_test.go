@@ -3,9 +3,11 @@ package scan_keys
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/progress"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -52,3 +54,87 @@ func TestTool_Metadata(t *testing.T) {
 	assert.Equal(t, "scan_keys", tool.Name())
 	assert.NotEmpty(t, tool.Description())
 }
+
+func TestTool_Execute_CollectsAcrossIterations(t *testing.T) {
+	mockClient := client.NewMockClient()
+	for i := 0; i < 250; i++ {
+		mockClient.SetRawBytes(fmt.Sprintf("user:%03d", i), []byte("v"))
+	}
+	mockClient.SetRawBytes("order:1", []byte("v"))
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"pattern": "user:*"})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+
+	output := result.(Output)
+	assert.Equal(t, 250, output.Count)
+	assert.Equal(t, uint64(0), output.Cursor)
+	assert.False(t, output.Partial)
+}
+
+func TestTool_Execute_StopsAtCountWithCursor(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			return []string{fmt.Sprintf("k%d", cursor)}, cursor + 1, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"count": 3, "cursor": 10})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+
+	output := result.(Output)
+	assert.Equal(t, []string{"k10", "k11", "k12"}, output.Keys)
+	assert.Equal(t, uint64(13), output.Cursor)
+}
+
+func TestTool_Execute_CancelledReturnsPartialResults(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	mockClient := &client.MockValkeyClient{
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			calls++
+			if calls == 3 {
+				cancel()
+				return nil, 0, ctx.Err()
+			}
+			return []string{fmt.Sprintf("k%d", cursor)}, cursor + 1, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	output := result.(Output)
+	assert.True(t, output.Partial)
+	assert.Equal(t, []string{"k0", "k1"}, output.Keys)
+	assert.Equal(t, uint64(2), output.Cursor)
+}
+
+func TestTool_Execute_ReportsProgress(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		GetDatabaseSizeFunc: func(ctx context.Context) (int64, error) { return 250, nil },
+		ScanKeysFunc: func(ctx context.Context, cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+			if cursor == 2 {
+				return []string{"last"}, 0, nil
+			}
+			return []string{"k"}, cursor + 1, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	var reports [][2]float64
+	ctx := progress.WithReporter(context.Background(), func(ctx context.Context, processed, total float64, message string) {
+		reports = append(reports, [2]float64{processed, total})
+	})
+	inputJSON, _ := json.Marshal(map[string]interface{}{})
+	_, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][2]float64{{100, 250}, {200, 250}, {250, 250}}, reports)
+}
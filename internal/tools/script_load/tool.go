@@ -2,6 +2,8 @@ package script_load
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -54,6 +56,22 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Script == "" {
+		return nil, fmt.Errorf("script cannot be empty")
+	}
+
+	sum := sha1.Sum([]byte(params.Script))
+	return &base.Preview{
+		Summary: fmt.Sprintf("Load the script into the script cache as %s; no keys change", hex.EncodeToString(sum[:])),
+	}, nil
+}
+
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
 }
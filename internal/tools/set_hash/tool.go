@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
 	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
@@ -68,6 +69,40 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	names := make([]string, 0, len(params.Fields))
+	for name := range params.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	current, err := t.client.GetMapFields(ctx, params.Key, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields of hash %q: %w", params.Key, err)
+	}
+
+	changes := make([]base.Change, 0, len(names))
+	for _, name := range names {
+		change := base.Change{Target: name, New: params.Fields[name]}
+		if value, ok := current[name]; ok {
+			change.Current = base.SafeValue(value)
+		}
+		changes = append(changes, change)
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Set %d fields of hash %q: %d new, %d overwritten", len(names), params.Key, len(names)-len(current), len(current)),
+		Changes: changes,
+	}, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
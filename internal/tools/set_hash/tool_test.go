@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -52,3 +53,19 @@ func TestTool_Metadata(t *testing.T) {
 	assert.Equal(t, "set_hash", tool.Name())
 	assert.NotEmpty(t, tool.Description())
 }
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawHashBytes("user:1", map[string][]byte{"name": []byte("alice")})
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "user:1", "fields": map[string]string{"name": "bob", "age": "30"}})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Set 2 fields of hash "user:1": 1 new, 1 overwritten`, preview.Summary)
+	assert.Equal(t, []base.Change{
+		{Target: "age", Current: nil, New: "30"},
+		{Target: "name", Current: "alice", New: "bob"},
+	}, preview.Changes)
+}
@@ -78,6 +78,55 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 	}, nil
 }
 
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	preview := &base.Preview{Keys: []*base.KeyState{state}}
+	if params.NX && state.Exists() {
+		preview.Summary = fmt.Sprintf("Key %q exists, so NX leaves it unchanged", params.Key)
+		return preview, nil
+	}
+	if params.XX && !state.Exists() {
+		preview.Summary = fmt.Sprintf("Key %q does not exist, so XX leaves it unset", params.Key)
+		return preview, nil
+	}
+
+	var current any
+	if state.Type == "string" {
+		if current, err = base.CurrentString(ctx, t.client, params.Key); err != nil {
+			return nil, err
+		}
+	}
+	preview.Changes = []base.Change{{Target: params.Key, Current: current, New: params.Value}}
+
+	switch state.Type {
+	case "none":
+		preview.Summary = fmt.Sprintf("Create string key %q", params.Key)
+	case "string":
+		preview.Summary = fmt.Sprintf("Overwrite string key %q", params.Key)
+	default:
+		preview.Summary = fmt.Sprintf("Replace %s key %q with a string", state.Type, params.Key)
+	}
+	switch {
+	case params.TTLSeconds != nil:
+		preview.Summary += fmt.Sprintf(", expiring in %ds", *params.TTLSeconds)
+	case state.TTL >= 0:
+		preview.Summary += ", removing its TTL"
+	}
+	return preview, nil
+}
+
 // Init registers the tool with the registry.
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
 	reg.MustRegister(NewTool(client))
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,3 +134,24 @@ func TestSetStringTool_Metadata(t *testing.T) {
 	assert.Contains(t, tool.Description(), "string")
 	assert.NotNil(t, tool.InputSchema())
 }
+
+func TestTool_Preview(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("greeting", []byte("hello"))
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "greeting", "value": "bonjour"})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Equal(t, `Overwrite string key "greeting"`, preview.Summary)
+	assert.Equal(t, []base.Change{{Target: "greeting", Current: "hello", New: "bonjour"}}, preview.Changes)
+
+	value, _, _ := mockClient.GetString(context.Background(), "greeting")
+	assert.Equal(t, "hello", string(value))
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "greeting", "value": "bonjour", "nx": true})
+	result, err = tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Empty(t, result.(*base.Preview).Changes)
+}
@@ -0,0 +1,107 @@
+// Package setbit_bitmap implements the setbit_bitmap tool.
+package setbit_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the setbit_bitmap functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for setbit_bitmap tool.
+type Input struct {
+	Key    string `json:"key" jsonschema:"required,description=Bitmap key"`
+	Offset int64  `json:"offset" jsonschema:"required,minimum=0,description=Bit offset; 0 is the most significant bit of the first byte"`
+	Value  int64  `json:"value" jsonschema:"required,minimum=0,maximum=1,description=Bit value: 0 or 1"`
+}
+
+// Output represents the output of setbit_bitmap tool.
+type Output struct {
+	Key      string `json:"key"`
+	Offset   int64  `json:"offset"`
+	Value    int64  `json:"value"`
+	Previous int64  `json:"previous" jsonschema:"description=Value of the bit before the call"`
+}
+
+// NewTool creates a new setbit_bitmap tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"setbit_bitmap",
+			"Set or clear one bit of a bitmap with SETBIT. The string grows with zero bytes to reach offset. Returns the previous value of the bit",
+			Input{},
+		),
+		client: client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := t.client.SetBit(ctx, params.Key, params.Offset, params.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set bit %d of key %q: %w", params.Offset, params.Key, err)
+	}
+	return Output{Key: params.Key, Offset: params.Offset, Value: params.Value, Previous: previous}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.client.GetBit(ctx, params.Key, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bit %d of key %q: %w", params.Offset, params.Key, err)
+	}
+	summary := fmt.Sprintf("Set bit %d of key %q to %d", params.Offset, params.Key, params.Value)
+	if size := params.Offset/8 + 1; size > state.Size {
+		summary += fmt.Sprintf(", growing it from %d to %d bytes", state.Size, size)
+	}
+	return &base.Preview{
+		Summary: summary,
+		Keys:    []*base.KeyState{state},
+		Changes: []base.Change{{Target: fmt.Sprintf("%s bit %d", params.Key, params.Offset), Current: current, New: params.Value}},
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Key == "" {
+		return params, fmt.Errorf("key cannot be empty")
+	}
+	if params.Offset < 0 {
+		return params, fmt.Errorf("offset cannot be negative")
+	}
+	if params.Value != 0 && params.Value != 1 {
+		return params, fmt.Errorf("value must be 0 or 1")
+	}
+	return params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,71 @@
+package setbit_bitmap
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBitBitmap_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"flags","offset":9,"value":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Offset: 9, Value: 1}, result)
+
+	value, _, err := mockClient.GetString(ctx, "flags")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x40}, value)
+
+	result, err = tool.Execute(ctx, json.RawMessage(`{"key":"flags","offset":9,"value":0}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "flags", Offset: 9, Value: 0, Previous: 1}, result)
+}
+
+func TestSetBitBitmap_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","offset":0,"value":1}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"flags","offset":-1,"value":1}`))
+	assert.EqualError(t, err, "offset cannot be negative")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"flags","offset":0,"value":2}`))
+	assert.EqualError(t, err, "value must be 0 or 1")
+}
+
+func TestSetBitBitmap_Execute_WrongType(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.PushList(ctx, "flags", []string{"a"}, true)
+	require.NoError(t, err)
+
+	_, err = NewTool(mockClient).Execute(ctx, json.RawMessage(`{"key":"flags","offset":0,"value":1}`))
+	assert.ErrorContains(t, err, "WRONGTYPE")
+}
+
+func TestSetBitBitmap_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.SetString(ctx, "flags", "\x80", nil, false, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	preview, err := tool.Preview(ctx, json.RawMessage(`{"key":"flags","offset":16,"value":1}`))
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Set bit 16 of key "flags" to 1, growing it from 1 to 3 bytes`, p.Summary)
+	assert.Equal(t, []base.Change{{Target: "flags bit 16", Current: int64(0), New: int64(1)}}, p.Changes)
+
+	preview, err = tool.Preview(ctx, json.RawMessage(`{"key":"flags","offset":0,"value":0}`))
+	require.NoError(t, err)
+	p = preview.(*base.Preview)
+	assert.Equal(t, `Set bit 0 of key "flags" to 0`, p.Summary)
+	assert.Equal(t, []base.Change{{Target: "flags bit 0", Current: int64(1), New: int64(0)}}, p.Changes)
+}
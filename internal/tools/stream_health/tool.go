@@ -0,0 +1,169 @@
+// Package stream_health implements the stream_health tool.
+package stream_health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// defaultIdleThresholdMs is how long a consumer may go without reading
+// before it counts as idle.
+const defaultIdleThresholdMs = 300000
+
+// Tool implements the stream_health functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for stream_health tool.
+type Input struct {
+	Key             string `json:"key" jsonschema:"required,description=Stream key"`
+	IdleThresholdMs int64  `json:"idle_threshold_ms,omitempty" jsonschema:"minimum=1,description=Milliseconds without reading after which a consumer counts as idle (default: 300000)"`
+}
+
+// Group is the health of a consumer group.
+type Group struct {
+	Name string `json:"name"`
+	// Lag is the number of entries not yet delivered to the group, or null
+	// when the server cannot tell.
+	Lag             *int64 `json:"lag"`
+	Pending         int64  `json:"pending"`
+	LastDeliveredID string `json:"last_delivered_id"`
+	// OldestPendingID and OldestPendingIdleMs describe the pending entry
+	// with the lowest ID.
+	OldestPendingID     string   `json:"oldest_pending_id,omitempty"`
+	OldestPendingIdleMs int64    `json:"oldest_pending_idle_ms,omitempty"`
+	Consumers           int64    `json:"consumers"`
+	IdleConsumers       []string `json:"idle_consumers"`
+	// Stalled is set when the group has entries to process but no consumer
+	// read within the idle threshold; Reason says why.
+	Stalled bool   `json:"stalled"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Output represents the output of stream_health tool.
+type Output struct {
+	Key             string   `json:"key"`
+	Length          int64    `json:"length"`
+	LastGeneratedID string   `json:"last_generated_id"`
+	IdleThresholdMs int64    `json:"idle_threshold_ms"`
+	Groups          []Group  `json:"groups"`
+	StalledGroups   []string `json:"stalled_groups"`
+}
+
+// NewTool creates a new stream_health tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("stream_health", "Report the health of every consumer group of a stream: lag and pending entries and the idle time of the oldest pending entry and the consumers idle beyond idle_threshold_ms. Groups with entries to process but no consumer reading within the threshold are flagged as stalled", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	threshold := params.IdleThresholdMs
+	if threshold <= 0 {
+		threshold = defaultIdleThresholdMs
+	}
+
+	info, err := t.client.GetStreamInfo(ctx, params.Key, false, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stream %q: %w", params.Key, err)
+	}
+	groups, err := t.client.GetStreamGroups(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups of stream %q: %w", params.Key, err)
+	}
+
+	output := &Output{
+		Key:             params.Key,
+		Length:          info.Length,
+		LastGeneratedID: info.LastGeneratedID,
+		IdleThresholdMs: threshold,
+		Groups:          make([]Group, 0, len(groups)),
+		StalledGroups:   []string{},
+	}
+	for _, g := range groups {
+		group, err := t.groupHealth(ctx, params.Key, info, g, threshold)
+		if err != nil {
+			return nil, err
+		}
+		output.Groups = append(output.Groups, *group)
+		if group.Stalled {
+			output.StalledGroups = append(output.StalledGroups, group.Name)
+		}
+	}
+	return output, nil
+}
+
+// groupHealth assesses group g of the stream described by info.
+func (t *Tool) groupHealth(ctx context.Context, key string, info *client.StreamInfo, g client.StreamGroupInfo, threshold int64) (*Group, error) {
+	group := &Group{
+		Name:            g.Name,
+		Lag:             g.Lag,
+		Pending:         g.Pending,
+		LastDeliveredID: g.LastDeliveredID,
+		Consumers:       g.Consumers,
+		IdleConsumers:   []string{},
+	}
+	// The lag is unknown after deletions, but a group that read the last
+	// entry has none.
+	if group.Lag == nil && g.LastDeliveredID == info.LastGeneratedID {
+		var zero int64
+		group.Lag = &zero
+	}
+
+	if g.Pending > 0 {
+		oldest, err := t.client.PendingStream(ctx, key, g.Name, client.StreamPendingOptions{Count: 1})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pending entries of group %q of stream %q: %w", g.Name, key, err)
+		}
+		if len(oldest) > 0 {
+			group.OldestPendingID, group.OldestPendingIdleMs = oldest[0].ID, oldest[0].IdleMs
+		}
+	}
+
+	consumers, err := t.client.GetStreamConsumers(ctx, key, g.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumers of group %q of stream %q: %w", g.Name, key, err)
+	}
+	for _, c := range consumers {
+		// Inactive time counts from the last successful read, so a consumer
+		// polling without getting entries does not look busy. Servers before
+		// Valkey 7.2 only report the idle time.
+		inactive := c.InactiveMs
+		if inactive < 0 {
+			inactive = c.IdleMs
+		}
+		if inactive >= threshold {
+			group.IdleConsumers = append(group.IdleConsumers, c.Name)
+		}
+	}
+
+	backlog := g.Pending > 0 || group.Lag == nil || *group.Lag > 0
+	switch {
+	case !backlog:
+	case len(consumers) == 0:
+		group.Stalled, group.Reason = true, "no consumers"
+	case len(group.IdleConsumers) == len(consumers):
+		group.Stalled, group.Reason = true, fmt.Sprintf("no consumer read in the last %dms", threshold)
+	}
+	return group, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
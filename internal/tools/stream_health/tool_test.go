@@ -0,0 +1,89 @@
+package stream_health
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	mockClient := client.NewMockClient()
+	mockClient.Clock = func() time.Time { return now }
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	// "workers" read two entries ten minutes ago and stopped; "audit" is
+	// caught up; "mailer" has never had a consumer.
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "audit", "0", false, nil))
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "mailer", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 2, false)
+	require.NoError(t, err)
+	now = now.Add(10 * time.Minute)
+	_, err = mockClient.ReadStreamGroup(ctx, "jobs", "audit", "a1", ">", 0, true)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output := result.(*Output)
+	assert.Equal(t, int64(3), output.Length)
+	assert.Equal(t, int64(defaultIdleThresholdMs), output.IdleThresholdMs)
+	assert.Equal(t, []string{"mailer", "workers"}, output.StalledGroups)
+
+	zero, one, three := int64(0), int64(1), int64(3)
+	assert.Equal(t, []Group{
+		{Name: "audit", Lag: &zero, LastDeliveredID: "3-0", Consumers: 1, IdleConsumers: []string{}},
+		{Name: "mailer", Lag: &three, LastDeliveredID: "0-0", IdleConsumers: []string{}, Stalled: true, Reason: "no consumers"},
+		{
+			Name: "workers", Lag: &one, Pending: 2, LastDeliveredID: "2-0",
+			OldestPendingID: "1-0", OldestPendingIdleMs: 600000,
+			Consumers: 1, IdleConsumers: []string{"w1"},
+			Stalled: true, Reason: "no consumer read in the last 300000ms",
+		},
+	}, output.Groups)
+
+	// A longer threshold keeps w1 active.
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "idle_threshold_ms": 3600000})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mailer"}, result.(*Output).StalledGroups)
+}
+
+func TestTool_Execute_UnknownLag(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		GetStreamInfoFunc: func(ctx context.Context, key string, full bool, count int64) (*client.StreamInfo, error) {
+			return &client.StreamInfo{Length: 2, LastGeneratedID: "9-0"}, nil
+		},
+		GetStreamGroupsFunc: func(ctx context.Context, key string) ([]client.StreamGroupInfo, error) {
+			return []client.StreamGroupInfo{
+				{Name: "caught-up", LastDeliveredID: "9-0", Consumers: 1},
+				{Name: "behind", LastDeliveredID: "4-0", Consumers: 1},
+			}, nil
+		},
+		GetStreamConsumersFunc: func(ctx context.Context, key, group string) ([]client.StreamConsumerInfo, error) {
+			// Before Valkey 7.2 only the idle time is known.
+			return []client.StreamConsumerInfo{{Name: "c", IdleMs: 400000, InactiveMs: -1}}, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs"}`))
+	require.NoError(t, err)
+	output := result.(*Output)
+	require.NotNil(t, output.Groups[0].Lag)
+	assert.Zero(t, *output.Groups[0].Lag)
+	assert.False(t, output.Groups[0].Stalled)
+	assert.Nil(t, output.Groups[1].Lag)
+	assert.True(t, output.Groups[1].Stalled)
+	assert.Equal(t, []string{"behind"}, output.StalledGroups)
+}
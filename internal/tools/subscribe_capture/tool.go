@@ -0,0 +1,140 @@
+// Package subscribe_capture implements the subscribe_capture tool.
+package subscribe_capture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+const (
+	defaultMaxMessages = 100
+	maxMessagesLimit   = 1000
+)
+
+// Tool implements the subscribe_capture functionality.
+type Tool struct {
+	base.BaseTool
+	client     client.ValkeyClient
+	maxTimeout time.Duration
+	now        func() time.Time
+}
+
+// Input represents the input for subscribe_capture tool.
+type Input struct {
+	Channels      []string `json:"channels,omitempty" jsonschema:"description=Channels to subscribe to with SUBSCRIBE"`
+	Patterns      []string `json:"patterns,omitempty" jsonschema:"description=Glob patterns of channels to subscribe to with PSUBSCRIBE"`
+	ShardChannels []string `json:"shard_channels,omitempty" jsonschema:"description=Shard channels to subscribe to with SSUBSCRIBE"`
+	DurationMs    *int64   `json:"duration_ms,omitempty" jsonschema:"description=Milliseconds to listen for (default: 10000; capped at the server maximum)"`
+	MaxMessages   int64    `json:"max_messages,omitempty" jsonschema:"minimum=1,maximum=1000,description=Stop after capturing this many messages (default: 100)"`
+}
+
+// Message is a captured message.
+type Message struct {
+	Channel string `json:"channel"`
+	// Pattern is the subscribed pattern the channel matched.
+	Pattern string    `json:"pattern,omitempty"`
+	Message any       `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// Output represents the output of subscribe_capture tool.
+type Output struct {
+	Messages []Message `json:"messages"`
+	Count    int       `json:"count"`
+	// DurationMs is how long the capture listened.
+	DurationMs int64 `json:"duration_ms"`
+	// Truncated is set when max_messages ended the capture early.
+	Truncated bool `json:"truncated,omitempty"`
+	// Partial is set when the call was cancelled before the capture ended.
+	Partial bool `json:"partial,omitempty"`
+}
+
+// NewTool creates a new subscribe_capture tool that listens at most
+// maxTimeout.
+func NewTool(client client.ValkeyClient, maxTimeout time.Duration) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"subscribe_capture",
+			"Subscribe to Pub/Sub channels, channel patterns and shard channels for up to duration_ms or max_messages and return the messages received with their channel, matched pattern and arrival time. Shows what services are broadcasting; messages published before the call are not seen",
+			Input{},
+		),
+		client:     client,
+		maxTimeout: maxTimeout,
+		now:        time.Now,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if len(params.Channels) == 0 && len(params.Patterns) == 0 && len(params.ShardChannels) == 0 {
+		return nil, fmt.Errorf("channels, patterns or shard_channels is required")
+	}
+	if params.DurationMs != nil && *params.DurationMs <= 0 {
+		return nil, fmt.Errorf("duration_ms must be positive")
+	}
+	duration, err := base.BlockTimeout(params.DurationMs, t.maxTimeout)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case params.MaxMessages < 0:
+		return nil, fmt.Errorf("max_messages must be positive")
+	case params.MaxMessages == 0:
+		params.MaxMessages = defaultMaxMessages
+	case params.MaxMessages > maxMessagesLimit:
+		params.MaxMessages = maxMessagesLimit
+	}
+
+	captureCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var mu sync.Mutex
+	output := Output{Messages: []Message{}}
+	done := false
+	start := t.now()
+	err = t.client.Subscribe(captureCtx, params.Channels, params.Patterns, params.ShardChannels, func(msg client.PubSubMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return
+		}
+		output.Messages = append(output.Messages, Message{
+			Channel: msg.Channel,
+			Pattern: msg.Pattern,
+			Message: base.SafeValue(msg.Message),
+			Time:    t.now(),
+		})
+		if int64(len(output.Messages)) >= params.MaxMessages {
+			output.Truncated = true
+			done = true
+			cancel()
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	done = true
+	output.Count = len(output.Messages)
+	output.DurationMs = t.now().Sub(start).Milliseconds()
+	output.Partial = ctx.Err() != nil
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration) {
+	reg.MustRegister(NewTool(client, maxTimeout))
+}
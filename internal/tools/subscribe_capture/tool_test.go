@@ -0,0 +1,102 @@
+package subscribe_capture
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// publishWhenSubscribed publishes messages to channel once a subscriber
+// is listening on it.
+func publishWhenSubscribed(mockClient *client.MockClient, channel string, messages ...string) {
+	go func() {
+		ctx := context.Background()
+		for {
+			counts, _ := mockClient.PubSubNumSub(ctx, []string{channel}, false)
+			if counts[channel] > 0 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		for _, message := range messages {
+			_, _ = mockClient.Publish(ctx, channel, message, false)
+		}
+	}()
+}
+
+func TestSubscribeCapture_Execute_MaxMessages(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+	publishWhenSubscribed(mockClient, "orders", "created", "paid", "shipped")
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"channels":["orders"],"patterns":["ord*"],"max_messages":3,"duration_ms":5000}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, 3, output.Count)
+	assert.True(t, output.Truncated)
+	assert.False(t, output.Partial)
+	assert.Less(t, output.DurationMs, int64(5000))
+	for _, msg := range output.Messages {
+		assert.Equal(t, "orders", msg.Channel)
+		assert.False(t, msg.Time.IsZero())
+	}
+	// Each message reaches both the channel and the pattern subscription.
+	assert.Equal(t, "created", output.Messages[0].Message)
+	assert.Equal(t, "created", output.Messages[1].Message)
+	assert.ElementsMatch(t, []string{"", "ord*"}, []string{output.Messages[0].Pattern, output.Messages[1].Pattern})
+}
+
+func TestSubscribeCapture_Execute_Duration(t *testing.T) {
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+	publishWhenSubscribed(mockClient, "events", "\xff\xfe")
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"channels":["events"],"duration_ms":50}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	require.Equal(t, 1, output.Count)
+	assert.False(t, output.Truncated)
+	assert.Equal(t, base.SafeValue([]byte("\xff\xfe")), output.Messages[0].Message)
+	assert.GreaterOrEqual(t, output.DurationMs, int64(50))
+}
+
+func TestSubscribeCapture_Execute_Cancelled(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"shard_channels":["orders"]}`))
+	require.NoError(t, err)
+	assert.True(t, result.(Output).Partial)
+}
+
+func TestSubscribeCapture_Execute_CapsDuration(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.SubscribeFunc = func(ctx context.Context, channels, patterns, shardChannels []string, onMessage func(client.PubSubMessage)) error {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.LessOrEqual(t, time.Until(deadline), 10*time.Millisecond)
+		return nil
+	}
+	tool := NewTool(mockClient, 10*time.Millisecond)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"channels":["orders"],"duration_ms":60000}`))
+	require.NoError(t, err)
+}
+
+func TestSubscribeCapture_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{}`))
+	assert.EqualError(t, err, "channels, patterns or shard_channels is required")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"channels":["orders"],"duration_ms":0}`))
+	assert.EqualError(t, err, "duration_ms must be positive")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"channels":["orders"],"max_messages":-1}`))
+	assert.EqualError(t, err, "max_messages must be positive")
+}
@@ -0,0 +1,107 @@
+// Package undo_change implements the undo_change tool.
+package undo_change
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/ItsJooL/valkey-mcp-server/internal/undo"
+)
+
+// Tool implements the undo_change functionality.
+type Tool struct {
+	base.BaseTool
+	client  client.ValkeyClient
+	journal *undo.Journal
+}
+
+// Input represents the input for undo_change tool.
+type Input struct {
+	ID int64 `json:"id" jsonschema:"required,minimum=1,description=ID of the change to revert, from list_recent_changes"`
+}
+
+// NewTool creates a new undo_change tool.
+func NewTool(client client.ValkeyClient, journal *undo.Journal) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"undo_change",
+			"Revert a change listed by list_recent_changes: keys are restored to their previous value and TTL, and keys the change created are deleted. Later changes to the same keys are lost",
+			Input{},
+		),
+		client:  client,
+		journal: journal,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	result, err := t.journal.Undo(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to undo change %d: %w", params.ID, err)
+	}
+	return result, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	change, ok := t.journal.Get(params.ID)
+	if !ok {
+		return nil, fmt.Errorf("change %d is not in the journal", params.ID)
+	}
+	keys := make([]string, 0, len(change.Keys))
+	restored, deleted := 0, 0
+	for _, s := range change.Keys {
+		keys = append(keys, s.Key)
+		switch {
+		case s.Skipped != "":
+		case s.Existed:
+			restored++
+		default:
+			deleted++
+		}
+	}
+	states, err := base.DescribeKeys(ctx, t.client, keys)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Revert change %d made by %s: restore %d keys and delete %d; %d keys cannot be restored", change.ID, change.Tool, restored, deleted, len(keys)-restored-deleted),
+		Keys:    states,
+	}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	change, ok := t.journal.Get(params.ID)
+	if !ok {
+		return nil, nil
+	}
+	impact := &registry.Impact{Summary: fmt.Sprintf("Revert change %d made by %s", change.ID, change.Tool)}
+	for _, s := range change.Keys {
+		impact.Keys = append(impact.Keys, s.Key)
+	}
+	return impact, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, journal *undo.Journal) {
+	reg.MustRegister(NewTool(client, journal))
+}
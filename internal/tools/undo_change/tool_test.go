@@ -0,0 +1,73 @@
+package undo_change
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/ItsJooL/valkey-mcp-server/internal/undo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deleteRecorded deletes key as a recorded delete_keys call.
+func deleteRecorded(t *testing.T, c client.ValkeyClient, journal *undo.Journal, key string) {
+	t.Helper()
+	ctx := context.Background()
+	input, _ := json.Marshal(map[string]interface{}{"keys": []string{key}})
+	done, err := journal.Record(ctx, "delete_keys", input)
+	require.NoError(t, err)
+	_, err = c.DeleteKey(ctx, key)
+	done(err)
+}
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("greeting", []byte("hello"))
+	journal := undo.New(mockClient, undo.Config{})
+	deleteRecorded(t, mockClient, journal, "greeting")
+	tool := NewTool(mockClient, journal)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"id":1}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"greeting"}, result.(*undo.Result).Restored)
+
+	value, _, _ := mockClient.GetString(ctx, "greeting")
+	assert.Equal(t, "hello", string(value))
+}
+
+func TestTool_Execute_UnknownChange(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), undo.New(client.NewMockClient(), undo.Config{}))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"id":7}`))
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestTool_Preview(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("greeting", []byte("hello"))
+	journal := undo.New(mockClient, undo.Config{})
+	deleteRecorded(t, mockClient, journal, "greeting")
+	tool := NewTool(mockClient, journal).(*Tool)
+
+	result, err := tool.Preview(ctx, json.RawMessage(`{"id":1}`))
+	require.NoError(t, err)
+	preview := result.(*base.Preview)
+	assert.Contains(t, preview.Summary, "restore 1 keys and delete 0")
+	assert.False(t, preview.Keys[0].Exists())
+
+	exists, _ := mockClient.ExistsKey(ctx, "greeting")
+	assert.False(t, exists)
+}
+
+func TestTool_Metadata(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), undo.New(client.NewMockClient(), undo.Config{}))
+
+	assert.Equal(t, "undo_change", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
@@ -0,0 +1,342 @@
+// Package watch_keyspace implements the watch_keyspace tool.
+package watch_keyspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/notifications"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+const (
+	// notifyParameter is the configuration parameter selecting the
+	// notifications the server publishes.
+	notifyParameter = notifications.Parameter
+	// eventClasses are the notifyParameter flags that select event types.
+	eventClasses = "Ag$lshzxetmdn"
+	// allClasses are the event classes the A flag stands for.
+	allClasses = "g$lshzxetd"
+
+	defaultMaxSamples = 100
+	maxSamplesLimit   = 1000
+	// maxKeys caps the keys listed with their counts.
+	maxKeys = 100
+	// restoreTimeout bounds restoring notifyParameter after the watch, which
+	// runs even when the call was cancelled.
+	restoreTimeout = 5 * time.Second
+)
+
+// eventClass maps the events a server publishes to the notifyParameter
+// class that selects them.
+var eventClass = map[string]string{
+	"del": "g", "rename_from": "g", "rename_to": "g", "move_from": "g", "move_to": "g",
+	"copy_to": "g", "restore": "g", "expire": "g", "persist": "g",
+	"set": "$", "setrange": "$", "incrby": "$", "incrbyfloat": "$", "append": "$",
+	"lpush": "l", "rpush": "l", "lpop": "l", "rpop": "l", "linsert": "l", "lset": "l",
+	"lrem": "l", "ltrim": "l", "sortstore": "l",
+	"sadd": "s", "srem": "s", "spop": "s", "sinterstore": "s", "sunionstore": "s", "sdiffstore": "s",
+	"hset": "h", "hdel": "h", "hincrby": "h", "hincrbyfloat": "h", "hexpire": "h", "hpersist": "h", "hexpired": "h",
+	"zadd": "z", "zincr": "z", "zrem": "z", "zrembyscore": "z", "zrembyrank": "z",
+	"zinterstore": "z", "zunionstore": "z", "zdiffstore": "z", "zrangestore": "z",
+	"xadd": "t", "xtrim": "t", "xdel": "t", "xsetid": "t", "xgroup-create": "t",
+	"xgroup-createconsumer": "t", "xgroup-delconsumer": "t", "xgroup-destroy": "t", "xgroup-setid": "t",
+	"expired": "x", "evicted": "e", "key-miss": "m", "new": "n",
+}
+
+// Tool implements the watch_keyspace functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+	// leases keep overlapping watches and resource subscriptions from
+	// restoring notifyParameter while another still needs notifications.
+	leases     *notifications.Leases
+	maxTimeout time.Duration
+	now        func() time.Time
+}
+
+// Input represents the input for watch_keyspace tool.
+type Input struct {
+	Pattern    string   `json:"pattern,omitempty" jsonschema:"description=Glob pattern of the keys to watch (default: *)"`
+	Events     []string `json:"events,omitempty" jsonschema:"description=Only count these events such as set del expired or evicted (default: all)"`
+	DurationMs *int64   `json:"duration_ms,omitempty" jsonschema:"description=Milliseconds to watch for (default: 10000; capped at the server maximum)"`
+	MaxSamples int64    `json:"max_samples,omitempty" jsonschema:"minimum=1,maximum=1000,description=Events kept in the timeline (default: 100)"`
+	Enable     bool     `json:"enable,omitempty" jsonschema:"description=Turn on notify-keyspace-events for the watch when it is off and restore it afterwards (needs confirmation)"`
+}
+
+// KeyEvents counts the events of one key.
+type KeyEvents struct {
+	Key    string           `json:"key"`
+	Total  int64            `json:"total"`
+	Events map[string]int64 `json:"events"`
+}
+
+// Event is a notification in the timeline.
+type Event struct {
+	Time  time.Time `json:"time"`
+	Key   string    `json:"key"`
+	Event string    `json:"event"`
+}
+
+// Output represents the output of watch_keyspace tool.
+type Output struct {
+	Pattern string `json:"pattern"`
+	// Channel is "keyspace" or "keyevent", the notifications listened to.
+	Channel string `json:"channel"`
+	// Notifications is notify-keyspace-events during the watch; Enabled is
+	// set when the tool changed it for the watch.
+	Notifications string `json:"notifications,omitempty"`
+	Enabled       bool   `json:"enabled,omitempty"`
+	DurationMs    int64  `json:"duration_ms"`
+	Total         int64  `json:"total"`
+	// Events counts the notifications of each event type.
+	Events map[string]int64 `json:"events"`
+	// Keys lists the most active keys; DistinctKeys counts all of them.
+	Keys         []KeyEvents `json:"keys"`
+	DistinctKeys int         `json:"distinct_keys"`
+	// Timeline holds the first max_samples notifications.
+	Timeline []Event `json:"timeline"`
+	// Partial is set when the call was cancelled before the window ended.
+	Partial bool   `json:"partial,omitempty"`
+	Note    string `json:"note,omitempty"`
+}
+
+// NewTool creates a new watch_keyspace tool that watches at most
+// maxTimeout and shares notifications with the other holders of leases.
+func NewTool(client client.ValkeyClient, maxTimeout time.Duration, leases *notifications.Leases) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"watch_keyspace",
+			"Watch keyspace notifications for keys matching a pattern during a time window and return per-event and per-key counts with a sample timeline. Shows what is changing right now. Needs notify-keyspace-events; enable turns it on for the watch and restores the original value afterwards",
+			Input{},
+		),
+		client:     client,
+		leases:     leases,
+		maxTimeout: maxTimeout,
+		now:        time.Now,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (result interface{}, err error) {
+	params, duration, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := Output{Pattern: params.Pattern, Channel: "keyspace", Events: map[string]int64{}, Timeline: []Event{}}
+	// The lease is held before notifyParameter is read, so a concurrent
+	// release cannot restore it behind this watch.
+	release := t.leases.Acquire()
+	defer func() {
+		restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreTimeout)
+		defer cancel()
+		if restoreErr := release(restoreCtx); restoreErr != nil && err == nil {
+			result, err = nil, restoreErr
+		}
+	}()
+	current, known := t.notifications(ctx)
+	watch := t.client.WatchKeyspace
+	if known {
+		flags := notificationFlags(current, params.Events)
+		if flags != current {
+			if !params.Enable {
+				return nil, notificationsError(current, flags)
+			}
+			if err := t.leases.Enable(ctx, current, flags); err != nil {
+				return nil, err
+			}
+			output.Enabled = true
+		}
+		output.Notifications = flags
+		if !strings.Contains(flags, "K") {
+			output.Channel = "keyevent"
+			watch = t.client.WatchKeyevents
+		}
+	} else {
+		output.Note = fmt.Sprintf("%s could not be read; the watch assumes keyspace notifications are on", notifyParameter)
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var mu sync.Mutex
+	keys := make(map[string]*KeyEvents)
+	done := false
+	start := t.now()
+	err = watch(watchCtx, params.Pattern, func(event client.KeyspaceEvent) {
+		if len(params.Events) > 0 && !slices.Contains(params.Events, event.Event) {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return
+		}
+		output.Total++
+		output.Events[event.Event]++
+		k, ok := keys[event.Key]
+		if !ok {
+			k = &KeyEvents{Key: event.Key, Events: map[string]int64{}}
+			keys[event.Key] = k
+		}
+		k.Total++
+		k.Events[event.Event]++
+		if int64(len(output.Timeline)) < params.MaxSamples {
+			output.Timeline = append(output.Timeline, Event{Time: t.now(), Key: event.Key, Event: event.Event})
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch keys matching %q: %w", params.Pattern, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	done = true
+	output.DurationMs = t.now().Sub(start).Milliseconds()
+	output.Partial = ctx.Err() != nil
+	output.DistinctKeys = len(keys)
+	output.Keys = make([]KeyEvents, 0, min(len(keys), maxKeys))
+	for _, k := range keys {
+		output.Keys = append(output.Keys, *k)
+	}
+	sort.Slice(output.Keys, func(i, j int) bool {
+		if output.Keys[i].Total != output.Keys[j].Total {
+			return output.Keys[i].Total > output.Keys[j].Total
+		}
+		return output.Keys[i].Key < output.Keys[j].Key
+	})
+	if len(output.Keys) > maxKeys {
+		output.Keys = output.Keys[:maxKeys]
+	}
+	return output, nil
+}
+
+// Assess implements registry.Assessor. Only calls that turn on
+// notifications need confirmation.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, _, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+	if !params.Enable {
+		return nil, nil
+	}
+	current, known := t.notifications(ctx)
+	flags := notificationFlags(current, params.Events)
+	if !known || flags == current {
+		return nil, nil
+	}
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Change configuration %q from %q to %q while watching keys matching %q, then restore it",
+			notifyParameter, current, flags, params.Pattern),
+		ConfigParameters: []string{notifyParameter},
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, duration, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := fmt.Sprintf("Watch events on keys matching %q for %dms", params.Pattern, duration.Milliseconds())
+	current, known := t.notifications(ctx)
+	flags := notificationFlags(current, params.Events)
+	if !known || flags == current {
+		return &base.Preview{Summary: summary}, nil
+	}
+	if !params.Enable {
+		return &base.Preview{
+			Summary: fmt.Sprintf("%s; the call would fail without enable", notificationsError(current, flags)),
+		}, nil
+	}
+	return &base.Preview{
+		Summary: summary + fmt.Sprintf(", turning on keyspace notifications meanwhile and restoring %s afterwards", notifyParameter),
+		Changes: []base.Change{{Target: notifyParameter, Current: current, New: flags}},
+	}, nil
+}
+
+// parse validates input, applies defaults and returns how long to watch.
+func (t *Tool) parse(input json.RawMessage) (Input, time.Duration, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, 0, err
+	}
+	if params.Pattern == "" {
+		params.Pattern = "*"
+	}
+	if params.DurationMs != nil && *params.DurationMs <= 0 {
+		return params, 0, fmt.Errorf("duration_ms must be positive")
+	}
+	switch {
+	case params.MaxSamples < 0:
+		return params, 0, fmt.Errorf("max_samples must be positive")
+	case params.MaxSamples == 0:
+		params.MaxSamples = defaultMaxSamples
+	case params.MaxSamples > maxSamplesLimit:
+		params.MaxSamples = maxSamplesLimit
+	}
+	duration, err := base.BlockTimeout(params.DurationMs, t.maxTimeout)
+	return params, duration, err
+}
+
+// notifications returns the current notify-keyspace-events value, and false
+// when the server refuses CONFIG GET.
+func (t *Tool) notifications(ctx context.Context) (string, bool) {
+	config, err := t.client.ConfigGet(ctx, notifyParameter)
+	if err != nil {
+		return "", false
+	}
+	value, ok := config[notifyParameter]
+	return value, ok
+}
+
+// notificationFlags returns the notify-keyspace-events value a watch of
+// events needs given the current one: current itself when it already
+// publishes keyspace or key-event notifications for the classes of events,
+// or for some event class when events is empty. Events of unknown class are
+// assumed to be published.
+func notificationFlags(current string, events []string) string {
+	flags := current
+	if !strings.ContainsAny(flags, "KE") {
+		flags += "K"
+	}
+	if len(events) == 0 {
+		if !strings.ContainsAny(flags, eventClasses) {
+			flags += "A"
+		}
+		return flags
+	}
+	for _, event := range events {
+		class, ok := eventClass[event]
+		if !ok || strings.Contains(flags, class) || (strings.Contains(flags, "A") && strings.Contains(allClasses, class)) {
+			continue
+		}
+		flags += class
+	}
+	return flags
+}
+
+// notificationsError explains why current does not serve a watch that needs
+// flags.
+func notificationsError(current, flags string) error {
+	if notificationFlags(current, nil) != current {
+		return fmt.Errorf("keyspace notifications are off (%s is %q); pass enable to turn them on for the watch", notifyParameter, current)
+	}
+	return fmt.Errorf("keyspace notifications miss the requested events (%s is %q and lacks %q); pass enable to add them for the watch",
+		notifyParameter, current, strings.TrimPrefix(flags, current))
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration, leases *notifications.Leases) {
+	reg.MustRegister(NewTool(client, maxTimeout, leases))
+}
@@ -0,0 +1,249 @@
+package watch_keyspace
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/notifications"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// watchClient replays events to the watch calls of a MockClient and records
+// which kind of notifications was watched and the configuration meanwhile.
+type watchClient struct {
+	*client.MockClient
+	events  []client.KeyspaceEvent
+	watched string
+	config  string
+}
+
+func (c *watchClient) replay(ctx context.Context, kind string, onEvent func(client.KeyspaceEvent)) error {
+	c.watched = kind
+	config, _ := c.ConfigGet(ctx, notifyParameter)
+	c.config = config[notifyParameter]
+	for _, event := range c.events {
+		onEvent(event)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (c *watchClient) WatchKeyspace(ctx context.Context, keyPattern string, onEvent func(client.KeyspaceEvent)) error {
+	return c.replay(ctx, "keyspace", onEvent)
+}
+
+func (c *watchClient) WatchKeyevents(ctx context.Context, keyPattern string, onEvent func(client.KeyspaceEvent)) error {
+	return c.replay(ctx, "keyevent", onEvent)
+}
+
+func newWatchClient(t *testing.T, notifications string) *watchClient {
+	c := &watchClient{MockClient: client.NewMockClient()}
+	_, err := c.ConfigSet(context.Background(), notifyParameter, notifications)
+	require.NoError(t, err)
+	c.events = []client.KeyspaceEvent{
+		{Key: "user:1", Event: "set"},
+		{Key: "user:2", Event: "set"},
+		{Key: "user:1", Event: "expire"},
+		{Key: "user:1", Event: "expired"},
+		{Key: "user:3", Event: "del"},
+	}
+	return c
+}
+
+func TestWatchKeyspace_Execute_Counts(t *testing.T) {
+	c := newWatchClient(t, "KA")
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"pattern":"user:*","duration_ms":20,"max_samples":2}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, "keyspace", output.Channel)
+	assert.Equal(t, "KA", output.Notifications)
+	assert.False(t, output.Enabled)
+	assert.Equal(t, int64(5), output.Total)
+	assert.Equal(t, map[string]int64{"set": 2, "expire": 1, "expired": 1, "del": 1}, output.Events)
+	assert.Equal(t, 3, output.DistinctKeys)
+	require.Len(t, output.Keys, 3)
+	assert.Equal(t, KeyEvents{Key: "user:1", Total: 3, Events: map[string]int64{"set": 1, "expire": 1, "expired": 1}}, output.Keys[0])
+	assert.Equal(t, "user:2", output.Keys[1].Key)
+	require.Len(t, output.Timeline, 2)
+	assert.Equal(t, "user:2", output.Timeline[1].Key)
+	assert.GreaterOrEqual(t, output.DurationMs, int64(20))
+}
+
+func TestWatchKeyspace_Execute_FiltersEvents(t *testing.T) {
+	c := newWatchClient(t, "Eg$")
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c))
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"events":["set","del"],"duration_ms":10}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, "keyevent", output.Channel)
+	assert.Equal(t, "keyevent", c.watched)
+	assert.Equal(t, int64(3), output.Total)
+	assert.Equal(t, map[string]int64{"set": 2, "del": 1}, output.Events)
+}
+
+func TestWatchKeyspace_Execute_MissingEventClasses(t *testing.T) {
+	ctx := context.Background()
+	c := newWatchClient(t, "Ex")
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c)).(*Tool)
+
+	input := json.RawMessage(`{"events":["set","expired","del"],"duration_ms":10}`)
+	_, err := tool.Execute(ctx, input)
+	assert.EqualError(t, err, `keyspace notifications miss the requested events (notify-keyspace-events is "Ex" and lacks "$g"); pass enable to add them for the watch`)
+
+	preview, err := tool.Preview(ctx, input)
+	require.NoError(t, err)
+	assert.Contains(t, preview.(*base.Preview).Summary, `lacks "$g"`)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"events":["set","expired","del"],"duration_ms":10,"enable":true}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.True(t, output.Enabled)
+	assert.Equal(t, "Ex$g", c.config)
+	config, err := c.ConfigGet(ctx, notifyParameter)
+	require.NoError(t, err)
+	assert.Equal(t, "Ex", config[notifyParameter])
+}
+
+func TestWatchKeyspace_Execute_EnablesAndRestores(t *testing.T) {
+	ctx := context.Background()
+	c := newWatchClient(t, "")
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c)).(*Tool)
+
+	_, err := tool.Execute(ctx, json.RawMessage(`{"duration_ms":10}`))
+	assert.EqualError(t, err, `keyspace notifications are off (notify-keyspace-events is ""); pass enable to turn them on for the watch`)
+
+	impact, err := tool.Assess(ctx, json.RawMessage(`{"enable":true}`))
+	require.NoError(t, err)
+	require.NotNil(t, impact)
+	assert.Equal(t, []string{notifyParameter}, impact.ConfigParameters)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"duration_ms":10,"enable":true}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.True(t, output.Enabled)
+	assert.Equal(t, "KA", output.Notifications)
+	assert.Equal(t, "KA", c.config)
+	config, err := c.ConfigGet(ctx, notifyParameter)
+	require.NoError(t, err)
+	assert.Equal(t, "", config[notifyParameter])
+}
+
+func TestWatchKeyspace_Execute_RestoresWhenCancelled(t *testing.T) {
+	c := newWatchClient(t, "E")
+	c.events = nil
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c))
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	result, err := tool.Execute(ctx, json.RawMessage(`{"enable":true}`))
+	require.NoError(t, err)
+	assert.True(t, result.(Output).Partial)
+	assert.Equal(t, "EA", c.config)
+	config, err := c.ConfigGet(context.Background(), notifyParameter)
+	require.NoError(t, err)
+	assert.Equal(t, "E", config[notifyParameter])
+}
+
+// blockingClient signals each watch as it starts and holds it until its
+// context is done.
+type blockingClient struct {
+	*client.MockClient
+	started chan struct{}
+}
+
+func (c *blockingClient) WatchKeyspace(ctx context.Context, keyPattern string, onEvent func(client.KeyspaceEvent)) error {
+	c.started <- struct{}{}
+	<-ctx.Done()
+	return nil
+}
+
+func TestWatchKeyspace_Execute_OverlappingWatches(t *testing.T) {
+	ctx := context.Background()
+	c := &blockingClient{MockClient: client.NewMockClient(), started: make(chan struct{}, 2)}
+	_, err := c.ConfigSet(ctx, notifyParameter, "")
+	require.NoError(t, err)
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c))
+	notify := func() string {
+		config, err := c.ConfigGet(ctx, notifyParameter)
+		require.NoError(t, err)
+		return config[notifyParameter]
+	}
+
+	// watch starts a watch and returns a function ending it.
+	watch := func() func() Output {
+		watchCtx, cancel := context.WithCancel(ctx)
+		done := make(chan Output)
+		go func() {
+			result, err := tool.Execute(watchCtx, json.RawMessage(`{"enable":true}`))
+			assert.NoError(t, err)
+			output, _ := result.(Output)
+			done <- output
+		}()
+		<-c.started
+		return func() Output {
+			cancel()
+			return <-done
+		}
+	}
+
+	endFirst := watch()
+	endSecond := watch()
+	// The first watch turned notifications on; ending it must not turn
+	// them off under the second.
+	assert.True(t, endFirst().Enabled)
+	assert.Equal(t, "KA", notify())
+	assert.False(t, endSecond().Enabled)
+	assert.Equal(t, "", notify())
+}
+
+func TestWatchKeyspace_Assess_NotNeeded(t *testing.T) {
+	c := newWatchClient(t, "KEA")
+	tool := NewTool(c, base.DefaultMaxBlockTimeout, notifications.New(c)).(*Tool)
+
+	impact, err := tool.Assess(context.Background(), json.RawMessage(`{"enable":true}`))
+	require.NoError(t, err)
+	assert.Nil(t, impact)
+}
+
+func TestWatchKeyspace_Preview(t *testing.T) {
+	c := newWatchClient(t, "g")
+	tool := NewTool(c, time.Second, notifications.New(c)).(*Tool)
+
+	preview, err := tool.Preview(context.Background(), json.RawMessage(`{"pattern":"user:*","enable":true}`))
+	require.NoError(t, err)
+	assert.Equal(t, &base.Preview{
+		Summary: `Watch events on keys matching "user:*" for 1000ms, turning on keyspace notifications meanwhile and restoring notify-keyspace-events afterwards`,
+		Changes: []base.Change{{Target: notifyParameter, Current: "g", New: "gK"}},
+	}, preview)
+}
+
+func TestNotificationFlags(t *testing.T) {
+	tests := []struct {
+		current string
+		events  []string
+		want    string
+	}{
+		{"", nil, "KA"},
+		{"KEA", nil, "KEA"},
+		{"Ex", nil, "Ex"},
+		{"K", nil, "KA"},
+		{"x", nil, "xK"},
+		{"Ex", []string{"set", "expired"}, "Ex$"},
+		{"Ex", []string{"set", "lpush", "set"}, "Ex$l"},
+		{"KA", []string{"set", "evicted"}, "KA"},
+		{"KA", []string{"new", "key-miss"}, "KAnm"},
+		{"Kg", []string{"custom-module-event"}, "Kg"},
+		{"", []string{"del"}, "Kg"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, notificationFlags(tt.current, tt.events), "%q %v", tt.current, tt.events)
+	}
+}
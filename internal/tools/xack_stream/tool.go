@@ -0,0 +1,92 @@
+// Package xack_stream implements the xack_stream tool.
+package xack_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xack_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xack_stream tool.
+type Input struct {
+	Key   string   `json:"key" jsonschema:"required,description=Stream key"`
+	Group string   `json:"group" jsonschema:"required,description=Consumer group name"`
+	IDs   []string `json:"ids" jsonschema:"required,description=IDs of the entries to acknowledge"`
+}
+
+// Output represents the output of xack_stream tool.
+type Output struct {
+	// Acknowledged is the number of IDs that were pending.
+	Acknowledged int64 `json:"acknowledged"`
+}
+
+// NewTool creates a new xack_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xack_stream", "Acknowledge entries of a consumer group with XACK, removing them from its pending list", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	acked, err := t.client.AckStream(ctx, params.Key, params.Group, params.IDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge entries of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	return Output{Acknowledged: acked}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Acknowledge %d entries in group %q of stream %q", len(params.IDs), params.Group, params.Key),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if len(params.IDs) == 0 {
+		return nil, fmt.Errorf("ids cannot be empty")
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,46 @@
+package xack_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "ids": []string{"1-0", "9-0"}})
+	preview, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Acknowledge 2 entries in group "workers" of stream "jobs"`, preview.(*base.Preview).Summary)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Acknowledged: 1}, result)
+
+	summary, err := mockClient.PendingStreamSummary(ctx, "jobs", "workers")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), summary.Count)
+	assert.Equal(t, "2-0", summary.Lowest)
+}
+
+func TestTool_Execute_EmptyIDs(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","group":"workers","ids":[]}`))
+	assert.EqualError(t, err, "ids cannot be empty")
+}
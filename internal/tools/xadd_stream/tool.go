@@ -16,18 +16,25 @@ type Tool struct {
 }
 
 type Input struct {
-	Key    string            `json:"key" jsonschema:"required,description=Stream key"`
-	ID     string            `json:"id" jsonschema:"description=Stream entry ID (* for auto-generate, defaults to *)"`
-	Fields map[string]string `json:"fields" jsonschema:"required,description=Field-value pairs"`
+	Key         string            `json:"key" jsonschema:"required,description=Stream key"`
+	ID          string            `json:"id" jsonschema:"description=Stream entry ID (* for auto-generate, defaults to *)"`
+	Fields      map[string]string `json:"fields" jsonschema:"required,description=Field-value pairs"`
+	MaxLen      *int64            `json:"maxlen,omitempty" jsonschema:"minimum=0,description=Trim the stream to at most this many entries after adding"`
+	MinID       string            `json:"minid,omitempty" jsonschema:"description=Evict entries with IDs lower than this after adding"`
+	Approximate bool              `json:"approximate,omitempty" jsonschema:"description=Trim with ~: only whole nodes are evicted so a few more entries may remain but trimming is cheaper"`
+	Limit       int64             `json:"limit,omitempty" jsonschema:"minimum=1,description=With approximate: maximum entries to evict"`
+	NoMkStream  bool              `json:"nomkstream,omitempty" jsonschema:"description=Do not create the stream when it does not exist"`
 }
 
 type Output struct {
 	ID string `json:"id"`
+	// Added is false when nomkstream was set and the stream did not exist.
+	Added bool `json:"added"`
 }
 
 func NewTool(client client.ValkeyClient) registry.Tool {
 	return &Tool{
-		BaseTool: base.NewBaseTool("xadd_stream", "Add entry to stream with specified fields", Input{}),
+		BaseTool: base.NewBaseTool("xadd_stream", "Add entry to stream with specified fields. maxlen or minid caps the stream in the same call", Input{}),
 		client:   client,
 	}
 }
@@ -46,18 +53,101 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 		return nil, fmt.Errorf("fields cannot be empty")
 	}
 
+	trim, err := base.StreamTrim(params.MaxLen, params.MinID, params.Approximate, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
 	// Default to auto-generate ID if not provided
 	id := params.ID
 	if id == "" {
 		id = "*"
 	}
 
-	resultID, err := t.client.AddStream(ctx, params.Key, id, params.Fields)
+	resultID, err := t.client.AddStream(ctx, params.Key, id, params.Fields, client.StreamAddOptions{NoMkStream: params.NoMkStream, Trim: trim})
 	if err != nil {
 		return nil, fmt.Errorf("failed to add stream entry: %w", err)
 	}
 
-	return Output{ID: resultID}, nil
+	return Output{ID: resultID, Added: resultID != ""}, nil
+}
+
+// evictions returns the entries the trim of params would evict and the first
+// of them, or none without a trim.
+func (t *Tool) evictions(ctx context.Context, params Input) (base.StreamEviction, []any, error) {
+	trim, err := base.StreamTrim(params.MaxLen, params.MinID, params.Approximate, params.Limit)
+	if err != nil || trim == nil {
+		return base.StreamEviction{}, nil, err
+	}
+	eviction, err := base.StreamTrimmed(ctx, t.client, params.Key, *trim, 1)
+	if err != nil {
+		return base.StreamEviction{}, nil, err
+	}
+	removed := make([]any, len(eviction.Entries))
+	for i, entry := range eviction.Entries {
+		removed[i] = entry
+	}
+	return eviction, removed, nil
+}
+
+// trimSummary describes evicting the entries of eviction.
+func trimSummary(params Input, eviction base.StreamEviction) string {
+	return fmt.Sprintf("evicting %s entries", eviction.Quantity(params.Approximate))
+}
+
+// Assess implements registry.Assessor. Only calls that trim entries need
+// confirmation.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	eviction, _, err := t.evictions(ctx, params)
+	if err != nil || eviction.Count == 0 {
+		return nil, err
+	}
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Append an entry to stream %q and trim it, %s", params.Key, trimSummary(params, eviction)),
+		Keys:    []string{params.Key},
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	id := params.ID
+	if id == "" {
+		id = "*"
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Exists() && params.NoMkStream {
+		return &base.Preview{
+			Summary: fmt.Sprintf("Add nothing: stream %q does not exist and nomkstream is set", params.Key),
+			Keys:    []*base.KeyState{state},
+		}, nil
+	}
+	eviction, removed, err := t.evictions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("Append an entry with %d fields and ID %q to stream %q, growing it from %d to %d entries", len(params.Fields), id, params.Key, state.Size, state.Size+1)
+	if eviction.Count > 0 {
+		summary = fmt.Sprintf("Append an entry with %d fields and ID %q to stream %q of %d entries, %s", len(params.Fields), id, params.Key, state.Size, trimSummary(params, eviction))
+	}
+	return &base.Preview{
+		Summary:   summary,
+		Keys:      []*base.KeyState{state},
+		Removed:   removed,
+		Truncated: eviction.Count > int64(len(removed)),
+	}, nil
 }
 
 func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
@@ -6,13 +6,14 @@ import (
 	"testing"
 
 	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestXAddStream_Execute_Success(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string) (string, error) {
+	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string, opts client.StreamAddOptions) (string, error) {
 		return "1609459200000-0", nil
 	}
 
@@ -35,7 +36,7 @@ func TestXAddStream_Execute_Success(t *testing.T) {
 
 func TestXAddStream_Execute_WithExplicitID(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string) (string, error) {
+	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string, opts client.StreamAddOptions) (string, error) {
 		return id, nil
 	}
 
@@ -76,7 +77,7 @@ func TestXAddStream_Execute_EmptyKey(t *testing.T) {
 
 func TestXAddStream_Execute_AutoGenerateID(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string) (string, error) {
+	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string, opts client.StreamAddOptions) (string, error) {
 		assert.Equal(t, "*", id)
 		return "1609459200000-0", nil
 	}
@@ -118,7 +119,7 @@ func TestXAddStream_Execute_EmptyFields(t *testing.T) {
 
 func TestXAddStream_Execute_MultipleFields(t *testing.T) {
 	mockClient := &client.MockValkeyClient{}
-	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string) (string, error) {
+	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string, opts client.StreamAddOptions) (string, error) {
 		assert.Equal(t, 3, len(fields))
 		return "1609459200000-1", nil
 	}
@@ -143,3 +144,64 @@ func TestXAddStream_Execute_MultipleFields(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, "1609459200000-1", output.ID)
 }
+
+func TestXAddStream_Execute_Trim(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "mystream", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "mystream", "fields": map[string]string{"n": "4"}, "maxlen": 2})
+	impact, err := tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Append an entry to stream "mystream" and trim it, evicting 2 entries`, impact.Summary)
+	preview, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Len(t, preview.(*base.Preview).Removed, 2)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{ID: "4-0", Added: true}, result)
+	entries, err := mockClient.GetStreamRange(ctx, "mystream", "-", "+", 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "3-0", entries[0].ID)
+
+	// Without evictions no confirmation is needed.
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "mystream", "fields": map[string]string{"n": "5"}, "maxlen": 10})
+	impact, err = tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Nil(t, impact)
+}
+
+func TestXAddStream_Execute_NoMkStream(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "mystream", "fields": map[string]string{"n": "1"}, "nomkstream": true})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{}, result)
+	exists, err := mockClient.ExistsKey(ctx, "mystream")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestXAddStream_Execute_TrimOptions(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.AddStreamFunc = func(ctx context.Context, key, id string, fields map[string]string, opts client.StreamAddOptions) (string, error) {
+		assert.Equal(t, &client.StreamTrim{Strategy: "MINID", Threshold: "1700000000000", Approximate: true, Limit: 50}, opts.Trim)
+		return "1700000000001-0", nil
+	}
+	tool := NewTool(mockClient)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"mystream","fields":{"n":"1"},"minid":"1700000000000","approximate":true,"limit":50}`))
+	require.NoError(t, err)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"mystream","fields":{"n":"1"},"approximate":true}`))
+	assert.EqualError(t, err, "approximate and limit require maxlen or minid")
+}
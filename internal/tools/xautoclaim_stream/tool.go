@@ -0,0 +1,123 @@
+// Package xautoclaim_stream implements the xautoclaim_stream tool.
+package xautoclaim_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xautoclaim_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xautoclaim_stream tool.
+type Input struct {
+	Key       string `json:"key" jsonschema:"required,description=Stream key"`
+	Group     string `json:"group" jsonschema:"required,description=Consumer group name"`
+	Consumer  string `json:"consumer" jsonschema:"required,description=Consumer that takes over the entries"`
+	MinIdleMs int64  `json:"min_idle_ms" jsonschema:"required,minimum=0,description=Claim only entries idle for at least this many milliseconds"`
+	Start     string `json:"start,omitempty" jsonschema:"description=Pending ID to scan from; pass next_start of the previous call to continue (default: 0-0)"`
+	Count     int64  `json:"count,omitempty" jsonschema:"minimum=1,description=Maximum pending entries to scan (default: 100)"`
+}
+
+// Output represents the output of xautoclaim_stream tool.
+type Output struct {
+	// NextStart is the start of the next call; "0-0" when the scan is done.
+	NextStart string           `json:"next_start"`
+	Entries   []map[string]any `json:"entries"`
+	Count     int64            `json:"count"`
+	// Deleted lists pending IDs no longer in the stream; they were removed
+	// from the pending list.
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+// NewTool creates a new xautoclaim_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xautoclaim_stream", "Transfer the pending entries of a consumer group idle for at least min_idle_ms to a consumer with XAUTOCLAIM. Call repeatedly with start set to next_start until it is 0-0 to reclaim every stuck message", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.client.AutoClaimStream(ctx, params.Key, params.Group, params.Consumer, params.MinIdleMs, params.Start, params.Count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim entries of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	return Output{
+		NextStart: result.NextStart,
+		Entries:   base.SafeStreamEntries(result.Entries),
+		Count:     int64(len(result.Entries)),
+		Deleted:   result.Deleted,
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := t.client.PendingStream(ctx, params.Key, params.Group, client.StreamPendingOptions{
+		Start:   params.Start,
+		Count:   params.Count,
+		MinIdle: params.MinIdleMs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending entries of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	owners := make(map[string]int)
+	for _, p := range pending {
+		owners[p.Consumer]++
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Transfer up to %d pending entries of group %q of stream %q idle for at least %dms from %d consumers to consumer %q", len(pending), params.Group, params.Key, params.MinIdleMs, len(owners), params.Consumer),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
+// parse validates input and applies defaults.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.Consumer == "" {
+		return nil, fmt.Errorf("consumer cannot be empty")
+	}
+	if params.Start == "" {
+		params.Start = "0-0"
+	}
+	if params.Count <= 0 {
+		params.Count = 100
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,67 @@
+package xautoclaim_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Pages(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	mockClient := client.NewMockClient()
+	mockClient.Clock = func() time.Time { return now }
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w2", "min_idle_ms": 30000, "count": 2})
+	preview, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Transfer up to 2 pending entries of group "workers" of stream "jobs" idle for at least 30000ms from 1 consumers to consumer "w2"`, preview.(*base.Preview).Summary)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, "3-0", output.NextStart)
+	assert.Equal(t, int64(2), output.Count)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w2", "min_idle_ms": 30000, "start": output.NextStart})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output = result.(Output)
+	assert.Equal(t, "0-0", output.NextStart)
+	assert.Equal(t, "3-0", output.Entries[0]["_id"])
+
+	summary, err := mockClient.PendingStreamSummary(ctx, "jobs", "workers")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"w2": 3}, summary.Consumers)
+}
+
+func TestTool_Execute_Deleted(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		AutoClaimStreamFunc: func(ctx context.Context, key, group, consumer string, minIdle int64, start string, count int64) (*client.StreamAutoClaim, error) {
+			assert.Equal(t, "0-0", start)
+			assert.Equal(t, int64(100), count)
+			return &client.StreamAutoClaim{NextStart: "0-0", Entries: []client.StreamEntry{}, Deleted: []string{"1-0"}}, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w2", "min_idle_ms": 0})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1-0"}, result.(Output).Deleted)
+}
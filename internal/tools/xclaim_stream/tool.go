@@ -0,0 +1,134 @@
+// Package xclaim_stream implements the xclaim_stream tool.
+package xclaim_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xclaim_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xclaim_stream tool.
+type Input struct {
+	Key        string   `json:"key" jsonschema:"required,description=Stream key"`
+	Group      string   `json:"group" jsonschema:"required,description=Consumer group name"`
+	Consumer   string   `json:"consumer" jsonschema:"required,description=Consumer that takes over the entries"`
+	MinIdleMs  int64    `json:"min_idle_ms" jsonschema:"required,minimum=0,description=Claim only entries idle for at least this many milliseconds"`
+	IDs        []string `json:"ids" jsonschema:"required,description=IDs of the pending entries to claim"`
+	IdleMs     *int64   `json:"idle_ms,omitempty" jsonschema:"minimum=0,description=Idle time to set on the claimed entries (default: 0)"`
+	TimeMs     *int64   `json:"time_ms,omitempty" jsonschema:"minimum=0,description=Unix time in milliseconds to set as the last delivery of the claimed entries"`
+	RetryCount *int64   `json:"retry_count,omitempty" jsonschema:"minimum=0,description=Delivery count to set (default: incremented)"`
+	Force      bool     `json:"force,omitempty" jsonschema:"description=Claim IDs that are in the stream but not pending in the group"`
+}
+
+// Output represents the output of xclaim_stream tool.
+type Output struct {
+	Entries []map[string]any `json:"entries"`
+	Count   int64            `json:"count"`
+}
+
+// NewTool creates a new xclaim_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xclaim_stream", "Transfer pending entries of a consumer group to another consumer with XCLAIM, e.g. to take over messages of a crashed worker. Entries idle for less than min_idle_ms are left alone", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := t.client.ClaimStream(ctx, params.Key, params.Group, params.Consumer, params.MinIdleMs, params.IDs, client.StreamClaimOptions{
+		Idle:       params.IdleMs,
+		Time:       params.TimeMs,
+		RetryCount: params.RetryCount,
+		Force:      params.Force,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim entries of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	return Output{
+		Entries: base.SafeStreamEntries(raw),
+		Count:   int64(len(raw)),
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	claimable, err := claimable(ctx, t.client, params)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Transfer %d of %d entries in group %q of stream %q to consumer %q", claimable, len(params.IDs), params.Group, params.Key, params.Consumer),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
+// claimable counts the IDs pending and idle long enough to be claimed.
+func claimable(ctx context.Context, c client.ValkeyClient, params *Input) (int, error) {
+	n := 0
+	for _, id := range params.IDs {
+		pending, err := c.PendingStream(ctx, params.Key, params.Group, client.StreamPendingOptions{
+			Start:   id,
+			End:     id,
+			Count:   1,
+			MinIdle: params.MinIdleMs,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list pending entries of group %q of stream %q: %w", params.Group, params.Key, err)
+		}
+		n += len(pending)
+	}
+	return n, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.Consumer == "" {
+		return nil, fmt.Errorf("consumer cannot be empty")
+	}
+	if len(params.IDs) == 0 {
+		return nil, fmt.Errorf("ids cannot be empty")
+	}
+	if params.IdleMs != nil && params.TimeMs != nil {
+		return nil, fmt.Errorf("idle_ms and time_ms cannot be combined")
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,76 @@
+package xclaim_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	mockClient := client.NewMockClient()
+	mockClient.Clock = func() time.Time { return now }
+	for _, id := range []string{"1-0", "2-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 1, false)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 1, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{
+		"key": "jobs", "group": "workers", "consumer": "w2", "min_idle_ms": 30000, "ids": []string{"1-0", "2-0"},
+	})
+	preview, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Transfer 1 of 2 entries in group "workers" of stream "jobs" to consumer "w2"`, preview.(*base.Preview).Summary)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, int64(1), output.Count)
+	assert.Equal(t, "1-0", output.Entries[0]["_id"])
+
+	pending, err := mockClient.PendingStream(ctx, "jobs", "workers", client.StreamPendingOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []client.StreamPendingEntry{
+		{ID: "1-0", Consumer: "w2", IdleMs: 0, Deliveries: 2},
+		{ID: "2-0", Consumer: "w1", IdleMs: 0, Deliveries: 1},
+	}, pending)
+}
+
+func TestTool_Execute_Options(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		ClaimStreamFunc: func(ctx context.Context, key, group, consumer string, minIdle int64, ids []string, opts client.StreamClaimOptions) ([]client.StreamEntry, error) {
+			require.NotNil(t, opts.RetryCount)
+			assert.Equal(t, int64(5), *opts.RetryCount)
+			assert.True(t, opts.Force)
+			return []client.StreamEntry{{ID: "1-0", FieldValues: map[string][]byte{"n": []byte("1")}}}, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{
+		"key": "jobs", "group": "workers", "consumer": "w2", "min_idle_ms": 0, "ids": []string{"1-0"}, "retry_count": 5, "force": true,
+	})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.(Output).Count)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{
+		"key": "jobs", "group": "workers", "consumer": "w2", "min_idle_ms": 0, "ids": []string{"1-0"}, "idle_ms": 1, "time_ms": 1,
+	})
+	_, err = tool.Execute(context.Background(), inputJSON)
+	assert.EqualError(t, err, "idle_ms and time_ms cannot be combined")
+}
@@ -0,0 +1,132 @@
+// Package xdel_stream implements the xdel_stream tool.
+package xdel_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xdel_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xdel_stream tool.
+type Input struct {
+	Key string   `json:"key" jsonschema:"required,description=Stream key"`
+	IDs []string `json:"ids" jsonschema:"required,description=IDs of the entries to delete"`
+}
+
+// Output represents the output of xdel_stream tool.
+type Output struct {
+	Deleted int64 `json:"deleted"`
+}
+
+// NewTool creates a new xdel_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xdel_stream", "Delete entries from a stream by ID with XDEL. Pending entries of consumer groups are not acknowledged and consumer group lag may become unknown", Input{}),
+		client:   client,
+	}
+}
+
+// parse parses and validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, err
+	}
+	if params.Key == "" {
+		return params, fmt.Errorf("key cannot be empty")
+	}
+	if len(params.IDs) == 0 {
+		return params, fmt.Errorf("ids cannot be empty")
+	}
+	return params, nil
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := t.client.DeleteStreamEntries(ctx, params.Key, params.IDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete entries from stream %q: %w", params.Key, err)
+	}
+	return Output{Deleted: deleted}, nil
+}
+
+// existing returns the entries among ids present in the stream at key.
+func (t *Tool) existing(ctx context.Context, key string, ids []string) ([]client.StreamEntry, error) {
+	var entries []client.StreamEntry
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		found, err := t.client.GetStreamRange(ctx, key, id, id, 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stream %q: %w", key, err)
+		}
+		entries = append(entries, found...)
+	}
+	return entries, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := t.existing(ctx, params.Key, params.IDs)
+	if err != nil {
+		return nil, err
+	}
+	return &registry.Impact{
+		Summary: fmt.Sprintf("Delete %d of %d entries from stream %q", len(entries), len(params.IDs), params.Key),
+		Keys:    []string{params.Key},
+	}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := t.existing(ctx, params.Key, params.IDs)
+	if err != nil {
+		return nil, err
+	}
+	removed := make([]any, 0, min(len(entries), base.MaxPreviewElements))
+	for _, entry := range base.SafeStreamEntries(entries[:min(len(entries), base.MaxPreviewElements)]) {
+		removed = append(removed, entry)
+	}
+	return &base.Preview{
+		Summary:   fmt.Sprintf("Delete %d of %d entries from stream %q, shrinking it from %d to %d entries", len(entries), len(params.IDs), params.Key, state.Size, state.Size-int64(len(entries))),
+		Keys:      []*base.KeyState{state},
+		Removed:   removed,
+		Truncated: len(entries) > len(removed),
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,51 @@
+package xdel_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "events", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "events", "workers", "0", false, nil))
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "events", "ids": []string{"3-0", "2-0", "3-0", "9-0"}})
+	preview, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Delete 2 of 4 entries from stream "events", shrinking it from 3 to 1 entries`, p.Summary)
+	assert.Len(t, p.Removed, 2)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Deleted: 2}, result)
+
+	// New IDs still follow the deleted top entry.
+	id, err := mockClient.AddStream(ctx, "events", "*", map[string]string{"n": "4"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "4-0", id)
+
+	// Deleting entries past the group's position makes its lag unknown.
+	groups, err := mockClient.GetStreamGroups(ctx, "events")
+	require.NoError(t, err)
+	assert.Nil(t, groups[0].Lag)
+}
+
+func TestTool_Execute_EmptyIDs(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"events","ids":[]}`))
+	assert.EqualError(t, err, "ids cannot be empty")
+}
@@ -0,0 +1,98 @@
+// Package xgroup_create_stream implements the xgroup_create_stream tool.
+package xgroup_create_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xgroup_create_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xgroup_create_stream tool.
+type Input struct {
+	Key         string `json:"key" jsonschema:"required,description=Stream key"`
+	Group       string `json:"group" jsonschema:"required,description=Consumer group name"`
+	ID          string `json:"id,omitempty" jsonschema:"description=Last delivered ID: the group receives entries after it ($ for new entries only and 0 for the whole stream; default: $)"`
+	MkStream    bool   `json:"mkstream,omitempty" jsonschema:"description=Create an empty stream if key does not exist"`
+	EntriesRead *int64 `json:"entries_read,omitempty" jsonschema:"minimum=0,description=Number of entries the group has read; enables lag reporting for an arbitrary id"`
+}
+
+// Output represents the output of xgroup_create_stream tool.
+type Output struct {
+	Key   string `json:"key"`
+	Group string `json:"group"`
+	ID    string `json:"id"`
+}
+
+// NewTool creates a new xgroup_create_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xgroup_create_stream", "Create a consumer group on a stream with XGROUP CREATE", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.client.CreateStreamGroup(ctx, params.Key, params.Group, params.ID, params.MkStream, params.EntriesRead); err != nil {
+		return nil, fmt.Errorf("failed to create group %q on stream %q: %w", params.Group, params.Key, err)
+	}
+	return Output{Key: params.Key, Group: params.Group, ID: params.ID}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("Create group %q on stream %q delivering entries after %q", params.Group, params.Key, params.ID)
+	if !state.Exists() {
+		summary = fmt.Sprintf("Stream %q does not exist; the call would fail", params.Key)
+		if params.MkStream {
+			summary = fmt.Sprintf("Create empty stream %q with group %q", params.Key, params.Group)
+		}
+	}
+	return &base.Preview{Summary: summary, Keys: []*base.KeyState{state}}, nil
+}
+
+// parse validates input and applies defaults.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.ID == "" {
+		params.ID = "$"
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
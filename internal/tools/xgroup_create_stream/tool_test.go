@@ -0,0 +1,61 @@
+package xgroup_create_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.AddStream(ctx, "jobs", "1-0", map[string]string{"n": "1"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "id": "0"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Key: "jobs", Group: "workers", ID: "0"}, result)
+
+	entries, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	_, err = tool.Execute(ctx, inputJSON)
+	assert.ErrorContains(t, err, "BUSYGROUP")
+}
+
+func TestTool_Execute_MkStream(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers"})
+	_, err := tool.Execute(ctx, inputJSON)
+	assert.ErrorContains(t, err, `failed to create group "workers" on stream "jobs"`)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "mkstream": true})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, "$", result.(Output).ID)
+	keyType, _ := mockClient.GetKeyType(ctx, "jobs")
+	assert.Equal(t, "stream", keyType)
+}
+
+func TestTool_Preview(t *testing.T) {
+	tool := NewTool(client.NewMockClient()).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "mkstream": true})
+	result, err := tool.Preview(context.Background(), inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Create empty stream "jobs" with group "workers"`, result.(*base.Preview).Summary)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs"}`))
+	assert.EqualError(t, err, "group cannot be empty")
+}
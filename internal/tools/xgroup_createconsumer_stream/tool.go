@@ -0,0 +1,93 @@
+// Package xgroup_createconsumer_stream implements the
+// xgroup_createconsumer_stream tool.
+package xgroup_createconsumer_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xgroup_createconsumer_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xgroup_createconsumer_stream tool.
+type Input struct {
+	Key      string `json:"key" jsonschema:"required,description=Stream key"`
+	Group    string `json:"group" jsonschema:"required,description=Consumer group name"`
+	Consumer string `json:"consumer" jsonschema:"required,description=Consumer to create"`
+}
+
+// Output represents the output of xgroup_createconsumer_stream tool.
+type Output struct {
+	// Created is false when the consumer already existed.
+	Created bool `json:"created"`
+}
+
+// NewTool creates a new xgroup_createconsumer_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xgroup_createconsumer_stream", "Create a consumer in a consumer group with XGROUP CREATECONSUMER", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := t.client.CreateStreamConsumer(ctx, params.Key, params.Group, params.Consumer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer %q in group %q of stream %q: %w", params.Consumer, params.Group, params.Key, err)
+	}
+	return Output{Created: created}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Create consumer %q in group %q of stream %q", params.Consumer, params.Group, params.Key),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.Consumer == "" {
+		return nil, fmt.Errorf("consumer cannot be empty")
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
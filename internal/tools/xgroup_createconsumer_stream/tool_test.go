@@ -0,0 +1,30 @@
+package xgroup_createconsumer_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "$", true, nil))
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w1"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Created: true}, result)
+
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Created: false}, result)
+
+	_, err = tool.Execute(ctx, json.RawMessage(`{"key":"jobs","group":"workers"}`))
+	assert.EqualError(t, err, "consumer cannot be empty")
+}
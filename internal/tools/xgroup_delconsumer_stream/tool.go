@@ -0,0 +1,110 @@
+// Package xgroup_delconsumer_stream implements the xgroup_delconsumer_stream
+// tool.
+package xgroup_delconsumer_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xgroup_delconsumer_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xgroup_delconsumer_stream tool.
+type Input struct {
+	Key      string `json:"key" jsonschema:"required,description=Stream key"`
+	Group    string `json:"group" jsonschema:"required,description=Consumer group name"`
+	Consumer string `json:"consumer" jsonschema:"required,description=Consumer to delete"`
+}
+
+// Output represents the output of xgroup_delconsumer_stream tool.
+type Output struct {
+	// Pending is the number of pending entries the consumer owned; they are
+	// no longer pending in the group.
+	Pending int64 `json:"pending"`
+}
+
+// NewTool creates a new xgroup_delconsumer_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xgroup_delconsumer_stream", "Delete a consumer from a consumer group with XGROUP DELCONSUMER. Its pending entries are dropped; claim them with xclaim_stream first to keep them", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := t.client.DeleteStreamConsumer(ctx, params.Key, params.Group, params.Consumer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete consumer %q from group %q of stream %q: %w", params.Consumer, params.Group, params.Key, err)
+	}
+	return Output{Pending: pending}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{Summary: t.describe(ctx, params), Keys: []*base.KeyState{state}}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return &registry.Impact{Summary: t.describe(ctx, params), Keys: []string{params.Key}}, nil
+}
+
+// describe summarises what deleting the consumer drops.
+func (t *Tool) describe(ctx context.Context, params *Input) string {
+	pending, err := t.client.PendingStreamSummary(ctx, params.Key, params.Group)
+	if err != nil {
+		// The group does not exist; the call fails.
+		return fmt.Sprintf("Delete consumer %q from group %q of stream %q", params.Consumer, params.Group, params.Key)
+	}
+	return fmt.Sprintf("Delete consumer %q from group %q of stream %q, dropping its %d pending entries", params.Consumer, params.Group, params.Key, pending.Consumers[params.Consumer])
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.Consumer == "" {
+		return nil, fmt.Errorf("consumer cannot be empty")
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
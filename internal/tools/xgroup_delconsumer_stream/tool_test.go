@@ -0,0 +1,37 @@
+package xgroup_delconsumer_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w1"})
+	impact, err := tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Delete consumer "w1" from group "workers" of stream "jobs", dropping its 2 pending entries`, impact.Summary)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Pending: 2}, result)
+
+	summary, err := mockClient.PendingStreamSummary(ctx, "jobs", "workers")
+	require.NoError(t, err)
+	assert.Zero(t, summary.Count)
+}
@@ -0,0 +1,104 @@
+// Package xgroup_destroy_stream implements the xgroup_destroy_stream tool.
+package xgroup_destroy_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xgroup_destroy_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xgroup_destroy_stream tool.
+type Input struct {
+	Key   string `json:"key" jsonschema:"required,description=Stream key"`
+	Group string `json:"group" jsonschema:"required,description=Consumer group to destroy"`
+}
+
+// Output represents the output of xgroup_destroy_stream tool.
+type Output struct {
+	Destroyed bool `json:"destroyed"`
+}
+
+// NewTool creates a new xgroup_destroy_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xgroup_destroy_stream", "Destroy a consumer group with XGROUP DESTROY, dropping its consumers and pending entries", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	destroyed, err := t.client.DestroyStreamGroup(ctx, params.Key, params.Group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to destroy group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	return Output{Destroyed: destroyed}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{Summary: t.describe(ctx, params), Keys: []*base.KeyState{state}}, nil
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registry.Impact{Summary: t.describe(ctx, params), Keys: []string{params.Key}}, nil
+}
+
+// describe summarises what destroying the group drops.
+func (t *Tool) describe(ctx context.Context, params *Input) string {
+	pending, err := t.client.PendingStreamSummary(ctx, params.Key, params.Group)
+	if err != nil {
+		// The group does not exist; the call changes nothing.
+		return fmt.Sprintf("Destroy group %q of stream %q", params.Group, params.Key)
+	}
+	return fmt.Sprintf("Destroy group %q of stream %q, dropping %d pending entries", params.Group, params.Key, pending.Count)
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,36 @@
+package xgroup_destroy_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.AddStream(ctx, "jobs", "1-0", map[string]string{"n": "1"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err = mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers"})
+	impact, err := tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Destroy group "workers" of stream "jobs", dropping 1 pending entries`, impact.Summary)
+	assert.Equal(t, []string{"jobs"}, impact.Keys)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Destroyed: true}, result)
+
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Destroyed: false}, result)
+}
@@ -0,0 +1,93 @@
+// Package xgroup_setid_stream implements the xgroup_setid_stream tool.
+package xgroup_setid_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xgroup_setid_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xgroup_setid_stream tool.
+type Input struct {
+	Key         string `json:"key" jsonschema:"required,description=Stream key"`
+	Group       string `json:"group" jsonschema:"required,description=Consumer group name"`
+	ID          string `json:"id" jsonschema:"required,description=New last delivered ID ($ for the last entry and 0 to redeliver the whole stream)"`
+	EntriesRead *int64 `json:"entries_read,omitempty" jsonschema:"minimum=0,description=Number of entries the group has read; enables lag reporting for an arbitrary id"`
+}
+
+// Output represents the output of xgroup_setid_stream tool.
+type Output struct {
+	Key   string `json:"key"`
+	Group string `json:"group"`
+	ID    string `json:"id"`
+}
+
+// NewTool creates a new xgroup_setid_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xgroup_setid_stream", "Set the last delivered ID of a consumer group with XGROUP SETID, to skip or replay entries", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.client.SetStreamGroupID(ctx, params.Key, params.Group, params.ID, params.EntriesRead); err != nil {
+		return nil, fmt.Errorf("failed to set ID of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	return Output{Key: params.Key, Group: params.Group, ID: params.ID}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Preview{
+		Summary: fmt.Sprintf("Make group %q of stream %q deliver entries after %q next", params.Group, params.Key, params.ID),
+		Keys:    []*base.KeyState{state},
+	}, nil
+}
+
+// parse validates input.
+func (t *Tool) parse(input json.RawMessage) (*Input, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.ID == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+	return &params, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
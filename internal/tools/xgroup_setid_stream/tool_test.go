@@ -0,0 +1,42 @@
+package xgroup_setid_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Replay(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "$", false, nil))
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "id": "1-0"})
+	_, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	entries, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "2-0", entries[0].ID)
+}
+
+func TestTool_Execute_NoGroup(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "id": "0"})
+	_, err := tool.Execute(context.Background(), inputJSON)
+	assert.ErrorContains(t, err, "NOGROUP")
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","group":"workers"}`))
+	assert.EqualError(t, err, "id cannot be empty")
+}
@@ -0,0 +1,75 @@
+// Package xinfo_consumers implements the xinfo_consumers tool.
+package xinfo_consumers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xinfo_consumers functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xinfo_consumers tool.
+type Input struct {
+	Key   string `json:"key" jsonschema:"required,description=Stream key"`
+	Group string `json:"group" jsonschema:"required,description=Consumer group name"`
+}
+
+// Consumer is a consumer of the group. InactiveMs is -1 when the consumer
+// never read an entry or the server predates Valkey 7.2.
+type Consumer struct {
+	Name       string `json:"name"`
+	Pending    int64  `json:"pending"`
+	IdleMs     int64  `json:"idle_ms"`
+	InactiveMs int64  `json:"inactive_ms"`
+}
+
+// Output represents the output of xinfo_consumers tool.
+type Output struct {
+	Consumers []Consumer `json:"consumers"`
+	Count     int64      `json:"count"`
+}
+
+// NewTool creates a new xinfo_consumers tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xinfo_consumers", "List the consumers of a stream consumer group with XINFO CONSUMERS: pending entries and the time since each last tried to read (idle) and last read something (inactive)", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+
+	consumers, err := t.client.GetStreamConsumers(ctx, params.Key, params.Group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumers of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	output := Output{Consumers: make([]Consumer, len(consumers)), Count: int64(len(consumers))}
+	for i, c := range consumers {
+		output.Consumers[i] = Consumer{Name: c.Name, Pending: c.Pending, IdleMs: c.IdleMs, InactiveMs: c.InactiveMs}
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
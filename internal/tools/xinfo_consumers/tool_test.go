@@ -0,0 +1,50 @@
+package xinfo_consumers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	mockClient := client.NewMockClient()
+	mockClient.Clock = func() time.Time { return now }
+	_, err := mockClient.AddStream(ctx, "jobs", "1-0", map[string]string{"n": "1"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err = mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 0, false)
+	require.NoError(t, err)
+	now = now.Add(2 * time.Second)
+	_, err = mockClient.CreateStreamConsumer(ctx, "jobs", "workers", "w2")
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{
+		Consumers: []Consumer{
+			{Name: "w1", Pending: 1, IdleMs: 3000, InactiveMs: 3000},
+			{Name: "w2", IdleMs: 1000, InactiveMs: 1000},
+		},
+		Count: 2,
+	}, result)
+}
+
+func TestTool_Execute_Errors(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","group":"workers"}`))
+	assert.ErrorContains(t, err, "NOGROUP")
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs"}`))
+	assert.EqualError(t, err, "group cannot be empty")
+}
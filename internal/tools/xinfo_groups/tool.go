@@ -0,0 +1,80 @@
+// Package xinfo_groups implements the xinfo_groups tool.
+package xinfo_groups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xinfo_groups functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xinfo_groups tool.
+type Input struct {
+	Key string `json:"key" jsonschema:"required,description=Stream key"`
+}
+
+// Group is a consumer group. EntriesRead and Lag are null when the server
+// cannot tell them.
+type Group struct {
+	Name            string `json:"name"`
+	Consumers       int64  `json:"consumers"`
+	Pending         int64  `json:"pending"`
+	LastDeliveredID string `json:"last_delivered_id"`
+	EntriesRead     *int64 `json:"entries_read"`
+	Lag             *int64 `json:"lag"`
+}
+
+// Output represents the output of xinfo_groups tool.
+type Output struct {
+	Groups []Group `json:"groups"`
+	Count  int64   `json:"count"`
+}
+
+// NewTool creates a new xinfo_groups tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xinfo_groups", "List the consumer groups of a stream with XINFO GROUPS: consumers and pending entries and last delivered ID and lag of each", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	groups, err := t.client.GetStreamGroups(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups of stream %q: %w", params.Key, err)
+	}
+	output := Output{Groups: make([]Group, len(groups)), Count: int64(len(groups))}
+	for i, g := range groups {
+		output.Groups[i] = Group{
+			Name:            g.Name,
+			Consumers:       g.Consumers,
+			Pending:         g.Pending,
+			LastDeliveredID: g.LastDeliveredID,
+			EntriesRead:     g.EntriesRead,
+			Lag:             g.Lag,
+		}
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,53 @@
+package xinfo_groups
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "audit", "$", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 1, false)
+	require.NoError(t, err)
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	zero, one, two, three := int64(0), int64(1), int64(2), int64(3)
+	assert.Equal(t, Output{
+		Groups: []Group{
+			{Name: "audit", LastDeliveredID: "3-0", EntriesRead: &three, Lag: &zero},
+			{Name: "workers", Consumers: 1, Pending: 1, LastDeliveredID: "1-0", EntriesRead: &one, Lag: &two},
+		},
+		Count: 2,
+	}, result)
+}
+
+func TestTool_Execute_UnknownLag(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		GetStreamGroupsFunc: func(ctx context.Context, key string) ([]client.StreamGroupInfo, error) {
+			return []client.StreamGroupInfo{{Name: "workers", LastDeliveredID: "5-0"}}, nil
+		},
+	}
+	tool := NewTool(mockClient)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs"}`))
+	require.NoError(t, err)
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"groups":[{"name":"workers","consumers":0,"pending":0,"last_delivered_id":"5-0","entries_read":null,"lag":null}],"count":1}`, string(data))
+}
@@ -0,0 +1,160 @@
+// Package xinfo_stream implements the xinfo_stream tool.
+package xinfo_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xinfo_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xinfo_stream tool.
+type Input struct {
+	Key   string `json:"key" jsonschema:"required,description=Stream key"`
+	Full  bool   `json:"full,omitempty" jsonschema:"description=Use the FULL form listing entries and the consumers and pending entries of each group"`
+	Count int64  `json:"count,omitempty" jsonschema:"minimum=1,description=With full: maximum entries and pending entries per group to list (default: 10)"`
+}
+
+// Output represents the output of xinfo_stream tool. Entries and Groups
+// are only listed with full.
+type Output struct {
+	Length               int64            `json:"length"`
+	RadixTreeKeys        int64            `json:"radix_tree_keys"`
+	RadixTreeNodes       int64            `json:"radix_tree_nodes"`
+	LastGeneratedID      string           `json:"last_generated_id"`
+	MaxDeletedEntryID    string           `json:"max_deleted_entry_id,omitempty"`
+	EntriesAdded         int64            `json:"entries_added"`
+	RecordedFirstEntryID string           `json:"recorded_first_entry_id,omitempty"`
+	GroupCount           int64            `json:"group_count"`
+	FirstEntry           map[string]any   `json:"first_entry,omitempty"`
+	LastEntry            map[string]any   `json:"last_entry,omitempty"`
+	Entries              []map[string]any `json:"entries,omitempty"`
+	Groups               []Group          `json:"groups,omitempty"`
+}
+
+// Group is a consumer group of the FULL form.
+type Group struct {
+	Name            string     `json:"name"`
+	LastDeliveredID string     `json:"last_delivered_id"`
+	EntriesRead     *int64     `json:"entries_read"`
+	Lag             *int64     `json:"lag"`
+	Pending         int64      `json:"pending"`
+	PendingEntries  []Pending  `json:"pending_entries"`
+	Consumers       []Consumer `json:"consumers"`
+}
+
+// Consumer is a consumer of the FULL form. Times are Unix times in
+// milliseconds.
+type Consumer struct {
+	Name           string    `json:"name"`
+	SeenTimeMs     int64     `json:"seen_time_ms"`
+	ActiveTimeMs   int64     `json:"active_time_ms"`
+	Pending        int64     `json:"pending"`
+	PendingEntries []Pending `json:"pending_entries"`
+}
+
+// Pending is a pending entry of the FULL form.
+type Pending struct {
+	ID             string `json:"id"`
+	Consumer       string `json:"consumer,omitempty"`
+	DeliveryTimeMs int64  `json:"delivery_time_ms"`
+	Deliveries     int64  `json:"deliveries"`
+}
+
+// NewTool creates a new xinfo_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xinfo_stream", "Describe a stream with XINFO STREAM: length and IDs and entries added and number of groups and the first and last entries. With full the entries and every group with its consumers and pending entries are listed instead", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Count != 0 && !params.Full {
+		return nil, fmt.Errorf("count requires full")
+	}
+
+	info, err := t.client.GetStreamInfo(ctx, params.Key, params.Full, params.Count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stream %q: %w", params.Key, err)
+	}
+
+	output := &Output{
+		Length:               info.Length,
+		RadixTreeKeys:        info.RadixTreeKeys,
+		RadixTreeNodes:       info.RadixTreeNodes,
+		LastGeneratedID:      info.LastGeneratedID,
+		MaxDeletedEntryID:    info.MaxDeletedEntryID,
+		EntriesAdded:         info.EntriesAdded,
+		RecordedFirstEntryID: info.RecordedFirstEntryID,
+		GroupCount:           info.Groups,
+	}
+	if info.FirstEntry != nil {
+		output.FirstEntry = base.SafeStreamEntries([]client.StreamEntry{*info.FirstEntry})[0]
+	}
+	if info.LastEntry != nil {
+		output.LastEntry = base.SafeStreamEntries([]client.StreamEntry{*info.LastEntry})[0]
+	}
+	if !params.Full {
+		return output, nil
+	}
+
+	output.Entries = base.SafeStreamEntries(info.Entries)
+	output.Groups = make([]Group, len(info.GroupDetails))
+	for i, g := range info.GroupDetails {
+		group := Group{
+			Name:            g.Name,
+			LastDeliveredID: g.LastDeliveredID,
+			EntriesRead:     g.EntriesRead,
+			Lag:             g.Lag,
+			Pending:         g.Pending,
+			PendingEntries:  pendingEntries(g.PendingEntries, true),
+			Consumers:       make([]Consumer, len(g.Consumers)),
+		}
+		for j, c := range g.Consumers {
+			group.Consumers[j] = Consumer{
+				Name:           c.Name,
+				SeenTimeMs:     c.SeenTime,
+				ActiveTimeMs:   c.ActiveTime,
+				Pending:        c.Pending,
+				PendingEntries: pendingEntries(c.PendingEntries, false),
+			}
+		}
+		output.Groups[i] = group
+	}
+	return output, nil
+}
+
+// pendingEntries converts deliveries, naming their consumer when
+// withConsumer is set.
+func pendingEntries(deliveries []client.StreamPendingDelivery, withConsumer bool) []Pending {
+	result := make([]Pending, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = Pending{ID: d.ID, DeliveryTimeMs: d.DeliveryTime, Deliveries: d.Deliveries}
+		if withConsumer {
+			result[i].Consumer = d.Consumer
+		}
+	}
+	return result
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,81 @@
+package xinfo_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seed(t *testing.T) *client.MockClient {
+	t.Helper()
+	ctx := context.Background()
+	now := time.UnixMilli(1700000000000)
+	mockClient := client.NewMockClient()
+	mockClient.Clock = func() time.Time { return now }
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 2, false)
+	require.NoError(t, err)
+	return mockClient
+}
+
+func TestTool_Execute(t *testing.T) {
+	tool := NewTool(seed(t))
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs"})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+	output := result.(*Output)
+	assert.Equal(t, int64(3), output.Length)
+	assert.Equal(t, "3-0", output.LastGeneratedID)
+	assert.Equal(t, int64(1), output.GroupCount)
+	assert.Equal(t, map[string]any{"_id": "1-0", "n": "1-0"}, output.FirstEntry)
+	assert.Equal(t, "3-0", output.LastEntry["_id"])
+	assert.Nil(t, output.Groups)
+}
+
+func TestTool_Execute_Full(t *testing.T) {
+	tool := NewTool(seed(t))
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "full": true, "count": 1})
+	result, err := tool.Execute(context.Background(), inputJSON)
+	require.NoError(t, err)
+	output := result.(*Output)
+	assert.Nil(t, output.FirstEntry)
+	assert.Len(t, output.Entries, 1)
+
+	lag, read := int64(1), int64(2)
+	assert.Equal(t, []Group{{
+		Name:            "workers",
+		LastDeliveredID: "2-0",
+		EntriesRead:     &read,
+		Lag:             &lag,
+		Pending:         2,
+		PendingEntries:  []Pending{{ID: "1-0", Consumer: "w1", DeliveryTimeMs: 1700000000000, Deliveries: 1}},
+		Consumers: []Consumer{{
+			Name:           "w1",
+			SeenTimeMs:     1700000000000,
+			ActiveTimeMs:   1700000000000,
+			Pending:        2,
+			PendingEntries: []Pending{{ID: "1-0", DeliveryTimeMs: 1700000000000, Deliveries: 1}},
+		}},
+	}}, output.Groups)
+}
+
+func TestTool_Execute_Errors(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs"}`))
+	assert.EqualError(t, err, `failed to describe stream "jobs": XINFO STREAM failed: ERR no such key`)
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","count":5}`))
+	assert.EqualError(t, err, "count requires full")
+}
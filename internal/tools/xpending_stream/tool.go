@@ -0,0 +1,103 @@
+// Package xpending_stream implements the xpending_stream tool.
+package xpending_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xpending_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xpending_stream tool.
+type Input struct {
+	Key       string `json:"key" jsonschema:"required,description=Stream key"`
+	Group     string `json:"group" jsonschema:"required,description=Consumer group name"`
+	Start     string `json:"start,omitempty" jsonschema:"description=Lowest ID to list (default: -)"`
+	End       string `json:"end,omitempty" jsonschema:"description=Highest ID to list (default: +)"`
+	Count     int64  `json:"count,omitempty" jsonschema:"minimum=1,description=Maximum entries to list (default: 100)"`
+	Consumer  string `json:"consumer,omitempty" jsonschema:"description=List only the entries of this consumer"`
+	MinIdleMs int64  `json:"min_idle_ms,omitempty" jsonschema:"minimum=0,description=List only entries idle for at least this many milliseconds"`
+}
+
+// Entry is a pending entry.
+type Entry struct {
+	ID         string `json:"id"`
+	Consumer   string `json:"consumer"`
+	IdleMs     int64  `json:"idle_ms"`
+	Deliveries int64  `json:"deliveries"`
+}
+
+// Output represents the output of xpending_stream tool. Without filters
+// Pending counts the group's pending entries and the other fields summarise
+// them; with filters Pending counts the Entries listed.
+type Output struct {
+	Pending   int64            `json:"pending"`
+	Lowest    string           `json:"lowest,omitempty"`
+	Highest   string           `json:"highest,omitempty"`
+	Consumers map[string]int64 `json:"consumers,omitempty"`
+	Entries   []Entry          `json:"entries,omitempty"`
+}
+
+// NewTool creates a new xpending_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xpending_stream", "Inspect the entries delivered to a consumer group but not acknowledged, with XPENDING. Without filters the result summarises them per consumer; with start or end or count or consumer or min_idle_ms each entry is listed with its idle time and delivery count, to find stuck messages to claim", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+
+	if params.Start == "" && params.End == "" && params.Count == 0 && params.Consumer == "" && params.MinIdleMs == 0 {
+		summary, err := t.client.PendingStreamSummary(ctx, params.Key, params.Group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pending entries of group %q of stream %q: %w", params.Group, params.Key, err)
+		}
+		return &Output{
+			Pending:   summary.Count,
+			Lowest:    summary.Lowest,
+			Highest:   summary.Highest,
+			Consumers: summary.Consumers,
+		}, nil
+	}
+
+	pending, err := t.client.PendingStream(ctx, params.Key, params.Group, client.StreamPendingOptions{
+		Start:    params.Start,
+		End:      params.End,
+		Count:    params.Count,
+		Consumer: params.Consumer,
+		MinIdle:  params.MinIdleMs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending entries of group %q of stream %q: %w", params.Group, params.Key, err)
+	}
+	output := &Output{Pending: int64(len(pending)), Entries: make([]Entry, len(pending))}
+	for i, p := range pending {
+		output.Entries[i] = Entry{ID: p.ID, Consumer: p.Consumer, IdleMs: p.IdleMs, Deliveries: p.Deliveries}
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,65 @@
+package xpending_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	mockClient := client.NewMockClient()
+	mockClient.Clock = func() time.Time { return now }
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	_, err := mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w1", ">", 2, false)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = mockClient.ReadStreamGroup(ctx, "jobs", "workers", "w2", ">", 0, false)
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers"})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, &Output{
+		Pending:   3,
+		Lowest:    "1-0",
+		Highest:   "3-0",
+		Consumers: map[string]int64{"w1": 2, "w2": 1},
+	}, result)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "min_idle_ms": 30000})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, &Output{
+		Pending: 2,
+		Entries: []Entry{
+			{ID: "1-0", Consumer: "w1", IdleMs: 61000, Deliveries: 1},
+			{ID: "2-0", Consumer: "w1", IdleMs: 61000, Deliveries: 1},
+		},
+	}, result)
+
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w2"})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{ID: "3-0", Consumer: "w2", IdleMs: 1000, Deliveries: 1}}, result.(*Output).Entries)
+}
+
+func TestTool_Execute_NoGroup(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers"})
+	_, err := tool.Execute(context.Background(), inputJSON)
+	assert.ErrorContains(t, err, "NOGROUP")
+}
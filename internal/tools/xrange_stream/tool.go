@@ -17,19 +17,21 @@ type Tool struct {
 
 type Input struct {
 	Key   string `json:"key" jsonschema:"required,description=Stream key"`
-	Start string `json:"start" jsonschema:"required,description=Start ID (- for first entry)"`
-	End   string `json:"end" jsonschema:"required,description=End ID (+ for last entry)"`
+	Start string `json:"start" jsonschema:"required,description=Start ID (- for first entry; (ID excludes it)"`
+	End   string `json:"end" jsonschema:"required,description=End ID (+ for last entry; (ID excludes it)"`
 	Count int64  `json:"count" jsonschema:"description=Maximum entries to return (0 for all)"`
 }
 
 type Output struct {
 	Entries []map[string]any `json:"entries"`
 	Count   int64            `json:"count"`
+	// NextStart is the start of the next page when more entries remain.
+	NextStart string `json:"next_start,omitempty"`
 }
 
 func NewTool(client client.ValkeyClient) registry.Tool {
 	return &Tool{
-		BaseTool: base.NewBaseTool("xrange_stream", "Get stream entries in ID range", Input{}),
+		BaseTool: base.NewBaseTool("xrange_stream", "Get stream entries in ID range. With count the result is a page: pass next_start as start to get the next one until it is absent", Input{}),
 		client:   client,
 	}
 }
@@ -52,14 +54,25 @@ func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{},
 		return nil, fmt.Errorf("end cannot be empty")
 	}
 
-	raw, err := t.client.GetStreamRange(ctx, params.Key, params.Start, params.End, params.Count)
+	// Read one entry past the page to tell whether another page follows.
+	count := params.Count
+	if count > 0 {
+		count++
+	}
+	raw, err := t.client.GetStreamRange(ctx, params.Key, params.Start, params.End, count)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stream range: %w", err)
 	}
 
+	var nextStart string
+	if params.Count > 0 && int64(len(raw)) > params.Count {
+		raw = raw[:params.Count]
+		nextStart = "(" + raw[len(raw)-1].ID
+	}
 	return Output{
-		Entries: base.SafeStreamEntries(raw),
-		Count:   int64(len(raw)),
+		Entries:   base.SafeStreamEntries(raw),
+		Count:     int64(len(raw)),
+		NextStart: nextStart,
 	}, nil
 }
 
@@ -144,3 +144,32 @@ func TestXRangeStream_Execute_NoEntries(t *testing.T) {
 	assert.Equal(t, int64(0), output.Count)
 	assert.Len(t, output.Entries, 0)
 }
+
+func TestXRangeStream_Execute_Pages(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "1-1", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "events", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	tool := NewTool(mockClient)
+
+	var ids []string
+	start := "-"
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 2)
+		inputJSON, _ := json.Marshal(map[string]interface{}{"key": "events", "start": start, "end": "+", "count": 2})
+		result, err := tool.Execute(ctx, inputJSON)
+		require.NoError(t, err)
+		output := result.(Output)
+		for _, entry := range output.Entries {
+			ids = append(ids, entry["_id"].(string))
+		}
+		if output.NextStart == "" {
+			break
+		}
+		assert.Equal(t, "(1-1", output.NextStart)
+		start = output.NextStart
+	}
+	assert.Equal(t, []string{"1-0", "1-1", "2-0", "3-0"}, ids)
+}
@@ -0,0 +1,92 @@
+// Package xread_block_stream implements the xread_block_stream tool.
+package xread_block_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xread_block_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client     client.ValkeyClient
+	maxTimeout time.Duration
+}
+
+// Input represents the input for xread_block_stream tool.
+type Input struct {
+	Key       string `json:"key" jsonschema:"required,description=Stream key"`
+	ID        string `json:"id" jsonschema:"required,description=Return entries after this ID ($ for entries added after the call)"`
+	Count     int64  `json:"count,omitempty" jsonschema:"description=Maximum entries to return (0 for all)"`
+	TimeoutMs *int64 `json:"timeout_ms,omitempty" jsonschema:"description=Milliseconds to wait for entries (default: 10000; capped at the server maximum)"`
+}
+
+// Output represents the output of xread_block_stream tool.
+type Output struct {
+	Entries []map[string]any `json:"entries"`
+	Count   int64            `json:"count"`
+	// NextID is the ID to pass as id to read the entries that follow.
+	NextID    string `json:"next_id"`
+	TimedOut  bool   `json:"timed_out"`
+	TimeoutMs int64  `json:"timeout_ms"`
+}
+
+// NewTool creates a new xread_block_stream tool that waits at most
+// maxTimeout.
+func NewTool(client client.ValkeyClient, maxTimeout time.Duration) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool(
+			"xread_block_stream",
+			"Wait for stream entries after an ID with XREAD BLOCK and return them as soon as any arrive. Reports timed_out when none arrive within timeout_ms. Pass next_id as id to keep following the stream",
+			Input{},
+		),
+		client:     client,
+		maxTimeout: maxTimeout,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.ID == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+	timeout, err := base.BlockTimeout(params.TimeoutMs, t.maxTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := t.client.BlockingReadStream(ctx, params.Key, params.ID, params.Count, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream %q: %w", params.Key, err)
+	}
+
+	output := Output{
+		Entries:   base.SafeStreamEntries(raw),
+		Count:     int64(len(raw)),
+		NextID:    params.ID,
+		TimedOut:  len(raw) == 0,
+		TimeoutMs: timeout.Milliseconds(),
+	}
+	if len(raw) > 0 {
+		output.NextID = raw[len(raw)-1].ID
+	}
+	return output, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient, maxTimeout time.Duration) {
+	reg.MustRegister(NewTool(client, maxTimeout))
+}
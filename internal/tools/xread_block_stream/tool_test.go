@@ -0,0 +1,76 @@
+package xread_block_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXReadBlockStream_Execute_WaitsForEntry(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.AddStream(ctx, "jobs", "1-0", map[string]string{"job": "old"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	tool := NewTool(mockClient, base.DefaultMaxBlockTimeout)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		_, _ = mockClient.AddStream(ctx, "jobs", "2-0", map[string]string{"job": "new"}, client.StreamAddOptions{})
+	}()
+	result, err := tool.Execute(ctx, json.RawMessage(`{"key":"jobs","id":"$","timeout_ms":5000}`))
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.False(t, output.TimedOut)
+	require.Len(t, output.Entries, 1)
+	assert.Equal(t, "new", output.Entries[0]["job"])
+	assert.Equal(t, "2-0", output.NextID)
+	assert.Equal(t, int64(5000), output.TimeoutMs)
+}
+
+func TestXReadBlockStream_Execute_TimedOut(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","id":"0","timeout_ms":10}`))
+	require.NoError(t, err)
+	assert.Equal(t, Output{Entries: []map[string]any{}, NextID: "0", TimedOut: true, TimeoutMs: 10}, result)
+}
+
+func TestXReadBlockStream_Execute_CapsTimeout(t *testing.T) {
+	mockClient := &client.MockValkeyClient{}
+	mockClient.BlockingReadStreamFunc = func(ctx context.Context, key, id string, count int64, timeout time.Duration) ([]client.StreamEntry, error) {
+		assert.Equal(t, 2*time.Second, timeout)
+		assert.Equal(t, int64(5), count)
+		return []client.StreamEntry{}, nil
+	}
+	tool := NewTool(mockClient, 2*time.Second)
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","id":"0","count":5,"timeout_ms":60000}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), result.(Output).TimeoutMs)
+}
+
+func TestXReadBlockStream_Execute_Cancelled(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := tool.Execute(ctx, json.RawMessage(`{"key":"jobs","id":"$"}`))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestXReadBlockStream_Execute_InvalidInput(t *testing.T) {
+	tool := NewTool(client.NewMockClient(), base.DefaultMaxBlockTimeout)
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"","id":"0"}`))
+	assert.EqualError(t, err, "key cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","id":""}`))
+	assert.EqualError(t, err, "id cannot be empty")
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","id":"0","timeout_ms":0}`))
+	assert.EqualError(t, err, "timeout_ms must be positive")
+}
@@ -0,0 +1,75 @@
+// Package xreadgroup_stream implements the xreadgroup_stream tool.
+package xreadgroup_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xreadgroup_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xreadgroup_stream tool.
+type Input struct {
+	Key      string `json:"key" jsonschema:"required,description=Stream key"`
+	Group    string `json:"group" jsonschema:"required,description=Consumer group name"`
+	Consumer string `json:"consumer" jsonschema:"required,description=Consumer reading the entries; created on first use"`
+	ID       string `json:"id,omitempty" jsonschema:"description=> for entries never delivered to the group (the default) or an ID to reread the consumer's pending entries after it"`
+	Count    int64  `json:"count,omitempty" jsonschema:"minimum=0,description=Maximum entries to return (0 for all)"`
+	NoAck    bool   `json:"noack,omitempty" jsonschema:"description=Do not add the entries to the pending list"`
+}
+
+// Output represents the output of xreadgroup_stream tool.
+type Output struct {
+	Entries []map[string]any `json:"entries"`
+	Count   int64            `json:"count"`
+}
+
+// NewTool creates a new xreadgroup_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xreadgroup_stream", "Read entries as a consumer of a consumer group with XREADGROUP. New entries are delivered once per group and stay pending until acknowledged with xack_stream", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	if params.Group == "" {
+		return nil, fmt.Errorf("group cannot be empty")
+	}
+	if params.Consumer == "" {
+		return nil, fmt.Errorf("consumer cannot be empty")
+	}
+	if params.ID == "" {
+		params.ID = ">"
+	}
+
+	raw, err := t.client.ReadStreamGroup(ctx, params.Key, params.Group, params.Consumer, params.ID, params.Count, params.NoAck)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream %q as group %q: %w", params.Key, params.Group, err)
+	}
+	return Output{
+		Entries: base.SafeStreamEntries(raw),
+		Count:   int64(len(raw)),
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
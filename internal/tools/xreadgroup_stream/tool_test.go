@@ -0,0 +1,74 @@
+package xreadgroup_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0", "3-0"} {
+		_, err := mockClient.AddStream(ctx, "jobs", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w1", "count": 2})
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	output := result.(Output)
+	assert.Equal(t, int64(2), output.Count)
+	assert.Equal(t, "1-0", output.Entries[0]["_id"])
+	assert.Equal(t, "2-0", output.Entries[1]["n"])
+
+	// Another consumer gets the next entry only.
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w2"})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.(Output).Count)
+
+	// Rereading history returns w1's pending entries.
+	inputJSON, _ = json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w1", "id": "0"})
+	result, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.(Output).Count)
+
+	pending, err := mockClient.PendingStream(ctx, "jobs", "workers", client.StreamPendingOptions{Consumer: "w1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pending[0].Deliveries)
+}
+
+func TestTool_Execute_NoAck(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	_, err := mockClient.AddStream(ctx, "jobs", "1-0", map[string]string{"n": "1"}, client.StreamAddOptions{})
+	require.NoError(t, err)
+	require.NoError(t, mockClient.CreateStreamGroup(ctx, "jobs", "workers", "0", false, nil))
+	tool := NewTool(mockClient)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w1", "noack": true})
+	_, err = tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+
+	summary, err := mockClient.PendingStreamSummary(ctx, "jobs", "workers")
+	require.NoError(t, err)
+	assert.Zero(t, summary.Count)
+}
+
+func TestTool_Execute_Errors(t *testing.T) {
+	tool := NewTool(client.NewMockClient())
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "jobs", "group": "workers", "consumer": "w1"})
+	_, err := tool.Execute(context.Background(), inputJSON)
+	assert.ErrorContains(t, err, "NOGROUP")
+
+	_, err = tool.Execute(context.Background(), json.RawMessage(`{"key":"jobs","group":"workers"}`))
+	assert.EqualError(t, err, "consumer cannot be empty")
+}
@@ -0,0 +1,85 @@
+// Package xrevrange_stream implements the xrevrange_stream tool.
+package xrevrange_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xrevrange_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xrevrange_stream tool.
+type Input struct {
+	Key   string `json:"key" jsonschema:"required,description=Stream key"`
+	End   string `json:"end,omitempty" jsonschema:"description=Highest ID to return; (ID excludes it (default: +)"`
+	Start string `json:"start,omitempty" jsonschema:"description=Lowest ID to return; (ID excludes it (default: -)"`
+	Count int64  `json:"count,omitempty" jsonschema:"minimum=0,description=Maximum entries to return (0 for all)"`
+}
+
+// Output represents the output of xrevrange_stream tool.
+type Output struct {
+	Entries []map[string]any `json:"entries"`
+	Count   int64            `json:"count"`
+	// NextEnd is the end of the next page when more entries remain.
+	NextEnd string `json:"next_end,omitempty"`
+}
+
+// NewTool creates a new xrevrange_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xrevrange_stream", "Get stream entries in ID range newest first with XREVRANGE. With count the result is a page: pass next_end as end to get the next one until it is absent", Input{}),
+		client:   client,
+	}
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return nil, err
+	}
+	if params.Key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+	end, start := params.End, params.Start
+	if end == "" {
+		end = "+"
+	}
+	if start == "" {
+		start = "-"
+	}
+
+	// Read one entry past the page to tell whether another page follows.
+	count := params.Count
+	if count > 0 {
+		count++
+	}
+	entries, err := t.client.GetStreamRevRange(ctx, params.Key, end, start, count)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reverse range of stream %q: %w", params.Key, err)
+	}
+
+	var nextEnd string
+	if params.Count > 0 && int64(len(entries)) > params.Count {
+		entries = entries[:params.Count]
+		nextEnd = "(" + entries[len(entries)-1].ID
+	}
+	return Output{
+		Entries: base.SafeStreamEntries(entries),
+		Count:   int64(len(entries)),
+		NextEnd: nextEnd,
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
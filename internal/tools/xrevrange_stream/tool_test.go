@@ -0,0 +1,55 @@
+package xrevrange_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTool_Execute_Pages(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0", "3-0", "4-0", "5-0"} {
+		_, err := mockClient.AddStream(ctx, "events", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	tool := NewTool(mockClient)
+
+	var ids []string
+	end := ""
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+		inputJSON, _ := json.Marshal(map[string]interface{}{"key": "events", "end": end, "count": 2})
+		result, err := tool.Execute(ctx, inputJSON)
+		require.NoError(t, err)
+		output := result.(Output)
+		for _, entry := range output.Entries {
+			ids = append(ids, entry["_id"].(string))
+		}
+		if output.NextEnd == "" {
+			break
+		}
+		end = output.NextEnd
+	}
+	assert.Equal(t, []string{"5-0", "4-0", "3-0", "2-0", "1-0"}, ids)
+}
+
+func TestTool_Execute_Bounds(t *testing.T) {
+	tool := NewTool(&client.MockValkeyClient{
+		GetStreamRevRangeFunc: func(ctx context.Context, key, end, start string, count int64) ([]client.StreamEntry, error) {
+			assert.Equal(t, "+", end)
+			assert.Equal(t, "-", start)
+			assert.Zero(t, count)
+			return []client.StreamEntry{{ID: "1-0", FieldValues: map[string][]byte{"n": []byte("1")}}}, nil
+		},
+	})
+
+	result, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"events"}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.(Output).Count)
+	assert.Empty(t, result.(Output).NextEnd)
+}
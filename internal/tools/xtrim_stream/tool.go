@@ -0,0 +1,128 @@
+// Package xtrim_stream implements the xtrim_stream tool.
+package xtrim_stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/registry"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+)
+
+// Tool implements the xtrim_stream functionality.
+type Tool struct {
+	base.BaseTool
+	client client.ValkeyClient
+}
+
+// Input represents the input for xtrim_stream tool.
+type Input struct {
+	Key         string `json:"key" jsonschema:"required,description=Stream key"`
+	MaxLen      *int64 `json:"maxlen,omitempty" jsonschema:"minimum=0,description=Keep at most this many entries"`
+	MinID       string `json:"minid,omitempty" jsonschema:"description=Evict entries with IDs lower than this"`
+	Approximate bool   `json:"approximate,omitempty" jsonschema:"description=Trim with ~: only whole nodes are evicted so a few more entries may remain but trimming is cheaper"`
+	Limit       int64  `json:"limit,omitempty" jsonschema:"minimum=1,description=With approximate: maximum entries to evict"`
+}
+
+// Output represents the output of xtrim_stream tool.
+type Output struct {
+	Evicted int64 `json:"evicted"`
+}
+
+// NewTool creates a new xtrim_stream tool.
+func NewTool(client client.ValkeyClient) registry.Tool {
+	return &Tool{
+		BaseTool: base.NewBaseTool("xtrim_stream", "Evict the oldest entries of a stream with XTRIM: maxlen keeps at most that many entries and minid evicts entries with lower IDs. Pending entries of consumer groups are not acknowledged", Input{}),
+		client:   client,
+	}
+}
+
+// parse parses and validates input.
+func (t *Tool) parse(input json.RawMessage) (Input, *client.StreamTrim, error) {
+	var params Input
+	if err := t.ParseInput(input, &params); err != nil {
+		return params, nil, err
+	}
+	if params.Key == "" {
+		return params, nil, fmt.Errorf("key cannot be empty")
+	}
+	trim, err := base.StreamTrim(params.MaxLen, params.MinID, params.Approximate, params.Limit)
+	if err != nil {
+		return params, nil, err
+	}
+	if trim == nil {
+		return params, nil, fmt.Errorf("maxlen or minid is required")
+	}
+	return params, trim, nil
+}
+
+func (t *Tool) Execute(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, trim, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	evicted, err := t.client.TrimStream(ctx, params.Key, *trim)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trim stream %q: %w", params.Key, err)
+	}
+	return Output{Evicted: evicted}, nil
+}
+
+// describe summarises evicting the entries of eviction from a stream of
+// length entries.
+func describe(params Input, trim *client.StreamTrim, length int64, eviction base.StreamEviction) string {
+	return fmt.Sprintf("Trim stream %q with %s %s: evicting %s of %d entries", params.Key, trim.Strategy, trim.Threshold, eviction.Quantity(params.Approximate), length)
+}
+
+// Assess implements registry.Assessor.
+func (t *Tool) Assess(ctx context.Context, input json.RawMessage) (*registry.Impact, error) {
+	params, trim, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := t.client.GetStreamLength(ctx, params.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get length of stream %q: %w", params.Key, err)
+	}
+	eviction, err := base.StreamTrimmed(ctx, t.client, params.Key, *trim, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &registry.Impact{Summary: describe(params, trim, length, eviction), Keys: []string{params.Key}}, nil
+}
+
+// Preview implements registry.Previewer.
+func (t *Tool) Preview(ctx context.Context, input json.RawMessage) (interface{}, error) {
+	params, trim, err := t.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := base.DescribeKey(ctx, t.client, params.Key)
+	if err != nil {
+		return nil, err
+	}
+	eviction, err := base.StreamTrimmed(ctx, t.client, params.Key, *trim, 0)
+	if err != nil {
+		return nil, err
+	}
+	removed := make([]any, len(eviction.Entries))
+	for i, entry := range eviction.Entries {
+		removed[i] = entry
+	}
+	return &base.Preview{
+		Summary:   describe(params, trim, state.Size, eviction),
+		Keys:      []*base.KeyState{state},
+		Removed:   removed,
+		Truncated: eviction.Count > int64(len(removed)),
+	}, nil
+}
+
+// Init registers the tool with the registry.
+func Init(reg *registry.ToolRegistry, client client.ValkeyClient) {
+	reg.MustRegister(NewTool(client))
+}
@@ -0,0 +1,87 @@
+package xtrim_stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/tools/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seed(t *testing.T) *client.MockClient {
+	t.Helper()
+	mockClient := client.NewMockClient()
+	for _, id := range []string{"1-0", "2-0", "3-0", "4-0", "5-0"} {
+		_, err := mockClient.AddStream(context.Background(), "events", id, map[string]string{"n": id}, client.StreamAddOptions{})
+		require.NoError(t, err)
+	}
+	return mockClient
+}
+
+func TestTool_Execute_MaxLen(t *testing.T) {
+	ctx := context.Background()
+	mockClient := seed(t)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "events", "maxlen": 2})
+	preview, err := tool.Preview(ctx, inputJSON)
+	require.NoError(t, err)
+	p := preview.(*base.Preview)
+	assert.Equal(t, `Trim stream "events" with MAXLEN 2: evicting 3 of 5 entries`, p.Summary)
+	assert.Len(t, p.Removed, 3)
+	assert.Equal(t, "1-0", p.Removed[0].(map[string]any)["_id"])
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Evicted: 3}, result)
+
+	entries, err := mockClient.GetStreamRange(ctx, "events", "-", "+", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "4-0", entries[0].ID)
+	info, err := mockClient.GetStreamInfo(ctx, "events", false, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "5-0", info.LastGeneratedID)
+	assert.Equal(t, "3-0", info.MaxDeletedEntryID)
+	assert.Equal(t, int64(5), info.EntriesAdded)
+}
+
+func TestTool_Execute_MinID(t *testing.T) {
+	ctx := context.Background()
+	mockClient := seed(t)
+	tool := NewTool(mockClient).(*Tool)
+
+	inputJSON, _ := json.Marshal(map[string]interface{}{"key": "events", "minid": "3", "approximate": true, "limit": 100})
+	impact, err := tool.Assess(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, `Trim stream "events" with MINID 3: evicting up to 2 of 5 entries`, impact.Summary)
+
+	result, err := tool.Execute(ctx, inputJSON)
+	require.NoError(t, err)
+	assert.Equal(t, Output{Evicted: 2}, result)
+}
+
+func TestTool_Execute_Options(t *testing.T) {
+	var got client.StreamTrim
+	tool := NewTool(&client.MockValkeyClient{
+		TrimStreamFunc: func(ctx context.Context, key string, trim client.StreamTrim) (int64, error) {
+			got = trim
+			return 0, nil
+		},
+	})
+
+	_, err := tool.Execute(context.Background(), json.RawMessage(`{"key":"events","maxlen":1000,"approximate":true,"limit":10}`))
+	require.NoError(t, err)
+	assert.Equal(t, client.StreamTrim{Strategy: "MAXLEN", Threshold: "1000", Approximate: true, Limit: 10}, got)
+
+	for input, message := range map[string]string{
+		`{"key":"events"}`:                        "maxlen or minid is required",
+		`{"key":"events","maxlen":1,"minid":"1"}`: "maxlen and minid cannot be combined",
+		`{"key":"events","maxlen":1,"limit":5}`:   "limit requires approximate",
+	} {
+		_, err := tool.Execute(context.Background(), json.RawMessage(input))
+		assert.EqualError(t, err, message, input)
+	}
+}
@@ -0,0 +1,329 @@
+// Package undo keeps a bounded in-memory journal of the keys write tools
+// change. Each key is DUMPed with its PTTL before the change, so that the
+// change can later be reverted with RESTORE ... REPLACE.
+package undo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+	"github.com/ItsJooL/valkey-mcp-server/internal/logging"
+)
+
+// DefaultCapacity is the suggested Config.Capacity.
+const DefaultCapacity = 100
+
+// DefaultMaxBytes is the suggested Config.MaxBytes.
+const DefaultMaxBytes = 64 << 20
+
+// UndoTool is the tool name recorded for changes made by Undo.
+const UndoTool = "undo_change"
+
+// Config bounds the journal.
+type Config struct {
+	// Capacity is the number of changes kept. Older changes are dropped.
+	Capacity int
+	// MaxBytes caps the total size of the serialized values kept. Keys whose
+	// value alone is larger, in memory or serialized, are recorded without a
+	// snapshot and cannot be restored.
+	MaxBytes int64
+}
+
+// Change is one recorded tool call.
+type Change struct {
+	ID     int64       `json:"id"`
+	Tool   string      `json:"tool"`
+	Time   time.Time   `json:"time"`
+	Keys   []*Snapshot `json:"keys"`
+	Failed bool        `json:"failed,omitempty"`
+	Undone bool        `json:"undone,omitempty"`
+}
+
+// Snapshot is the state of one key before a change.
+type Snapshot struct {
+	Key     string `json:"key"`
+	Existed bool   `json:"existed"`
+	Type    string `json:"type,omitempty"`
+	// PTTL is the remaining time to live in milliseconds, or -1 for none.
+	PTTL int64 `json:"pttl,omitempty"`
+	Size int   `json:"size_bytes,omitempty"`
+	// Skipped explains why the key cannot be restored.
+	Skipped string `json:"skipped,omitempty"`
+
+	dump []byte
+}
+
+// Journal implements registry.Journal.
+type Journal struct {
+	client   client.ValkeyClient
+	capacity int
+	maxBytes int64
+	now      func() time.Time
+
+	mu      sync.Mutex
+	changes []*Change
+	bytes   int64
+	nextID  int64
+}
+
+// New creates an empty journal.
+func New(c client.ValkeyClient, cfg Config) *Journal {
+	if cfg.Capacity <= 0 {
+		cfg.Capacity = DefaultCapacity
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = DefaultMaxBytes
+	}
+	return &Journal{
+		client:   c,
+		capacity: cfg.Capacity,
+		maxBytes: cfg.MaxBytes,
+		now:      time.Now,
+		nextID:   1,
+	}
+}
+
+// Record implements registry.Journal. Calls whose arguments name no keys,
+// such as config_set, are not recorded.
+func (j *Journal) Record(ctx context.Context, tool string, input json.RawMessage) (func(error), error) {
+	keys := writtenKeys(input)
+	if len(keys) == 0 {
+		return func(error) {}, nil
+	}
+	snapshots, err := j.snapshot(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot keys for undo: %w", err)
+	}
+	return func(err error) {
+		// A failed call may still have changed some keys, so it is kept.
+		change := j.add(tool, snapshots, err != nil)
+		logging.FromContext(ctx).DebugContext(ctx, "change recorded", "change_id", change.ID, "tool", tool, "keys", len(keys))
+	}, nil
+}
+
+// writtenKeys returns the keys named by the key arguments of a write tool.
+func writtenKeys(input json.RawMessage) []string {
+	var args struct {
+		Key         string   `json:"key"`
+		Keys        []string `json:"keys"`
+		NewKey      string   `json:"new_key"`
+		Source      string   `json:"source"`
+		Destination string   `json:"destination"`
+	}
+	if len(input) == 0 || json.Unmarshal(input, &args) != nil {
+		return nil
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, key := range append([]string{args.Key, args.NewKey, args.Source, args.Destination}, args.Keys...) {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// snapshot DUMPs keys with their PTTL, skipping keys over the size limit.
+func (j *Journal) snapshot(ctx context.Context, keys []string) ([]*Snapshot, error) {
+	snapshots := make([]*Snapshot, 0, len(keys))
+	for _, key := range keys {
+		keyType, err := j.client.GetKeyType(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get type of key %q: %w", key, err)
+		}
+		s := &Snapshot{Key: key, Existed: keyType != "none"}
+		snapshots = append(snapshots, s)
+		if !s.Existed {
+			continue
+		}
+		s.Type = keyType
+
+		if s.PTTL, err = j.client.GetPTTL(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to get TTL of key %q: %w", key, err)
+		}
+		// MEMORY USAGE is cheap next to DUMP, so keys that are clearly too
+		// large are skipped without pulling their value into memory. It may
+		// be disabled by ACLs; the size is then checked after DUMP.
+		if usage, err := j.client.MemoryUsage(ctx, key); err == nil && usage > j.maxBytes {
+			s.Skipped = fmt.Sprintf("value of about %d bytes in memory exceeds the journal limit of %d bytes", usage, j.maxBytes)
+			continue
+		}
+		if s.dump, err = j.client.DumpKey(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to dump key %q: %w", key, err)
+		}
+		s.Size = len(s.dump)
+		if int64(s.Size) > j.maxBytes {
+			s.dump = nil
+			s.Skipped = fmt.Sprintf("value of %d bytes exceeds the journal limit of %d bytes", s.Size, j.maxBytes)
+		}
+	}
+	return snapshots, nil
+}
+
+// add appends a change and evicts the oldest ones beyond the journal bounds.
+func (j *Journal) add(tool string, snapshots []*Snapshot, failed bool) *Change {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	change := &Change{ID: j.nextID, Tool: tool, Time: j.now(), Keys: snapshots, Failed: failed}
+	j.nextID++
+	j.changes = append(j.changes, change)
+	j.bytes += changeBytes(change)
+	for len(j.changes) > j.capacity || (j.bytes > j.maxBytes && len(j.changes) > 1) {
+		j.bytes -= changeBytes(j.changes[0])
+		j.changes[0] = nil
+		j.changes = j.changes[1:]
+	}
+	return change
+}
+
+// changeBytes returns the size of the serialized values held by change.
+func changeBytes(change *Change) int64 {
+	var n int64
+	for _, s := range change.Keys {
+		n += int64(len(s.dump))
+	}
+	return n
+}
+
+// List returns up to limit recorded changes, newest first. When key is not
+// empty only changes to that key are returned. limit <= 0 means no limit.
+func (j *Journal) List(key string, limit int) []Change {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var result []Change
+	for i := len(j.changes) - 1; i >= 0; i-- {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		change := j.changes[i]
+		if key != "" && !change.touches(key) {
+			continue
+		}
+		result = append(result, *change)
+	}
+	return result
+}
+
+// Get returns a copy of the change with the given ID.
+func (j *Journal) Get(id int64) (Change, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if change := j.find(id); change != nil {
+		return *change, true
+	}
+	return Change{}, false
+}
+
+// find returns the change with the given ID. The caller must hold j.mu.
+func (j *Journal) find(id int64) *Change {
+	for _, change := range j.changes {
+		if change.ID == id {
+			return change
+		}
+	}
+	return nil
+}
+
+func (c *Change) touches(key string) bool {
+	for _, s := range c.Keys {
+		if s.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Result describes an undone change.
+type Result struct {
+	ID       int64    `json:"id"`
+	Restored []string `json:"restored,omitempty"`
+	Deleted  []string `json:"deleted,omitempty"`
+	Skipped  []string `json:"skipped,omitempty"`
+	// UndoID is the change recording the state replaced by the undo, which
+	// can itself be undone.
+	UndoID int64 `json:"undo_id"`
+}
+
+// Undo reverts the change with the given ID: keys that existed are restored
+// with RESTORE ... REPLACE and their original TTL, and keys that did not are
+// deleted. Later changes to the same keys are overwritten.
+func (j *Journal) Undo(ctx context.Context, id int64) (*Result, error) {
+	j.mu.Lock()
+	change := j.find(id)
+	switch {
+	case change == nil:
+		j.mu.Unlock()
+		return nil, fmt.Errorf("change %d is not in the journal, which keeps the last %d changes", id, j.capacity)
+	case change.Undone:
+		j.mu.Unlock()
+		return nil, fmt.Errorf("change %d has already been undone", id)
+	}
+	change.Undone = true
+	j.mu.Unlock()
+
+	result, err := j.revert(ctx, change)
+	if err != nil {
+		j.mu.Lock()
+		change.Undone = false
+		j.mu.Unlock()
+		return nil, err
+	}
+	return result, nil
+}
+
+// revert restores the keys of change, recording their current state first.
+func (j *Journal) revert(ctx context.Context, change *Change) (*Result, error) {
+	keys := make([]string, 0, len(change.Keys))
+	for _, s := range change.Keys {
+		if s.Skipped == "" {
+			keys = append(keys, s.Key)
+		}
+	}
+	current, err := j.snapshot(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot keys for undo: %w", err)
+	}
+
+	result := &Result{ID: change.ID}
+	err = j.restore(ctx, change, result)
+	undo := j.add(UndoTool, current, err != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w (keys changed so far can be reverted with change %d)", err, undo.ID)
+	}
+	result.UndoID = undo.ID
+	return result, nil
+}
+
+// restore writes back the snapshots of change and records what it did in
+// result.
+func (j *Journal) restore(ctx context.Context, change *Change, result *Result) error {
+	for _, s := range change.Keys {
+		switch {
+		case s.Skipped != "":
+			result.Skipped = append(result.Skipped, s.Key)
+		case !s.Existed:
+			if _, err := j.client.DeleteKey(ctx, s.Key); err != nil {
+				return fmt.Errorf("failed to delete key %q: %w", s.Key, err)
+			}
+			result.Deleted = append(result.Deleted, s.Key)
+		default:
+			ttl := s.PTTL
+			if ttl < 0 {
+				ttl = 0
+			}
+			if _, err := j.client.RestoreKey(ctx, s.Key, ttl, s.dump, client.RestoreOptions{Replace: true}); err != nil {
+				return fmt.Errorf("failed to restore key %q: %w", s.Key, err)
+			}
+			result.Restored = append(result.Restored, s.Key)
+		}
+	}
+	return nil
+}
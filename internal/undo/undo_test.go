@@ -0,0 +1,156 @@
+package undo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ItsJooL/valkey-mcp-server/internal/client"
+)
+
+// record runs write as a tool call recorded in j.
+func record(t *testing.T, j *Journal, tool string, args map[string]interface{}, write func() error) {
+	t.Helper()
+	input, _ := json.Marshal(args)
+	done, err := j.Record(context.Background(), tool, input)
+	require.NoError(t, err)
+	done(write())
+}
+
+func TestJournal_UndoRestoresValueAndTTL(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	ttl := int64(60)
+	_, err := mockClient.SetString(ctx, "greeting", "hello", &ttl, false, false)
+	require.NoError(t, err)
+	j := New(mockClient, Config{})
+
+	record(t, j, "delete_keys", map[string]interface{}{"keys": []string{"greeting"}}, func() error {
+		_, err := mockClient.DeleteKey(ctx, "greeting")
+		return err
+	})
+	changes := j.List("", 0)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "delete_keys", changes[0].Tool)
+	assert.Equal(t, int64(60000), changes[0].Keys[0].PTTL)
+
+	result, err := j.Undo(ctx, changes[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"greeting"}, result.Restored)
+
+	value, found, _ := mockClient.GetString(ctx, "greeting")
+	assert.True(t, found)
+	assert.Equal(t, "hello", string(value))
+	remaining, _ := mockClient.GetTTL(ctx, "greeting")
+	assert.Equal(t, int64(60), remaining)
+
+	_, err = j.Undo(ctx, changes[0].ID)
+	assert.ErrorContains(t, err, "already been undone")
+}
+
+func TestJournal_UndoDeletesCreatedKeys(t *testing.T) {
+	ctx := context.Background()
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("old", []byte("1"))
+	j := New(mockClient, Config{})
+
+	record(t, j, "rename_key", map[string]interface{}{"key": "old", "new_key": "new"}, func() error {
+		_, err := mockClient.RenameKey(ctx, "old", "new")
+		return err
+	})
+	result, err := j.Undo(ctx, j.List("", 1)[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old"}, result.Restored)
+	assert.Equal(t, []string{"new"}, result.Deleted)
+
+	exists, _ := mockClient.ExistsKeys(ctx, []string{"old", "new"})
+	assert.Equal(t, map[string]bool{"old": true, "new": false}, exists)
+
+	// The undo is recorded and can itself be undone.
+	_, err = j.Undo(ctx, result.UndoID)
+	require.NoError(t, err)
+	exists, _ = mockClient.ExistsKeys(ctx, []string{"old", "new"})
+	assert.Equal(t, map[string]bool{"old": false, "new": true}, exists)
+}
+
+func TestJournal_Bounds(t *testing.T) {
+	mockClient := client.NewMockClient()
+	mockClient.SetRawBytes("small", []byte("ab"))
+	// The mock reports 100 bytes of memory for every string, so "large" is
+	// only found too large once dumped.
+	mockClient.SetRawBytes("large", []byte(strings.Repeat("0123456789", 30)))
+	j := New(mockClient, Config{Capacity: 2, MaxBytes: 200})
+
+	for i := 0; i < 3; i++ {
+		record(t, j, "set_string", map[string]interface{}{"key": "small"}, func() error { return nil })
+	}
+	changes := j.List("", 0)
+	require.Len(t, changes, 2)
+	assert.Equal(t, []int64{3, 2}, []int64{changes[0].ID, changes[1].ID})
+
+	record(t, j, "set_string", map[string]interface{}{"key": "large"}, func() error { return errors.New("boom") })
+	change := j.List("large", 0)[0]
+	assert.True(t, change.Failed)
+	assert.Contains(t, change.Keys[0].Skipped, "exceeds the journal limit")
+
+	_, err := j.Undo(context.Background(), 1)
+	assert.ErrorContains(t, err, "not in the journal")
+}
+
+func TestJournal_SkipsLargeKeysBeforeDump(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		GetKeyTypeFunc: func(ctx context.Context, key string) (string, error) {
+			return "list", nil
+		},
+		MemoryUsageFunc: func(ctx context.Context, key string) (int64, error) {
+			return 4 << 30, nil
+		},
+		DumpKeyFunc: func(ctx context.Context, key string) ([]byte, error) {
+			t.Fatalf("key %q was dumped", key)
+			return nil, nil
+		},
+	}
+	j := New(mockClient, Config{MaxBytes: 1 << 20})
+
+	record(t, j, "ltrim_list", map[string]interface{}{"key": "queue"}, func() error { return nil })
+	snapshot := j.List("queue", 0)[0].Keys[0]
+	assert.True(t, snapshot.Existed)
+	assert.Equal(t, "value of about 4294967296 bytes in memory exceeds the journal limit of 1048576 bytes", snapshot.Skipped)
+
+	result, err := j.Undo(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue"}, result.Skipped)
+}
+
+func TestJournal_DumpsWhenMemoryUsageIsDenied(t *testing.T) {
+	mockClient := &client.MockValkeyClient{
+		GetKeyTypeFunc: func(ctx context.Context, key string) (string, error) {
+			return "string", nil
+		},
+		MemoryUsageFunc: func(ctx context.Context, key string) (int64, error) {
+			return 0, errors.New("NOPERM this user has no permissions to run the 'memory|usage' command")
+		},
+		DumpKeyFunc: func(ctx context.Context, key string) ([]byte, error) {
+			return []byte(key + "-payload"), nil
+		},
+	}
+	j := New(mockClient, Config{MaxBytes: 12})
+
+	record(t, j, "set_string", map[string]interface{}{"keys": []string{"a", "long-key"}}, func() error { return nil })
+	snapshots := j.List("", 0)[0].Keys
+	assert.Equal(t, 9, snapshots[0].Size)
+	assert.Empty(t, snapshots[0].Skipped)
+	assert.Equal(t, "value of 16 bytes exceeds the journal limit of 12 bytes", snapshots[1].Skipped)
+}
+
+func TestWrittenKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, writtenKeys(json.RawMessage(`{"key":"a","new_key":"b","keys":["a","c"]}`)))
+	assert.Equal(t, []string{"jobs", "processing"}, writtenKeys(json.RawMessage(`{"source":"jobs","destination":"processing"}`)))
+	assert.Empty(t, writtenKeys(json.RawMessage(`{"parameter":"maxmemory"}`)))
+	assert.Empty(t, writtenKeys(nil))
+}